@@ -0,0 +1,128 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ModelBudget defines the budget limits for a single model
+type ModelBudget struct {
+	// BudgetDuration - Budget is reset at the end of specified duration
+	BudgetDuration string `json:"budgetDuration,omitempty"`
+	// MaxBudget is the maximum budget for the model
+	MaxBudget string `json:"maxBudget,omitempty"`
+	// RPMLimit is the maximum requests per minute for the model
+	RPMLimit int `json:"rpmLimit,omitempty"`
+	// TPMLimit is the maximum tokens per minute for the model
+	TPMLimit int `json:"tpmLimit,omitempty"`
+}
+
+// BudgetSpec defines the desired state of Budget
+type BudgetSpec struct {
+	// ConnectionRef defines how to connect to the LiteLLM instance
+	// +kubebuilder:validation:Required
+	ConnectionRef ConnectionRef `json:"connectionRef"`
+
+	// BudgetDuration - Budget is reset at the end of specified duration. If not set, budget is never reset. You can set duration as seconds ("30s"), minutes ("30m"), hours ("30h"), days ("30d"), months ("1mo").
+	BudgetDuration string `json:"budgetDuration,omitempty"`
+	// BudgetID is the ID of the budget in LiteLLM. Defaults to the name of the resource
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="BudgetID is immutable"
+	BudgetID string `json:"budgetID,omitempty"`
+	// MaxBudget is the maximum budget
+	MaxBudget string `json:"maxBudget,omitempty"`
+	// MaxParallelRequests is the maximum number of parallel requests
+	MaxParallelRequests int `json:"maxParallelRequests,omitempty"`
+	// ModelMaxBudget is the model specific budget
+	ModelMaxBudget map[string]ModelBudget `json:"modelMaxBudget,omitempty"`
+	// RPMLimit is the maximum requests per minute
+	RPMLimit int `json:"rpmLimit,omitempty"`
+	// SoftBudget - alert when spend exceeds this budget, doesn't block requests
+	SoftBudget string `json:"softBudget,omitempty"`
+	// TPMLimit is the maximum tokens per minute
+	TPMLimit int `json:"tpmLimit,omitempty"`
+}
+
+// BudgetStatus defines the observed state of Budget
+type BudgetStatus struct {
+	// ObservedGeneration is the most recent generation observed for this Budget. It corresponds to the
+	// Budget's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// BudgetDuration - Budget is reset at the end of specified duration
+	BudgetDuration string `json:"budgetDuration,omitempty"`
+	// BudgetID is the ID of the budget in LiteLLM
+	BudgetID string `json:"budgetID,omitempty"`
+	// BudgetResetAt is the date and time when the budget will be reset
+	BudgetResetAt string `json:"budgetResetAt,omitempty"`
+	// CreatedAt is the date and time when the budget was created
+	CreatedAt string `json:"createdAt,omitempty"`
+	// MaxBudget is the maximum budget
+	MaxBudget string `json:"maxBudget,omitempty"`
+	// RPMLimit is the maximum requests per minute
+	RPMLimit int `json:"rpmLimit,omitempty"`
+	// SoftBudget is the soft budget
+	SoftBudget string `json:"softBudget,omitempty"`
+	// TPMLimit is the maximum tokens per minute
+	TPMLimit int `json:"tpmLimit,omitempty"`
+	// UpdatedAt is the date and time when the budget was last updated
+	UpdatedAt string `json:"updatedAt,omitempty"`
+
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="The ready status of the budget"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.budgetID",description="The budget ID in LiteLLM"
+// +kubebuilder:printcolumn:name="Budget",type="string",JSONPath=".spec.maxBudget",description="Maximum budget"
+// +kubebuilder:printcolumn:name="Duration",type="string",JSONPath=".spec.budgetDuration",description="Budget reset period"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since creation"
+
+// Budget is the Schema for the budgets API
+type Budget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BudgetSpec   `json:"spec,omitempty"`
+	Status BudgetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BudgetList contains a list of Budget
+type BudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Budget `json:"items"`
+}
+
+// GetConditions returns the conditions slice
+func (b *Budget) GetConditions() []metav1.Condition {
+	return b.Status.Conditions
+}
+
+// SetConditions sets the conditions slice
+func (b *Budget) SetConditions(conditions []metav1.Condition) {
+	b.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&Budget{}, &BudgetList{})
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterVaultStoreSpec defines the Vault KV version 2 secrets engine key Secrets are written to
+// +kubebuilder:validation:XValidation:rule="has(self.tokenSecretRef) != has(self.kubernetesAuth)",message="exactly one of tokenSecretRef or kubernetesAuth must be set"
+type ClusterVaultStoreSpec struct {
+	// Address of the Vault server, e.g. https://vault.example.com:8200
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	Address string `json:"address"`
+	// Namespace of Vault Enterprise the secrets engine is in
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Mount path of the KV version 2 secrets engine
+	// +kubebuilder:default=secret
+	// +optional
+	Mount string `json:"mount,omitempty"`
+	// PathPrefix confines the secrets written through the store. The key Secret of a resource is written to
+	// <pathPrefix>/<namespace>/<path>, so that resources can only write below the path of their namespace
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-][A-Za-z0-9_.-]*(/[A-Za-z0-9_-][A-Za-z0-9_.-]*)*$`
+	PathPrefix string `json:"pathPrefix"`
+	// TokenSecretRef selects the Secret key holding the Vault token
+	// +optional
+	TokenSecretRef *VaultTokenSecretRef `json:"tokenSecretRef,omitempty"`
+	// KubernetesAuth logs in to Vault with the service account token of the operator
+	// +optional
+	KubernetesAuth *VaultKubernetesAuth `json:"kubernetesAuth,omitempty"`
+}
+
+// VaultTokenSecretRef selects the key of a Secret holding a Vault token
+type VaultTokenSecretRef struct {
+	// Name of the Secret
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace of the Secret
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+	// Key of the Secret holding the token
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// VaultKubernetesAuth is a role of the Vault Kubernetes auth method
+type VaultKubernetesAuth struct {
+	// Role to log in as
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Role string `json:"role"`
+	// Mount path of the Kubernetes auth method
+	// +kubebuilder:default=kubernetes
+	// +optional
+	Mount string `json:"mount,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Address",type="string",JSONPath=".spec.address",description="The address of the Vault server"
+// +kubebuilder:printcolumn:name="Path Prefix",type="string",JSONPath=".spec.pathPrefix",description="The path prefix of the written secrets"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since creation"
+
+// ClusterVaultStore is a Vault KV secrets engine that VirtualKeys and Users write their key Secret to. It is
+// cluster-scoped so that the Vault server and the credentials used to log in to it are chosen by the cluster
+// administrators, while resources only reference the store by name.
+type ClusterVaultStore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterVaultStoreSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterVaultStoreList contains a list of ClusterVaultStore
+type ClusterVaultStoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterVaultStore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterVaultStore{}, &ClusterVaultStoreList{})
+}
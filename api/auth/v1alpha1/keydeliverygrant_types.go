@@ -0,0 +1,75 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KeyDeliveryGrantFrom identifies the VirtualKeys allowed to copy their key Secret into the namespace of the grant
+type KeyDeliveryGrantFrom struct {
+	// Namespace is the namespace of the VirtualKeys
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+	// Name limits the grant to a single VirtualKey. All VirtualKeys in the namespace are allowed when it is not set
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// KeyDeliveryGrantSpec defines the VirtualKeys allowed to deliver their key into the namespace of the grant
+type KeyDeliveryGrantSpec struct {
+	// From lists the VirtualKeys allowed to copy their key Secret into the namespace of the grant
+	// +kubebuilder:validation:MinItems=1
+	From []KeyDeliveryGrantFrom `json:"from"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since creation"
+
+// KeyDeliveryGrant allows VirtualKeys in other namespaces to copy their key Secret into its namespace. Like a
+// Gateway API ReferenceGrant it is created in the namespace receiving the copies, so that the owners of a
+// namespace decide which keys are delivered to it.
+type KeyDeliveryGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec KeyDeliveryGrantSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyDeliveryGrantList contains a list of KeyDeliveryGrant
+type KeyDeliveryGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyDeliveryGrant `json:"items"`
+}
+
+// Allows reports whether the grant allows the VirtualKey to copy its key Secret into the namespace of the grant
+func (g *KeyDeliveryGrant) Allows(namespace, name string) bool {
+	for _, from := range g.Spec.From {
+		if from.Namespace == namespace && (from.Name == "" || from.Name == name) {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	SchemeBuilder.Register(&KeyDeliveryGrant{}, &KeyDeliveryGrantList{})
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OrganizationSpec defines the desired state of Organization
+type OrganizationSpec struct {
+	// ConnectionRef defines how to connect to the LiteLLM instance
+	// +kubebuilder:validation:Required
+	ConnectionRef ConnectionRef `json:"connectionRef"`
+
+	// BudgetDuration - Budget is reset at the end of specified duration. If not set, budget is never reset. You can set duration as seconds ("30s"), minutes ("30m"), hours ("30h"), days ("30d"), months ("1mo").
+	BudgetDuration string `json:"budgetDuration,omitempty"`
+	// MaxBudget is the maximum budget for the organization
+	MaxBudget string `json:"maxBudget,omitempty"`
+	// Metadata is the metadata of the organization
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Models is the list of models the organization's teams can use. If empty, assumes all models are allowed.
+	Models []string `json:"models,omitempty"`
+	// OrganizationAlias is the alias of the organization
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="OrganizationAlias is immutable"
+	OrganizationAlias string `json:"organizationAlias,omitempty"`
+	// OrganizationID is the ID of the organization. If not set, a unique ID will be generated.
+	OrganizationID string `json:"organizationID,omitempty"`
+	// RPMLimit is the maximum requests per minute limit for the organization
+	RPMLimit int `json:"rpmLimit,omitempty"`
+	// Tags for tracking spend and/or doing tag-based routing. Requires Enterprise license
+	Tags []string `json:"tags,omitempty"`
+	// TPMLimit is the maximum tokens per minute limit for the organization
+	TPMLimit int `json:"tpmLimit,omitempty"`
+}
+
+// OrganizationStatus defines the observed state of Organization
+type OrganizationStatus struct {
+	// ObservedGeneration is the most recent generation observed for this Organization. It corresponds to the
+	// Organization's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// BudgetDuration - Budget is reset at the end of specified duration. If not set, budget is never reset.
+	BudgetDuration string `json:"budgetDuration,omitempty"`
+	// BudgetID is the ID of the budget LiteLLM created for the organization
+	BudgetID string `json:"budgetID,omitempty"`
+	// CreatedAt is the date and time when the organization was created
+	CreatedAt string `json:"createdAt,omitempty"`
+	// MaxBudget is the maximum budget for the organization
+	MaxBudget string `json:"maxBudget,omitempty"`
+	// Models is the list of models the organization's teams can use
+	Models []string `json:"models,omitempty"`
+	// OrganizationAlias is the alias of the organization
+	OrganizationAlias string `json:"organizationAlias,omitempty"`
+	// OrganizationID is the ID of the organization
+	OrganizationID string `json:"organizationID,omitempty"`
+	// RPMLimit is the maximum requests per minute limit for the organization
+	RPMLimit int `json:"rpmLimit,omitempty"`
+	// Spend is the current spend of the organization
+	Spend string `json:"spend,omitempty"`
+	// TPMLimit is the maximum tokens per minute limit for the organization
+	TPMLimit int `json:"tpmLimit,omitempty"`
+	// UpdatedAt is the date and time when the organization was last updated
+	UpdatedAt string `json:"updatedAt,omitempty"`
+
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="The ready status of the organization"
+// +kubebuilder:printcolumn:name="Alias",type="string",JSONPath=".spec.organizationAlias",description="The organization alias"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.organizationID",description="The organization ID in LiteLLM"
+// +kubebuilder:printcolumn:name="Budget",type="string",JSONPath=".spec.maxBudget",description="Maximum budget for the organization"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since creation"
+
+// Organization is the Schema for the organizations API
+type Organization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationSpec   `json:"spec,omitempty"`
+	Status OrganizationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationList contains a list of Organization
+type OrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Organization `json:"items"`
+}
+
+// GetConditions returns the conditions slice
+func (o *Organization) GetConditions() []metav1.Condition {
+	return o.Status.Conditions
+}
+
+// SetConditions sets the conditions slice
+func (o *Organization) SetConditions(conditions []metav1.Condition) {
+	o.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&Organization{}, &OrganizationList{})
+}
@@ -130,6 +130,7 @@ func (i *InstanceRef) GetNamespace() string {
 }
 
 // TeamSpec defines the desired state of Team
+// +kubebuilder:validation:XValidation:rule="!(has(self.organizationID) && has(self.organizationRef))",message="organizationID and organizationRef are mutually exclusive"
 type TeamSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
@@ -142,8 +143,14 @@ type TeamSpec struct {
 	Blocked bool `json:"blocked,omitempty"`
 	// BudgetDuration - Budget is reset at the end of specified duration. If not set, budget is never reset. You can set duration as seconds ("30s"), minutes ("30m"), hours ("30h"), days ("30d"), months ("1mo").
 	BudgetDuration string `json:"budgetDuration,omitempty"`
+	// BudgetRef references a Budget resource whose limits are copied to the team, replacing maxBudget,
+	// budgetDuration, rpmLimit and tpmLimit. A Budget setting softBudget, maxParallelRequests or modelMaxBudget
+	// is refused. The namespace defaults to the Team's namespace
+	BudgetRef *CRDRef `json:"budgetRef,omitempty"`
 	// Guardrails are guardrails for the team
 	Guardrails []string `json:"guardrails,omitempty"`
+	// LoggingCallbacks overrides the logging callbacks of the team. Removing it disables the callbacks synced by the operator
+	LoggingCallbacks *TeamLoggingCallbacks `json:"loggingCallbacks,omitempty"`
 	// MaxBudget is the maximum budget for the team
 	MaxBudget string `json:"maxBudget,omitempty"`
 	// Metadata is the metadata of the team
@@ -154,6 +161,8 @@ type TeamSpec struct {
 	Models []string `json:"models,omitempty"`
 	// OrganizationID is the ID of the organization that the team belongs to. If not set, the team will be created with no organization.
 	OrganizationID string `json:"organizationID,omitempty"`
+	// OrganizationRef references an Organization resource whose ID the team is created under. The namespace defaults to the Team's namespace
+	OrganizationRef *CRDRef `json:"organizationRef,omitempty"`
 	// RPMLimit is the maximum requests per minute limit for the team - all keys associated with this team_id will have at max this RPM limit
 	RPMLimit int `json:"rpmLimit,omitempty"`
 	// Tags for tracking spend and/or doing tag-based routing. Requires Enterprise license
@@ -170,6 +179,29 @@ type TeamSpec struct {
 	TPMLimit int `json:"tpmLimit,omitempty"`
 }
 
+// TeamLoggingCallbacks defines the logging callbacks run for the requests of a team
+type TeamLoggingCallbacks struct {
+	// Callbacks are the callbacks run for the team, replacing the callbacks set on the team outside the operator
+	// +listType=map
+	// +listMapKey=name
+	Callbacks []TeamCallback `json:"callbacks,omitempty"`
+	// Vars are the non-secret callback variables, e.g. langfuse_host
+	Vars map[string]string `json:"vars,omitempty"`
+	// VarsSecretName is the name of a Secret in the Team's namespace whose keys are added to the callback variables, e.g. langfuse_secret_key
+	VarsSecretName string `json:"varsSecretName,omitempty"`
+}
+
+// TeamCallback defines a logging callback of a team
+type TeamCallback struct {
+	// Name is the name of the callback, e.g. langfuse
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Type selects whether the callback runs on successful requests, failed requests or both
+	// +kubebuilder:default=success_and_failure
+	// +kubebuilder:validation:Enum=success;failure;success_and_failure
+	Type string `json:"type,omitempty"`
+}
+
 // TeamStatus defines the observed state of Team
 type TeamStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -186,10 +218,14 @@ type TeamStatus struct {
 	BudgetDuration string `json:"budgetDuration,omitempty"`
 	// BudgetResetAt is the date and time when the budget will be reset
 	BudgetResetAt string `json:"budgetResetAt,omitempty"`
+	// CallbackVarsChecksum is a hash of the callback variables secret last pushed to LiteLLM
+	CallbackVarsChecksum string `json:"callbackVarsChecksum,omitempty"`
 	// CreatedAt is the date and time when the team was created
 	CreatedAt string `json:"createdAt,omitempty"`
 	// LiteLLMModelTable is the model table for the team
 	LiteLLMModelTable string `json:"liteLLMModelTable,omitempty"`
+	// MCPServers are the IDs of the MCP servers the operator granted the team access to
+	MCPServers []string `json:"mcpServers,omitempty"`
 	// MaxBudget is the maximum budget for the team
 	MaxBudget string `json:"maxBudget,omitempty"`
 	// MaxParallelRequests is the maximum number of parallel requests allowed
@@ -24,6 +24,7 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // TeamMemberAssociationSpec defines the desired state of TeamMemberAssociation
+// +kubebuilder:validation:XValidation:rule="!(has(self.maxBudgetInTeam) && has(self.budgetRef))",message="maxBudgetInTeam and budgetRef are mutually exclusive"
 type TeamMemberAssociationSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
@@ -32,6 +33,9 @@ type TeamMemberAssociationSpec struct {
 	// +kubebuilder:validation:Required
 	ConnectionRef ConnectionRef `json:"connectionRef"`
 
+	// BudgetRef references a Budget resource whose maxBudget is copied to the user's budget in the team.
+	// A Budget setting modelMaxBudget is refused. The namespace defaults to the TeamMemberAssociation's namespace
+	BudgetRef *CRDRef `json:"budgetRef,omitempty"`
 	// MaxBudgetInTeam is the maximum budget for the user in the team
 	MaxBudgetInTeam string `json:"maxBudgetInTeam,omitempty"`
 	// Role is the role of the user - one of "admin" or "user"
@@ -41,6 +41,10 @@ type UserSpec struct {
 	Blocked bool `json:"blocked,omitempty"`
 	// BudgetDuration - Budget is reset at the end of specified duration. If not set, budget is never reset. You can set duration as seconds ("30s"), minutes ("30m"), hours ("30h"), days ("30d"), months ("1mo").
 	BudgetDuration string `json:"budgetDuration,omitempty"`
+	// BudgetRef references a Budget resource whose limits are copied to the user, replacing maxBudget, softBudget,
+	// budgetDuration, maxParallelRequests, rpmLimit and tpmLimit. A Budget setting modelMaxBudget is refused.
+	// The namespace defaults to the User's namespace
+	BudgetRef *CRDRef `json:"budgetRef,omitempty"`
 	// Duration is the duration for the key auto-created on /user/new
 	Duration string `json:"duration,omitempty"`
 	// Guardrails is the list of active guardrails for the user
@@ -65,6 +69,10 @@ type UserSpec struct {
 	Permissions map[string]string `json:"permissions,omitempty"`
 	// RPMLimit is the maximum requests per minute for the user
 	RPMLimit int `json:"rpmLimit,omitempty"`
+	// SecretStoreRef also writes the key Secret of the auto-created key to an external secret store
+	SecretStoreRef *SecretStoreRef `json:"secretStoreRef,omitempty"`
+	// SecretTemplate customises the Secret the auto-created key is written to
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
 	// SendInviteEmail is whether to send an invite email to the user - NOTE: the user endpoint will return an error if email alerting is not configured and this is enabled, but the user will still be created.
 	SendInviteEmail bool `json:"sendInviteEmail,omitempty"`
 	// SoftBudget - alert when user exceeds this budget, doesn't block requests
@@ -145,6 +153,12 @@ type UserStatus struct {
 	Permissions map[string]string `json:"permissions,omitempty"`
 	// RPMLimit is the maximum requests per minute
 	RPMLimit int `json:"rpmLimit,omitempty"`
+	// SecretStoreChecksum is the checksum of the key Secret data last written to the secret store
+	SecretStoreChecksum string `json:"secretStoreChecksum,omitempty"`
+	// SecretStorePath is the path the key Secret was last written to in the secret store
+	SecretStorePath string `json:"secretStorePath,omitempty"`
+	// SecretStoreRef is the secret store the key Secret was last written to
+	SecretStoreRef *SecretStoreRef `json:"secretStoreRef,omitempty"`
 	// Spend is the amount spent by user
 	Spend string `json:"spend,omitempty"`
 	// Tags for tracking spend and/or doing tag-based routing. Requires Enterprise license
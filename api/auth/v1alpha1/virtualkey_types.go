@@ -24,6 +24,7 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // VirtualKeySpec defines the desired state of VirtualKey
+// +kubebuilder:validation:XValidation:rule="!(has(self.budgetID) && has(self.budgetRef))",message="budgetID and budgetRef are mutually exclusive"
 type VirtualKeySpec struct {
 	// ConnectionRef defines how to connect to the LiteLLM instance
 	// +kubebuilder:validation:Required
@@ -41,6 +42,8 @@ type VirtualKeySpec struct {
 	BudgetDuration string `json:"budgetDuration,omitempty"`
 	// BudgetID is the identifier for the budget
 	BudgetID string `json:"budgetID,omitempty"`
+	// BudgetRef references a Budget resource whose ID the key is linked to. The namespace defaults to the VirtualKey's namespace
+	BudgetRef *CRDRef `json:"budgetRef,omitempty"`
 	// Config contains additional configuration settings
 	Config map[string]string `json:"config,omitempty"`
 	// Duration specifies how long the key is valid
@@ -73,6 +76,19 @@ type VirtualKeySpec struct {
 	Permissions map[string]string `json:"permissions,omitempty"`
 	// RPMLimit sets global RPM limit
 	RPMLimit int `json:"rpmLimit,omitempty"`
+	// RecoveryPolicy sets what happens when the key Secret no longer holds the key in LiteLLM, e.g. after the Secret
+	// is deleted or the VirtualKey is restored from a backup. None reports the SecretOutOfSync condition, Regenerate
+	// regenerates the key and writes the new key to the Secret
+	// +kubebuilder:validation:Enum=None;Regenerate
+	// +kubebuilder:default=None
+	// +optional
+	RecoveryPolicy string `json:"recoveryPolicy,omitempty"`
+	// Rotation regenerates the key on an interval, keeping the previous key valid for a grace period
+	Rotation *KeyRotation `json:"rotation,omitempty"`
+	// SecretStoreRef also writes the key Secret to an external secret store
+	SecretStoreRef *SecretStoreRef `json:"secretStoreRef,omitempty"`
+	// SecretTemplate customises the Secret the key is written to
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
 	// SendInviteEmail indicates whether to send an invite email
 	SendInviteEmail bool `json:"sendInviteEmail,omitempty"`
 	// SoftBudget sets a soft budget limit
@@ -81,6 +97,11 @@ type VirtualKeySpec struct {
 	Spend string `json:"spend,omitempty"`
 	// Tags for tracking spend and/or doing tag-based routing. Requires Enterprise license
 	Tags []string `json:"tags,omitempty"`
+	// TargetNamespaces are other namespaces the key Secret is copied into. Each namespace must allow the copy with a
+	// KeyDeliveryGrant
+	// +listType=set
+	// +optional
+	TargetNamespaces []string `json:"targetNamespaces,omitempty"`
 	// TeamID identifies the team associated with the key
 	TeamID string `json:"teamID,omitempty"`
 	// TPMLimit sets global TPM limit
@@ -89,6 +110,91 @@ type VirtualKeySpec struct {
 	UserID string `json:"userID,omitempty"`
 }
 
+// KeyRotation defines when a virtual key is regenerated
+type KeyRotation struct {
+	// Interval between rotations of the key, e.g. 2160h for 90 days.
+	// Without an interval the key is only rotated through the litellm.ai/rotate annotation
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// GracePeriod the previous key remains valid, and in the key Secret as previousKey, after a rotation
+	// +kubebuilder:default="24h"
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// SecretTemplate customises the Secret a generated key is written to. The key is always written to the key field
+// +kubebuilder:validation:XValidation:rule="!has(self.format) || (has(self.data) && size(self.data) > 0)",message="format requires data"
+// +kubebuilder:validation:XValidation:rule="!has(self.data) || !('key' in self.data || 'previousKey' in self.data)",message="data cannot set the reserved key and previousKey entries"
+// +kubebuilder:validation:XValidation:rule="!has(self.formatKey) || !(self.formatKey in ['key', 'previousKey'])",message="formatKey cannot be the reserved key or previousKey entry"
+type SecretTemplate struct {
+	// Name of the Secret. Defaults to <instance>-key-<keyAlias>
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Labels added to the Secret
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations added to the Secret
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Data adds keys to the Secret. The values are Go templates over .Key, .KeyAlias, .TeamID, .UserID and .URL,
+	// the URL of the LiteLLM connection, e.g. OPENAI_API_KEY: "{{ .Key }}" and OPENAI_BASE_URL: "{{ .URL }}". The key and
+	// previousKey entries are reserved
+	// +optional
+	Data map[string]string `json:"data,omitempty"`
+	// Format additionally renders the data keys into a single document: env for a .env file or json for a JSON object
+	// +kubebuilder:validation:Enum=env;json
+	// +optional
+	Format string `json:"format,omitempty"`
+	// FormatKey is the Secret key holding the rendered document. Defaults to .env or config.json
+	// +optional
+	FormatKey string `json:"formatKey,omitempty"`
+}
+
+// SecretStoreRef writes the key Secret to an external secret store, for consumers outside the cluster
+// +kubebuilder:validation:XValidation:rule="has(self.vault) != has(self.pushSecret)",message="exactly one of vault or pushSecret must be set"
+type SecretStoreRef struct {
+	// Vault writes the key Secret data to the Vault KV secrets engine of a ClusterVaultStore
+	// +optional
+	Vault *VaultStoreRef `json:"vault,omitempty"`
+	// PushSecret emits an External Secrets Operator PushSecret pushing the key Secret to a SecretStore
+	// +optional
+	PushSecret *PushSecretStore `json:"pushSecret,omitempty"`
+}
+
+// VaultStoreRef writes the key Secret to the Vault KV secrets engine of a ClusterVaultStore
+type VaultStoreRef struct {
+	// Name of the ClusterVaultStore
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Path of the secret below <pathPrefix>/<namespace> of the store. Defaults to <keyAlias>
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-][A-Za-z0-9_.-]*(/[A-Za-z0-9_-][A-Za-z0-9_.-]*)*$`
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// PushSecretStore is a SecretStore of the External Secrets Operator
+type PushSecretStore struct {
+	// StoreName is the name of the SecretStore or ClusterSecretStore
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	StoreName string `json:"storeName"`
+	// StoreKind is the kind of the store
+	// +kubebuilder:validation:Enum=SecretStore;ClusterSecretStore
+	// +kubebuilder:default=SecretStore
+	// +optional
+	StoreKind string `json:"storeKind,omitempty"`
+	// RemoteKey the key Secret is pushed to, below <namespace>. Defaults to <keyAlias>
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-][A-Za-z0-9_.-]*(/[A-Za-z0-9_-][A-Za-z0-9_.-]*)*$`
+	// +optional
+	RemoteKey string `json:"remoteKey,omitempty"`
+	// RefreshInterval of the PushSecret
+	// +kubebuilder:default="1h"
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
 // VirtualKeyStatus defines the observed state of VirtualKey
 type VirtualKeyStatus struct {
 	// Aliases maps additional aliases for the key
@@ -111,6 +217,8 @@ type VirtualKeyStatus struct {
 	CreatedAt string `json:"createdAt,omitempty"`
 	// CreatedBy tracks who created the key
 	CreatedBy string `json:"createdBy,omitempty"`
+	// DeliveredNamespaces are the namespaces holding a copy of the key Secret
+	DeliveredNamespaces []string `json:"deliveredNamespaces,omitempty"`
 	// Duration specifies how long the key is valid
 	Duration string `json:"duration,omitempty"`
 	// EnforcedParams lists parameters that must be included in requests
@@ -121,24 +229,44 @@ type VirtualKeyStatus struct {
 	Guardrails []string `json:"guardrails,omitempty"`
 	// KeyAlias is the user defined key alias
 	KeyAlias string `json:"keyAlias,omitempty"`
+	// KeyHash is the SHA-256 hash of the key written to the key Secret, as LiteLLM hashes it
+	KeyHash string `json:"keyHash,omitempty"`
 	// KeyID is the generated ID of the key
 	KeyID string `json:"keyID,omitempty"`
 	// KeyName is the redacted secret key
 	KeyName string `json:"keyName,omitempty"`
 	// KeySecretRef is the reference to the secret containing the key
 	KeySecretRef string `json:"keySecretRef,omitempty"`
+	// LastRotatedAt is the time the key was last regenerated
+	LastRotatedAt *metav1.Time `json:"lastRotatedAt,omitempty"`
 	// LiteLLMBudgetTable is the budget table reference
 	LiteLLMBudgetTable string `json:"liteLLMBudgetTable,omitempty"`
+	// MCPServers are the IDs of the MCP servers the operator granted the key access to
+	MCPServers []string `json:"mcpServers,omitempty"`
 	// MaxBudget is the maximum budget for the key
 	MaxBudget string `json:"maxBudget,omitempty"`
 	// MaxParallelRequests limits concurrent requests
 	MaxParallelRequests int `json:"maxParallelRequests,omitempty"`
 	// Models specifies which models can be used
 	Models []string `json:"models,omitempty"`
+	// NextRotationAt is the time the key is next regenerated by the rotation interval
+	NextRotationAt *metav1.Time `json:"nextRotationAt,omitempty"`
 	// Permissions defines key permissions
 	Permissions map[string]string `json:"permissions,omitempty"`
+	// PreviousKeyID is the ID of the key replaced by the last rotation, until it is revoked
+	PreviousKeyID string `json:"previousKeyID,omitempty"`
+	// PreviousKeyRevokeAt is the time the previous key is revoked
+	PreviousKeyRevokeAt *metav1.Time `json:"previousKeyRevokeAt,omitempty"`
+	// RotationTrigger is the value of the rotate annotation that triggered the last rotation
+	RotationTrigger string `json:"rotationTrigger,omitempty"`
 	// RPMLimit sets global RPM limit
 	RPMLimit int `json:"rpmLimit,omitempty"`
+	// SecretStoreChecksum is the checksum of the key Secret data last written to the secret store
+	SecretStoreChecksum string `json:"secretStoreChecksum,omitempty"`
+	// SecretStorePath is the path the key Secret was last written to in the secret store
+	SecretStorePath string `json:"secretStorePath,omitempty"`
+	// SecretStoreRef is the secret store the key Secret was last written to
+	SecretStoreRef *SecretStoreRef `json:"secretStoreRef,omitempty"`
 	// Spend tracks the current spend amount
 	Spend string `json:"spend,omitempty"`
 	// Tags for tracking spend and/or doing tag-based routing. Requires Enterprise license
@@ -0,0 +1,94 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CredentialSpec defines the desired state of Credential.
+type CredentialSpec struct {
+	// ConnectionRef is the connection reference
+	ConnectionRef ConnectionRef `json:"connectionRef,omitempty"`
+
+	// CredentialName is the name of the credential in LiteLLM, defaults to the name of the resource.
+	// Models reference it through litellmParams.litellmCredentialName
+	// +optional
+	CredentialName string `json:"credentialName,omitempty"`
+
+	// CredentialSecretRef references the Secret whose keys are synced as the credential values, e.g. api_key and api_base
+	CredentialSecretRef SecretRef `json:"credentialSecretRef"`
+
+	// CredentialInfo contains non-secret information stored with the credential, e.g. custom_llm_provider
+	// +optional
+	CredentialInfo map[string]string `json:"credentialInfo,omitempty"`
+}
+
+// CredentialStatus defines the observed state of Credential.
+type CredentialStatus struct {
+	// ObservedGeneration represents the .metadata.generation that the condition was set based upon
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastUpdated represents the last time the status was updated
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+	// Conditions represent the latest available observations of the credential's state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// CredentialName is the name of the credential in the litellm server
+	CredentialName string `json:"credentialName,omitempty"`
+
+	// SecretChecksum is a hash of the credential secret last pushed to the litellm server
+	SecretChecksum string `json:"secretChecksum,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Credential Name",type="string",JSONPath=".status.credentialName",description="Name of the credential in LiteLLM"
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.credentialSecretRef.secretName",description="Credential secret name"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Ready status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age of the credential"
+
+// Credential is the Schema for the credentials API.
+type Credential struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CredentialSpec   `json:"spec,omitempty"`
+	Status CredentialStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CredentialList contains a list of Credential.
+type CredentialList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Credential `json:"items"`
+}
+
+// GetConditions returns the conditions slice
+func (c *Credential) GetConditions() []metav1.Condition {
+	return c.Status.Conditions
+}
+
+// SetConditions sets the conditions slice
+func (c *Credential) SetConditions(conditions []metav1.Condition) {
+	c.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&Credential{}, &CredentialList{})
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GuardrailSpec defines the desired state of Guardrail.
+type GuardrailSpec struct {
+	// ConnectionRef is the connection reference
+	ConnectionRef ConnectionRef `json:"connectionRef,omitempty"`
+
+	// GuardrailName is the name of the guardrail in LiteLLM, defaults to the name of the resource.
+	// Teams, users and virtual keys reference it through their guardrails list
+	// +optional
+	GuardrailName string `json:"guardrailName,omitempty"`
+
+	// Provider is the guardrail integration, e.g. aporia, bedrock, lakera_v2 or presidio
+	// +kubebuilder:validation:MinLength=1
+	Provider string `json:"provider"`
+
+	// Mode is when the guardrail runs
+	// +kubebuilder:validation:Enum=pre_call;post_call;during_call;logging_only
+	// +kubebuilder:default=pre_call
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// DefaultOn runs the guardrail on every request, not only those that ask for it
+	// +optional
+	DefaultOn bool `json:"defaultOn,omitempty"`
+
+	// Params contains non-secret provider parameters passed as litellm_params, e.g. api_base
+	// +optional
+	Params map[string]string `json:"params,omitempty"`
+
+	// ParamsSecretRef references a Secret whose keys are passed as litellm_params, e.g. api_key
+	// +optional
+	ParamsSecretRef *SecretRef `json:"paramsSecretRef,omitempty"`
+}
+
+// GuardrailStatus defines the observed state of Guardrail.
+type GuardrailStatus struct {
+	// ObservedGeneration represents the .metadata.generation that the condition was set based upon
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastUpdated represents the last time the status was updated
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+	// Conditions represent the latest available observations of the guardrail's state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// GuardrailID is the ID of the guardrail in the litellm server
+	GuardrailID string `json:"guardrailId,omitempty"`
+	// GuardrailName is the name of the guardrail in the litellm server
+	GuardrailName string `json:"guardrailName,omitempty"`
+
+	// SecretChecksum is a hash of the params secret last pushed to the litellm server
+	SecretChecksum string `json:"secretChecksum,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Guardrail Name",type="string",JSONPath=".status.guardrailName",description="Name of the guardrail in LiteLLM"
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.provider",description="Guardrail provider"
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=".spec.mode",description="When the guardrail runs"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Ready status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age of the guardrail"
+
+// Guardrail is the Schema for the guardrails API.
+type Guardrail struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GuardrailSpec   `json:"spec,omitempty"`
+	Status GuardrailStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GuardrailList contains a list of Guardrail.
+type GuardrailList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Guardrail `json:"items"`
+}
+
+// GetConditions returns the conditions slice
+func (g *Guardrail) GetConditions() []metav1.Condition {
+	return g.Status.Conditions
+}
+
+// SetConditions sets the conditions slice
+func (g *Guardrail) SetConditions(conditions []metav1.Condition) {
+	g.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&Guardrail{}, &GuardrailList{})
+}
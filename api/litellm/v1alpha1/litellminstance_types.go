@@ -17,12 +17,17 @@ limitations under the License.
 package v1alpha1
 
 import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // LiteLLMInstanceSpec defines the desired state of LiteLLMInstance.
+// +kubebuilder:validation:XValidation:rule="!has(self.upgradeStrategy) || !has(self.upgradeStrategy.type) || self.upgradeStrategy.type != 'Canary' || (has(self.gateway) && has(self.gateway.enabled) && self.gateway.enabled)",message="the Canary upgrade strategy requires gateway.enabled"
 type LiteLLMInstanceSpec struct {
 	// +kubebuilder:default="ghcr.io/berriai/litellm-database:main-v1.74.9.rc.1"
 	Image             string            `json:"image"`
@@ -36,6 +41,511 @@ type LiteLLMInstanceSpec struct {
 	Replicas     int32               `json:"replicas,omitempty"`
 	Models       []InitModelInstance `json:"models,omitempty"`
 	ExtraEnvVars []corev1.EnvVar     `json:"extraEnvVars,omitempty"`
+
+	// PodTemplate holds overrides merged over the generated Deployment pod template
+	PodTemplate *PodTemplate `json:"podTemplate,omitempty"`
+	// Autoscaling configures a HorizontalPodAutoscaler for the LiteLLM Deployment
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
+	// PodDisruptionBudget configures a PodDisruptionBudget for the LiteLLM pods
+	PodDisruptionBudget *PodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+	// Database configures a database provisioned by the operator as an alternative to databaseSecretRef
+	Database *Database `json:"database,omitempty"`
+	// Migrations runs database migrations in a Job before a new image is rolled out
+	Migrations *Migrations `json:"migrations,omitempty"`
+	// UpgradeStrategy controls how a new image is rolled out to the LiteLLM pods
+	UpgradeStrategy *UpgradeStrategy `json:"upgradeStrategy,omitempty"`
+
+	// GeneralSettings is rendered into the general_settings section of the proxy config
+	GeneralSettings *GeneralSettings `json:"generalSettings,omitempty"`
+	// LitellmSettings is rendered into the litellm_settings section of the proxy config
+	LitellmSettings *LitellmSettings `json:"litellmSettings,omitempty"`
+	// RouterSettings is rendered into the router_settings section of the proxy config
+	RouterSettings *RouterSettings `json:"routerSettings,omitempty"`
+	// Integrations configures the logging and observability callbacks of the proxy
+	// +listType=map
+	// +listMapKey=type
+	Integrations []LoggingIntegration `json:"integrations,omitempty"`
+	// Cache configures the response cache of the proxy, rendered into litellm_settings.cache_params
+	Cache *Cache `json:"cache,omitempty"`
+	// ConfigOverrides is a YAML document deep-merged into the rendered proxy config. Maps are merged
+	// key by key and any other value, including lists, replaces the rendered one.
+	ConfigOverrides string `json:"configOverrides,omitempty"`
+}
+
+// GeneralSettings defines the proxy server settings of the general_settings section.
+type GeneralSettings struct {
+	// AllowRequestsOnDBUnavailable keeps serving requests while the database is unreachable
+	// +kubebuilder:default=true
+	AllowRequestsOnDBUnavailable *bool `json:"allowRequestsOnDBUnavailable,omitempty"`
+	// StoreModelInDB stores models in the database; required for the Model resources
+	// +kubebuilder:default=true
+	StoreModelInDB *bool `json:"storeModelInDB,omitempty"`
+	// MaxParallelRequests is the maximum number of parallel requests per deployment
+	// +kubebuilder:validation:Minimum=1
+	MaxParallelRequests *int `json:"maxParallelRequests,omitempty"`
+	// GlobalMaxParallelRequests is the maximum number of parallel requests across the proxy
+	// +kubebuilder:validation:Minimum=1
+	GlobalMaxParallelRequests *int `json:"globalMaxParallelRequests,omitempty"`
+	// Alerting lists the alerting integrations, e.g. slack or email
+	Alerting []string `json:"alerting,omitempty"`
+	// AlertingThreshold is the number of seconds after which a request is reported as hanging
+	// +kubebuilder:validation:Minimum=1
+	AlertingThreshold *int `json:"alertingThreshold,omitempty"`
+	// AlertTypes restricts alerting to the listed alert types
+	AlertTypes []string `json:"alertTypes,omitempty"`
+	// BackgroundHealthChecks enables periodic health checks of the models
+	BackgroundHealthChecks *bool `json:"backgroundHealthChecks,omitempty"`
+	// HealthCheckInterval is the number of seconds between background health checks
+	// +kubebuilder:validation:Minimum=1
+	HealthCheckInterval *int `json:"healthCheckInterval,omitempty"`
+	// DisableSpendLogs stops writing spend logs to the database
+	DisableSpendLogs *bool `json:"disableSpendLogs,omitempty"`
+	// MaxRequestSizeMB limits the size of a request body
+	// +kubebuilder:validation:Minimum=1
+	MaxRequestSizeMB *int `json:"maxRequestSizeMB,omitempty"`
+	// MaxResponseSizeMB limits the size of a response body
+	// +kubebuilder:validation:Minimum=1
+	MaxResponseSizeMB *int `json:"maxResponseSizeMB,omitempty"`
+	// DatabaseConnectionPoolLimit is the size of the database connection pool of each worker
+	// +kubebuilder:validation:Minimum=1
+	DatabaseConnectionPoolLimit *int `json:"databaseConnectionPoolLimit,omitempty"`
+	// ProxyBatchWriteAt is the number of seconds between batched spend writes to the database
+	// +kubebuilder:validation:Minimum=1
+	ProxyBatchWriteAt *int `json:"proxyBatchWriteAt,omitempty"`
+}
+
+// LitellmSettings defines the LiteLLM module settings of the litellm_settings section.
+type LitellmSettings struct {
+	// Callbacks lists the callbacks run on both successful and failed requests
+	Callbacks []string `json:"callbacks,omitempty"`
+	// SuccessCallback lists the callbacks run on successful requests
+	SuccessCallback []string `json:"successCallback,omitempty"`
+	// FailureCallback lists the callbacks run on failed requests
+	FailureCallback []string `json:"failureCallback,omitempty"`
+	// ServiceCallback lists the callbacks reporting the health of the proxy services
+	ServiceCallback []string `json:"serviceCallback,omitempty"`
+	// DropParams drops the parameters a provider does not support instead of failing the request
+	DropParams *bool `json:"dropParams,omitempty"`
+	// RequestTimeout is the number of seconds after which a call to a provider times out
+	// +kubebuilder:validation:Minimum=1
+	RequestTimeout *int `json:"requestTimeout,omitempty"`
+	// NumRetries is the number of retries of a failed call to a provider
+	// +kubebuilder:validation:Minimum=0
+	NumRetries *int `json:"numRetries,omitempty"`
+	// SetVerbose enables debug logging
+	SetVerbose *bool `json:"setVerbose,omitempty"`
+	// JSONLogs writes the proxy logs as JSON
+	JSONLogs *bool `json:"jsonLogs,omitempty"`
+	// Cache enables response caching
+	Cache *bool `json:"cache,omitempty"`
+	// CacheParams is rendered as the cache_params of the response cache
+	CacheParams *runtime.RawExtension `json:"cacheParams,omitempty"`
+}
+
+// RouterSettings defines how requests are routed across the model deployments.
+type RouterSettings struct {
+	// RoutingStrategy selects the deployment serving a request
+	// +kubebuilder:validation:Enum=simple-shuffle;least-busy;usage-based-routing;usage-based-routing-v2;latency-based-routing;cost-based-routing
+	RoutingStrategy string `json:"routingStrategy,omitempty"`
+	// NumRetries is the number of retries of a failed request across deployments
+	// +kubebuilder:validation:Minimum=0
+	NumRetries *int `json:"numRetries,omitempty"`
+	// Timeout is the number of seconds after which a request times out
+	// +kubebuilder:validation:Minimum=1
+	Timeout *int `json:"timeout,omitempty"`
+	// AllowedFails is the number of failures within a minute before a deployment is cooled down
+	// +kubebuilder:validation:Minimum=0
+	AllowedFails *int `json:"allowedFails,omitempty"`
+	// CooldownTime is the number of seconds a failing deployment is cooled down for
+	// +kubebuilder:validation:Minimum=0
+	CooldownTime *int `json:"cooldownTime,omitempty"`
+	// RetryAfter is the minimum number of seconds to wait before retrying a request
+	// +kubebuilder:validation:Minimum=0
+	RetryAfter *int `json:"retryAfter,omitempty"`
+	// Fallbacks lists the models tried when a model fails
+	Fallbacks []ModelFallback `json:"fallbacks,omitempty"`
+	// ContextWindowFallbacks lists the models tried when a request exceeds the context window of a model
+	ContextWindowFallbacks []ModelFallback `json:"contextWindowFallbacks,omitempty"`
+	// ContentPolicyFallbacks lists the models tried when a model rejects a request on its content policy
+	ContentPolicyFallbacks []ModelFallback `json:"contentPolicyFallbacks,omitempty"`
+	// DefaultFallbacks lists the models tried when a model without fallbacks fails
+	DefaultFallbacks []string `json:"defaultFallbacks,omitempty"`
+	// EnablePreCallChecks filters out deployments whose context window is too small before routing
+	EnablePreCallChecks *bool `json:"enablePreCallChecks,omitempty"`
+	// ModelGroupAlias maps model names to the model group serving them
+	ModelGroupAlias map[string]string `json:"modelGroupAlias,omitempty"`
+}
+
+// ModelFallback defines the ordered fallback models of a model.
+type ModelFallback struct {
+	// Model is the name of the model the fallbacks apply to
+	// +kubebuilder:validation:MinLength=1
+	Model string `json:"model"`
+	// Fallbacks are the names of the models tried in order
+	// +kubebuilder:validation:MinItems=1
+	Fallbacks []string `json:"fallbacks"`
+}
+
+// LoggingIntegration configures a logging callback of the proxy. The block matching the type holds its
+// settings, and credentials are read from Secrets in the namespace of the instance.
+// +kubebuilder:validation:XValidation:rule="self.type != 'langfuse' || has(self.langfuse)",message="langfuse must be set when type is langfuse"
+// +kubebuilder:validation:XValidation:rule="self.type != 'otel' || has(self.otel)",message="otel must be set when type is otel"
+// +kubebuilder:validation:XValidation:rule="self.type != 's3' || has(self.s3)",message="s3 must be set when type is s3"
+// +kubebuilder:validation:XValidation:rule="self.type != 'gcs' || has(self.gcs)",message="gcs must be set when type is gcs"
+// +kubebuilder:validation:XValidation:rule="self.type != 'datadog' || has(self.datadog)",message="datadog must be set when type is datadog"
+// +kubebuilder:validation:XValidation:rule="self.type != 'webhook' || has(self.webhook)",message="webhook must be set when type is webhook"
+type LoggingIntegration struct {
+	// Type selects the integration
+	// +kubebuilder:validation:Enum=langfuse;otel;s3;gcs;datadog;prometheus;webhook
+	Type string `json:"type"`
+	// Events selects whether successful requests, failed requests or both are logged
+	// +kubebuilder:default=success_and_failure
+	// +kubebuilder:validation:Enum=success;failure;success_and_failure
+	Events string `json:"events,omitempty"`
+	// Langfuse configures the langfuse integration
+	Langfuse *LangfuseIntegration `json:"langfuse,omitempty"`
+	// OpenTelemetry configures the otel integration
+	OpenTelemetry *OpenTelemetryIntegration `json:"otel,omitempty"`
+	// S3 configures the s3 integration
+	S3 *S3Integration `json:"s3,omitempty"`
+	// GCS configures the gcs integration
+	GCS *GCSIntegration `json:"gcs,omitempty"`
+	// Datadog configures the datadog integration
+	Datadog *DatadogIntegration `json:"datadog,omitempty"`
+	// Webhook configures the webhook integration, which posts each logged request as JSON
+	Webhook *WebhookIntegration `json:"webhook,omitempty"`
+}
+
+// LangfuseIntegration defines the Langfuse project requests are logged to.
+type LangfuseIntegration struct {
+	// Host is the URL of the Langfuse server, defaults to Langfuse Cloud
+	Host string `json:"host,omitempty"`
+	// PublicKeySecretRef selects the Secret key holding the Langfuse public key
+	PublicKeySecretRef corev1.SecretKeySelector `json:"publicKeySecretRef"`
+	// SecretKeySecretRef selects the Secret key holding the Langfuse secret key
+	SecretKeySecretRef corev1.SecretKeySelector `json:"secretKeySecretRef"`
+}
+
+// OpenTelemetryIntegration defines the OpenTelemetry collector traces are exported to.
+type OpenTelemetryIntegration struct {
+	// Exporter selects the protocol used to export traces
+	// +kubebuilder:default=otlp_http
+	// +kubebuilder:validation:Enum=otlp_http;otlp_grpc;console
+	Exporter string `json:"exporter,omitempty"`
+	// Endpoint is the URL of the collector
+	Endpoint string `json:"endpoint,omitempty"`
+	// HeadersSecretRef selects the Secret key holding the export headers as comma separated key=value pairs
+	HeadersSecretRef *corev1.SecretKeySelector `json:"headersSecretRef,omitempty"`
+}
+
+// S3Integration defines the S3 bucket requests are logged to.
+type S3Integration struct {
+	// BucketName is the name of the bucket
+	// +kubebuilder:validation:MinLength=1
+	BucketName string `json:"bucketName"`
+	// Region is the region of the bucket
+	Region string `json:"region,omitempty"`
+	// Path is the prefix of the logged objects
+	Path string `json:"path,omitempty"`
+	// EndpointURL overrides the S3 endpoint, e.g. for MinIO
+	EndpointURL string `json:"endpointURL,omitempty"`
+	// AccessKeyIDSecretRef selects the Secret key holding the AWS access key ID, the pod identity is used when empty
+	AccessKeyIDSecretRef *corev1.SecretKeySelector `json:"accessKeyIDSecretRef,omitempty"`
+	// SecretAccessKeySecretRef selects the Secret key holding the AWS secret access key
+	SecretAccessKeySecretRef *corev1.SecretKeySelector `json:"secretAccessKeySecretRef,omitempty"`
+}
+
+// GCSIntegration defines the Google Cloud Storage bucket requests are logged to.
+type GCSIntegration struct {
+	// BucketName is the name of the bucket
+	// +kubebuilder:validation:MinLength=1
+	BucketName string `json:"bucketName"`
+	// ServiceAccountSecretRef selects the Secret key holding a service account JSON key, which is mounted
+	// into the pods. Workload identity is used when empty
+	ServiceAccountSecretRef *corev1.SecretKeySelector `json:"serviceAccountSecretRef,omitempty"`
+}
+
+// DatadogIntegration defines the Datadog site requests are logged to.
+type DatadogIntegration struct {
+	// Site is the Datadog site, e.g. us5.datadoghq.com
+	Site string `json:"site,omitempty"`
+	// APIKeySecretRef selects the Secret key holding the Datadog API key
+	APIKeySecretRef corev1.SecretKeySelector `json:"apiKeySecretRef"`
+}
+
+// WebhookIntegration defines the HTTP endpoint requests are logged to.
+type WebhookIntegration struct {
+	// Endpoint is the URL the logs are posted to
+	// +kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+	// HeadersSecretRef selects the Secret key holding the headers sent with each request, passed to LiteLLM
+	// as GENERIC_LOGGER_HEADERS
+	HeadersSecretRef *corev1.SecretKeySelector `json:"headersSecretRef,omitempty"`
+}
+
+// Cache configures the response cache of the proxy. The redis caches connect through the Redis of
+// redisSecretRef, and the other backends read their credentials from Secrets in the namespace of the instance.
+// +kubebuilder:validation:XValidation:rule="self.type != 'qdrant-semantic' || has(self.qdrant)",message="qdrant must be set when type is qdrant-semantic"
+// +kubebuilder:validation:XValidation:rule="self.type != 's3' || has(self.s3)",message="s3 must be set when type is s3"
+// +kubebuilder:validation:XValidation:rule="!(self.type in ['redis-semantic', 'qdrant-semantic']) || has(self.similarityThreshold)",message="similarityThreshold must be set for the semantic caches"
+// +kubebuilder:validation:XValidation:rule="!has(self.embeddingModel) || !has(self.embeddingModelRef)",message="embeddingModel and embeddingModelRef are mutually exclusive"
+type Cache struct {
+	// Type selects the cache backend
+	// +kubebuilder:default=redis
+	// +kubebuilder:validation:Enum=redis;redis-semantic;qdrant-semantic;s3;local
+	Type string `json:"type,omitempty"`
+	// TTL is the number of seconds a response is cached for
+	// +kubebuilder:validation:Minimum=1
+	TTL *int `json:"ttl,omitempty"`
+	// Namespace prefixes the cache keys, so several instances can share a backend
+	Namespace string `json:"namespace,omitempty"`
+	// SupportedCallTypes limits caching to the listed call types
+	// +kubebuilder:validation:items:Enum=completion;acompletion;text_completion;atext_completion;embedding;aembedding;transcription;atranscription;rerank;arerank
+	SupportedCallTypes []string `json:"supportedCallTypes,omitempty"`
+	// SimilarityThreshold is the similarity, between 0 and 1, above which a semantic cache returns a cached response
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	SimilarityThreshold string `json:"similarityThreshold,omitempty"`
+	// EmbeddingModel is the modelName of the model in models embedding the prompts of a semantic cache
+	EmbeddingModel string `json:"embeddingModel,omitempty"`
+	// EmbeddingModelRef references the Model resource embedding the prompts of a semantic cache
+	EmbeddingModelRef *ResourceRef `json:"embeddingModelRef,omitempty"`
+	// Qdrant defines the Qdrant collection of the qdrant-semantic cache
+	Qdrant *QdrantCache `json:"qdrant,omitempty"`
+	// S3 defines the bucket of the s3 cache
+	S3 *S3Cache `json:"s3,omitempty"`
+}
+
+// QdrantCache defines the Qdrant collection semantic cache entries are stored in.
+type QdrantCache struct {
+	// APIBase is the URL of the Qdrant server
+	// +kubebuilder:validation:MinLength=1
+	APIBase string `json:"apiBase"`
+	// APIKeySecretRef selects the Secret key holding the Qdrant API key
+	APIKeySecretRef *corev1.SecretKeySelector `json:"apiKeySecretRef,omitempty"`
+	// CollectionName is the name of the collection, created by LiteLLM when missing
+	// +kubebuilder:validation:MinLength=1
+	CollectionName string `json:"collectionName"`
+	// QuantizationConfig selects how the vectors of the collection are quantized
+	// +kubebuilder:default=binary
+	// +kubebuilder:validation:Enum=binary;scalar;product
+	QuantizationConfig string `json:"quantizationConfig,omitempty"`
+}
+
+// S3Cache defines the S3 bucket responses are cached in.
+type S3Cache struct {
+	// BucketName is the name of the bucket
+	// +kubebuilder:validation:MinLength=1
+	BucketName string `json:"bucketName"`
+	// Region is the region of the bucket
+	Region string `json:"region,omitempty"`
+	// EndpointURL overrides the S3 endpoint, e.g. for MinIO
+	EndpointURL string `json:"endpointURL,omitempty"`
+	// AccessKeyIDSecretRef selects the Secret key holding the AWS access key ID, the pod identity is used when empty
+	AccessKeyIDSecretRef *corev1.SecretKeySelector `json:"accessKeyIDSecretRef,omitempty"`
+	// SecretAccessKeySecretRef selects the Secret key holding the AWS secret access key
+	SecretAccessKeySecretRef *corev1.SecretKeySelector `json:"secretAccessKeySecretRef,omitempty"`
+}
+
+// UpgradeStrategy defines how a change of image is rolled out. RollingUpdate replaces the pods of the
+// Deployment in place. Canary and BlueGreen start the new image in a second Deployment, promote it once
+// its pods are ready and the proxy reports healthy, and roll back automatically when it does not.
+// +kubebuilder:validation:XValidation:rule="self.type != 'Canary' || !has(self.blueGreen)",message="blueGreen can only be set with the BlueGreen type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'BlueGreen' || !has(self.canary)",message="canary can only be set with the Canary type"
+type UpgradeStrategy struct {
+	// Type of the upgrade strategy
+	// +kubebuilder:default=RollingUpdate
+	// +kubebuilder:validation:Enum=RollingUpdate;Canary;BlueGreen
+	Type string `json:"type,omitempty"`
+	// Canary configures the Canary strategy
+	Canary *CanaryStrategy `json:"canary,omitempty"`
+	// BlueGreen configures the BlueGreen strategy
+	BlueGreen *BlueGreenStrategy `json:"blueGreen,omitempty"`
+	// HealthCheck configures the proxy health check gating the promotion
+	HealthCheck *UpgradeHealthCheck `json:"healthCheck,omitempty"`
+	// ProgressDeadlineSeconds is how long the new image may take to become ready and healthy before it is rolled back
+	// +kubebuilder:default=600
+	// +kubebuilder:validation:Minimum=1
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+}
+
+// CanaryStrategy defines the canary Deployment running the new image next to the current one.
+type CanaryStrategy struct {
+	// Replicas is the number of canary pods
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Weight is the percentage of traffic sent to the canary through the HTTPRoute when the gateway is enabled.
+	// Without the gateway the Service balances traffic by the ratio of canary to stable pods.
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	Weight *int32 `json:"weight,omitempty"`
+	// AnalysisSeconds is how long the canary must stay healthy before it is promoted
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=0
+	AnalysisSeconds *int32 `json:"analysisSeconds,omitempty"`
+}
+
+// BlueGreenStrategy defines the preview Deployment running the new image without receiving traffic.
+type BlueGreenStrategy struct {
+	// AutoPromote switches the traffic to the new image once the preview is healthy.
+	// When false the promotion waits for the litellm.ai/promote annotation on the instance.
+	// +kubebuilder:default=true
+	AutoPromote *bool `json:"autoPromote,omitempty"`
+	// PromotionDelaySeconds is how long the preview must stay healthy before it is promoted
+	// +kubebuilder:default=60
+	// +kubebuilder:validation:Minimum=0
+	PromotionDelaySeconds *int32 `json:"promotionDelaySeconds,omitempty"`
+}
+
+// UpgradeHealthCheck defines the request sent to the proxy pods of the new image before promotion.
+type UpgradeHealthCheck struct {
+	// Path of the proxy health endpoint; the master key is sent as a bearer token. /health also checks every
+	// configured model, calling their providers
+	// +kubebuilder:default="/health/readiness"
+	Path string `json:"path,omitempty"`
+	// TimeoutSeconds limits the duration of a single health check request
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// UpgradeStatus records the progress of a Canary or BlueGreen upgrade.
+type UpgradeStatus struct {
+	// Strategy is the upgrade strategy used for the target image
+	Strategy string `json:"strategy,omitempty"`
+	// Phase of the upgrade: Progressing, Promoting, Succeeded, RolledBack or Aborted
+	Phase string `json:"phase,omitempty"`
+	// Step is the last step taken, reported as the reason of the Upgrading condition
+	Step string `json:"step,omitempty"`
+	// Message describes the last step taken
+	Message string `json:"message,omitempty"`
+	// TargetImage is the image being rolled out
+	TargetImage string `json:"targetImage,omitempty"`
+	// StartedAt is when the upgrade to the target image started
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// HealthySince is when the new image was first found ready and healthy
+	HealthySince *metav1.Time `json:"healthySince,omitempty"`
+}
+
+// Migrations defines the Job that applies the LiteLLM database migrations of a new image.
+// While migrations are enabled the Deployment keeps running the previous image until the
+// migration Job for the new image has succeeded, and the proxy pods skip schema updates on startup.
+type Migrations struct {
+	// Enabled indicates whether migrations run in a Job before the Deployment is rolled
+	Enabled bool `json:"enabled"`
+	// Command overrides the command run by the migration Job
+	Command []string `json:"command,omitempty"`
+	// BackoffLimit is the number of retries before the migration Job is marked as failed
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=0
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds limits how long the migration Job may run
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// Database defines how the database of the LiteLLM instance is provided.
+type Database struct {
+	// Managed provisions a PostgreSQL database owned by the instance
+	Managed *ManagedDatabase `json:"managed,omitempty"`
+}
+
+// ManagedDatabase defines a PostgreSQL database provisioned by the operator. The generated
+// credentials are stored in the <instance>-postgres-credentials Secret and wired into the
+// LiteLLM container automatically.
+type ManagedDatabase struct {
+	// Enabled indicates whether the operator should provision the database
+	Enabled bool `json:"enabled"`
+	// Provider selects how the database is provisioned; auto uses CloudNativePG when its CRDs are installed
+	// +kubebuilder:default=auto
+	// +kubebuilder:validation:Enum=auto;statefulset;cloudnativepg
+	Provider string `json:"provider,omitempty"`
+	// Image is the PostgreSQL image; defaults to postgres:16-alpine for the StatefulSet and the operator default for CloudNativePG
+	Image string `json:"image,omitempty"`
+	// DatabaseName is the name of the database created for LiteLLM
+	// +kubebuilder:default=litellm
+	DatabaseName string `json:"databaseName,omitempty"`
+	// Username is the owner of the database
+	// +kubebuilder:default=litellm
+	Username string `json:"username,omitempty"`
+	// StorageSize is the size of the persistent volume holding the database
+	// +kubebuilder:default="10Gi"
+	StorageSize resource.Quantity `json:"storageSize,omitempty"`
+	// StorageClassName is the storage class of the persistent volume
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Resources sets compute resources for the PostgreSQL container
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Instances is the number of PostgreSQL instances when using CloudNativePG
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Instances int32 `json:"instances,omitempty"`
+}
+
+// Autoscaling defines the HorizontalPodAutoscaler managed for the LiteLLM Deployment.
+// While autoscaling is enabled the replicas field is ignored and the HPA owns the replica count.
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must be less than or equal to maxReplicas"
+type Autoscaling struct {
+	// Enabled indicates whether the HorizontalPodAutoscaler should be created
+	Enabled bool `json:"enabled"`
+	// MinReplicas is the lower limit for the number of replicas
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit for the number of replicas
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// TargetCPUUtilizationPercentage is the target average CPU utilisation across pods
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	// TargetMemoryUtilizationPercentage is the target average memory utilisation across pods
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+	// Metrics are additional metrics, e.g. custom or external metrics, used to compute the replica count
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+	// Behavior configures the scale up and scale down behaviour of the HPA
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// PodDisruptionBudget defines the PodDisruptionBudget managed for the LiteLLM pods.
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))",message="only one of minAvailable or maxUnavailable may be set"
+type PodDisruptionBudget struct {
+	// Enabled indicates whether the PodDisruptionBudget should be created
+	Enabled bool `json:"enabled"`
+	// MinAvailable is the number or percentage of pods that must remain available during a disruption
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of pods that can be unavailable during a disruption
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// PodTemplate defines overrides for the pod template of the LiteLLM Deployment.
+// Fields left empty keep the operator defaults.
+type PodTemplate struct {
+	// Annotations are added to the pod template metadata
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Labels are added to the pod template metadata; the operator selector labels always take precedence
+	Labels map[string]string `json:"labels,omitempty"`
+	// Resources sets compute resources for the LiteLLM container
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// NodeSelector constrains the pods to nodes with matching labels
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations allow the pods to schedule onto nodes with matching taints
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity sets the pod scheduling constraints
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// TopologySpreadConstraints describes how the pods spread across topology domains
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// PriorityClassName sets the priority class of the pods
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// SecurityContext sets the pod level security attributes
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+	// ContainerSecurityContext sets the security attributes of the LiteLLM container
+	ContainerSecurityContext *corev1.SecurityContext `json:"containerSecurityContext,omitempty"`
+	// ImagePullSecrets lists secrets used to pull the LiteLLM and sidecar images
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// Sidecars are additional containers run alongside the LiteLLM container
+	Sidecars []corev1.Container `json:"sidecars,omitempty"`
+	// Volumes are additional volumes made available to the pod, e.g. for sidecars
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
 }
 
 // model instance used to create proxy server config map
@@ -67,19 +577,141 @@ type DatabaseSecretRef struct {
 	Keys    DatabaseSecretKeys `json:"keys"`
 }
 
+// RedisSecretRef references the Secret holding the Redis connection details used by the
+// LiteLLM router to share rate limit counters and cooldowns across replicas.
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'sentinel' || has(self.sentinel)",message="sentinel must be set when mode is sentinel"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'cluster' || (has(self.clusterNodes) && size(self.clusterNodes) > 0)",message="clusterNodes must be set when mode is cluster"
 type RedisSecretRef struct {
 	NameRef string          `json:"nameRef"`
 	Keys    RedisSecretKeys `json:"keys"`
+	// TLS enables TLS for connections to Redis
+	TLS bool `json:"tls,omitempty"`
+	// Mode selects how LiteLLM connects to Redis
+	// +kubebuilder:default=standalone
+	// +kubebuilder:validation:Enum=standalone;sentinel;cluster
+	Mode string `json:"mode,omitempty"`
+	// Sentinel configures Redis Sentinel discovery when mode is sentinel
+	Sentinel *RedisSentinel `json:"sentinel,omitempty"`
+	// ClusterNodes lists the Redis Cluster startup nodes as host:port when mode is cluster
+	ClusterNodes []string `json:"clusterNodes,omitempty"`
+}
+
+// RedisSentinel defines the Redis Sentinel nodes and monitored master.
+type RedisSentinel struct {
+	// Nodes lists the sentinel nodes as host:port
+	// +kubebuilder:validation:MinItems=1
+	Nodes []string `json:"nodes"`
+	// ServiceName is the name of the master monitored by the sentinels
+	ServiceName string `json:"serviceName"`
+	// PasswordSecret is the key in the Redis secret holding the sentinel password
+	PasswordSecret string `json:"passwordSecret,omitempty"`
 }
 
 type Ingress struct {
 	Enabled bool   `json:"enabled"`
 	Host    string `json:"host"`
+	// ClassName is the name of the IngressClass that should serve the Ingress
+	ClassName string `json:"className,omitempty"`
+	// Annotations are added to the Ingress metadata
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Paths routed to the LiteLLM service; defaults to a single "/" prefix path
+	Paths []IngressPath `json:"paths,omitempty"`
+	// TLS enables TLS termination for the Ingress host
+	TLS *IngressTLS `json:"tls,omitempty"`
 }
 
+// IngressPath defines an HTTP path routed to the LiteLLM service.
+type IngressPath struct {
+	// Path is matched against the path of an incoming request
+	// +kubebuilder:default="/"
+	Path string `json:"path"`
+	// PathType determines how the path is matched
+	// +kubebuilder:default=Prefix
+	// +kubebuilder:validation:Enum=Exact;Prefix;ImplementationSpecific
+	PathType networkingv1.PathType `json:"pathType,omitempty"`
+}
+
+// IngressTLS defines TLS termination for the Ingress.
+type IngressTLS struct {
+	// SecretName is the Secret holding the TLS certificate; defaults to <instance>-ingress-tls
+	SecretName string `json:"secretName,omitempty"`
+	// CertManagerIssuer requests the certificate from cert-manager using the given issuer
+	CertManagerIssuer *CertManagerIssuerRef `json:"certManagerIssuer,omitempty"`
+}
+
+// CertManagerIssuerRef references a cert-manager Issuer or ClusterIssuer.
+type CertManagerIssuerRef struct {
+	// Name of the issuer
+	Name string `json:"name"`
+	// Kind of the issuer
+	// +kubebuilder:default=ClusterIssuer
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	Kind string `json:"kind,omitempty"`
+}
+
+// Gateway configures a Gateway API HTTPRoute that exposes the LiteLLM service through a parent Gateway.
 type Gateway struct {
 	Enabled bool   `json:"enabled"`
 	Host    string `json:"host"`
+	// ParentRef references the Gateway the HTTPRoute attaches to
+	ParentRef GatewayParentRef `json:"parentRef,omitempty"`
+	// Hostnames are additional hostnames matched by the HTTPRoute
+	Hostnames []string `json:"hostnames,omitempty"`
+	// Paths matched by the HTTPRoute; defaults to a single "/" prefix match
+	Paths []GatewayPathMatch `json:"paths,omitempty"`
+	// Timeouts for requests matched by the HTTPRoute
+	Timeouts *HTTPRouteTimeouts `json:"timeouts,omitempty"`
+	// RequestHeaders modifies the headers of requests before they are forwarded
+	RequestHeaders *HTTPHeaderModifier `json:"requestHeaders,omitempty"`
+	// ResponseHeaders modifies the headers of responses before they are returned
+	ResponseHeaders *HTTPHeaderModifier `json:"responseHeaders,omitempty"`
+}
+
+// GatewayParentRef references a Gateway API Gateway.
+type GatewayParentRef struct {
+	// Name of the Gateway
+	Name string `json:"name"`
+	// Namespace of the Gateway; defaults to the instance namespace
+	Namespace string `json:"namespace,omitempty"`
+	// SectionName selects a listener of the Gateway
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// GatewayPathMatch defines an HTTPRoute path match.
+type GatewayPathMatch struct {
+	// Type of the path match
+	// +kubebuilder:default=PathPrefix
+	// +kubebuilder:validation:Enum=Exact;PathPrefix;RegularExpression
+	Type string `json:"type,omitempty"`
+	// Value of the path to match
+	// +kubebuilder:default="/"
+	Value string `json:"value"`
+}
+
+// HTTPRouteTimeouts defines HTTPRoute rule timeouts as Gateway API durations, e.g. "30s" or "5m".
+type HTTPRouteTimeouts struct {
+	// Request is the timeout for the whole client request
+	// +kubebuilder:validation:Pattern=`^([0-9]{1,5}(h|m|s|ms)){1,4}$`
+	Request string `json:"request,omitempty"`
+	// BackendRequest is the timeout for a single request to the LiteLLM service
+	// +kubebuilder:validation:Pattern=`^([0-9]{1,5}(h|m|s|ms)){1,4}$`
+	BackendRequest string `json:"backendRequest,omitempty"`
+}
+
+// HTTPHeaderModifier defines headers to set, add or remove.
+type HTTPHeaderModifier struct {
+	// Set overwrites the given headers
+	Set []HTTPHeader `json:"set,omitempty"`
+	// Add appends the given headers
+	Add []HTTPHeader `json:"add,omitempty"`
+	// Remove deletes the named headers
+	Remove []string `json:"remove,omitempty"`
+}
+
+// HTTPHeader is a header name and value.
+type HTTPHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
 }
 type DatabaseSecretKeys struct {
 	HostSecret     string `json:"hostSecret"`
@@ -92,6 +724,8 @@ type RedisSecretKeys struct {
 	HostSecret     string `json:"hostSecret"`
 	PortSecret     string `json:"portSecret"`
 	PasswordSecret string `json:"passwordSecret"`
+	// UsernameSecret is the key holding the Redis ACL username
+	UsernameSecret string `json:"usernameSecret,omitempty"`
 }
 
 // LiteLLMInstanceStatus defines the observed state of LiteLLMInstance.
@@ -108,6 +742,26 @@ type LiteLLMInstanceStatus struct {
 	DeploymentCreated bool `json:"deploymentCreated,omitempty"`
 	ServiceCreated    bool `json:"serviceCreated,omitempty"`
 	IngressCreated    bool `json:"ingressCreated,omitempty"`
+	HPACreated        bool `json:"hpaCreated,omitempty"`
+	PDBCreated        bool `json:"pdbCreated,omitempty"`
+
+	// IngressAddress is the load balancer address reported on the Ingress
+	IngressAddress string `json:"ingressAddress,omitempty"`
+	// HTTPRouteCreated indicates whether the Gateway API HTTPRoute was created
+	HTTPRouteCreated bool `json:"httpRouteCreated,omitempty"`
+	// DatabaseCreated indicates whether the managed database was created
+	DatabaseCreated bool `json:"databaseCreated,omitempty"`
+	// DatabaseProvider is the provider used for the managed database
+	DatabaseProvider string `json:"databaseProvider,omitempty"`
+
+	// CurrentImage is the image the LiteLLM Deployment is running
+	CurrentImage string `json:"currentImage,omitempty"`
+	// PreviousImage is the image the LiteLLM Deployment ran before the last upgrade, used to roll back
+	PreviousImage string `json:"previousImage,omitempty"`
+	// MigratedImage is the last image whose database migrations were applied
+	MigratedImage string `json:"migratedImage,omitempty"`
+	// Upgrade reports the progress of the last Canary or BlueGreen upgrade
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
 
 	// Conditions represent the latest available observations of a LiteLLM instance's state
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -120,6 +774,7 @@ type LiteLLMInstanceStatus struct {
 // +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".spec.image",description="The LiteLLM image being used"
 // +kubebuilder:printcolumn:name="Database",type="string",JSONPath=".spec.databaseSecretRef.nameRef",description="Database secret reference"
 // +kubebuilder:printcolumn:name="Ingress",type="boolean",JSONPath=".spec.ingress.enabled",description="Whether ingress is enabled"
+// +kubebuilder:printcolumn:name="Address",type="string",JSONPath=".status.ingressAddress",description="Ingress load balancer address",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since creation"
 
 // LiteLLMInstance is the Schema for the litellminstances API.
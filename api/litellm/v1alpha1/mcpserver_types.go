@@ -0,0 +1,189 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MCPServerSpec defines the desired state of MCPServer.
+// +kubebuilder:validation:XValidation:rule="(has(self.transport) && self.transport == 'stdio') ? has(self.command) : (has(self.url) != has(self.serviceRef))",message="stdio servers require command; sse and http servers require exactly one of url or serviceRef"
+type MCPServerSpec struct {
+	// ConnectionRef is the connection reference
+	ConnectionRef ConnectionRef `json:"connectionRef,omitempty"`
+
+	// ServerName is the name of the MCP server in LiteLLM, defaults to the name of the resource.
+	// It prefixes the names of the server's tools
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// Description is a human readable description of the server
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Transport is how LiteLLM talks to the server
+	// +kubebuilder:validation:Enum=sse;http;stdio
+	// +kubebuilder:default=http
+	// +optional
+	Transport string `json:"transport,omitempty"`
+
+	// URL is the address of an sse or http server
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// ServiceRef points at an in-cluster Service serving an sse or http server, as an alternative to url
+	// +optional
+	ServiceRef *MCPServiceRef `json:"serviceRef,omitempty"`
+
+	// Command is the command LiteLLM runs for a stdio server
+	// +optional
+	Command string `json:"command,omitempty"`
+
+	// Args are the arguments passed to command
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Env is the environment passed to command
+	// +optional
+	Env map[string]string `json:"env,omitempty"`
+
+	// AuthType is how LiteLLM authenticates to the server
+	// +kubebuilder:validation:Enum=none;api_key;bearer_token;basic;authorization
+	// +optional
+	AuthType string `json:"authType,omitempty"`
+
+	// AuthSecretRef references the Secret key holding the auth header value sent to the server
+	// +optional
+	AuthSecretRef *MCPAuthSecretRef `json:"authSecretRef,omitempty"`
+
+	// AllowedTools limits the tools exposed through LiteLLM, all tools are exposed when empty
+	// +optional
+	AllowedTools []string `json:"allowedTools,omitempty"`
+
+	// AllowedTeams are the Team resources granted access to the server
+	// +optional
+	AllowedTeams []ResourceRef `json:"allowedTeams,omitempty"`
+
+	// AllowedKeys are the VirtualKey resources granted access to the server
+	// +optional
+	AllowedKeys []ResourceRef `json:"allowedKeys,omitempty"`
+}
+
+// MCPServiceRef references a Service serving an MCP server
+type MCPServiceRef struct {
+	// Name is the name of the Service
+	Name string `json:"name"`
+	// Namespace is the namespace of the Service, defaults to the MCPServer's namespace
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Port is the Service port
+	// +kubebuilder:default=80
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// Path is the path of the MCP endpoint, e.g. /mcp or /sse
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// MCPAuthSecretRef references a key of a Secret
+type MCPAuthSecretRef struct {
+	// SecretName is the name of the Secret
+	SecretName string `json:"secretName"`
+	// Namespace is the namespace of the Secret, defaults to the MCPServer's namespace
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Key is the key of the Secret holding the auth value
+	// +kubebuilder:default=token
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// ResourceRef references a resource by name, the namespace defaults to the referencing resource's namespace
+type ResourceRef struct {
+	Name string `json:"name"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// MCPTool describes a tool discovered on an MCP server
+type MCPTool struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// MCPServerStatus defines the observed state of MCPServer.
+type MCPServerStatus struct {
+	// ObservedGeneration represents the .metadata.generation that the condition was set based upon
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastUpdated represents the last time the status was updated
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+	// Conditions represent the latest available observations of the MCP server's state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ServerID is the ID of the MCP server in the litellm server
+	ServerID string `json:"serverId,omitempty"`
+	// ServerName is the name of the MCP server in the litellm server
+	ServerName string `json:"serverName,omitempty"`
+	// URL is the address LiteLLM uses to reach the server
+	URL string `json:"url,omitempty"`
+
+	// SecretChecksum is a hash of the auth value last pushed to the litellm server
+	SecretChecksum string `json:"secretChecksum,omitempty"`
+
+	// Tools are the tools LiteLLM discovered on the server
+	Tools []MCPTool `json:"tools,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Server Name",type="string",JSONPath=".status.serverName",description="Name of the MCP server in LiteLLM"
+// +kubebuilder:printcolumn:name="Transport",type="string",JSONPath=".spec.transport",description="MCP transport"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",description="Address of the MCP server"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Ready status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age of the MCP server"
+
+// MCPServer is the Schema for the mcpservers API.
+type MCPServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPServerSpec   `json:"spec,omitempty"`
+	Status MCPServerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MCPServerList contains a list of MCPServer.
+type MCPServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MCPServer `json:"items"`
+}
+
+// GetConditions returns the conditions slice
+func (m *MCPServer) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+// SetConditions sets the conditions slice
+func (m *MCPServer) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&MCPServer{}, &MCPServerList{})
+}
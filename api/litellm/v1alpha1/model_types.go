@@ -207,6 +207,9 @@ type ModelStatus struct {
 
 	// ModelId contains the model uuid provided by litellm server
 	ModelId *string `json:"modelId,omitempty"`
+
+	// SecretChecksum is a hash of the model secret last pushed to the litellm server
+	SecretChecksum string `json:"secretChecksum,omitempty"`
 }
 
 // +kubebuilder:object:root=true
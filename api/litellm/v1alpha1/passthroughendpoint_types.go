@@ -0,0 +1,114 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PassThroughEndpointSpec defines the desired state of PassThroughEndpoint.
+type PassThroughEndpointSpec struct {
+	// ConnectionRef is the connection reference
+	ConnectionRef ConnectionRef `json:"connectionRef,omitempty"`
+
+	// Path is the route exposed on the proxy, e.g. /rerank
+	// +kubebuilder:validation:Pattern=`^/.+`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="path is immutable"
+	Path string `json:"path"`
+
+	// Target is the URL requests are forwarded to
+	// +kubebuilder:validation:MinLength=1
+	Target string `json:"target"`
+
+	// Headers are non-secret headers added to forwarded requests
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// HeadersSecretRef references a Secret whose keys are added as headers to forwarded requests, e.g. Authorization
+	// +optional
+	HeadersSecretRef *SecretRef `json:"headersSecretRef,omitempty"`
+
+	// IncludeSubpath forwards requests to sub-paths of path as well
+	// +optional
+	IncludeSubpath bool `json:"includeSubpath,omitempty"`
+
+	// Auth requires callers to present a LiteLLM virtual key
+	// +optional
+	Auth bool `json:"auth,omitempty"`
+
+	// ForwardHeaders forwards the caller's request headers to the target
+	// +optional
+	ForwardHeaders bool `json:"forwardHeaders,omitempty"`
+}
+
+// PassThroughEndpointStatus defines the observed state of PassThroughEndpoint.
+type PassThroughEndpointStatus struct {
+	// ObservedGeneration represents the .metadata.generation that the condition was set based upon
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastUpdated represents the last time the status was updated
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+	// Conditions represent the latest available observations of the endpoint's state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// EndpointID is the ID of the pass-through endpoint in the litellm server
+	EndpointID string `json:"endpointId,omitempty"`
+	// Path is the public path served by the proxy, ending in /* when sub-paths are included
+	Path string `json:"path,omitempty"`
+
+	// SecretChecksum is a hash of the headers secret last pushed to the litellm server
+	SecretChecksum string `json:"secretChecksum,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=pte
+// +kubebuilder:printcolumn:name="Path",type="string",JSONPath=".status.path",description="Public path on the proxy"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.target",description="Target URL"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Ready status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age of the endpoint"
+
+// PassThroughEndpoint is the Schema for the passthroughendpoints API.
+type PassThroughEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PassThroughEndpointSpec   `json:"spec,omitempty"`
+	Status PassThroughEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PassThroughEndpointList contains a list of PassThroughEndpoint.
+type PassThroughEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PassThroughEndpoint `json:"items"`
+}
+
+// GetConditions returns the conditions slice
+func (p *PassThroughEndpoint) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+// SetConditions sets the conditions slice
+func (p *PassThroughEndpoint) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&PassThroughEndpoint{}, &PassThroughEndpointList{})
+}
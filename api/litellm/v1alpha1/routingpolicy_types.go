@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RoutingPolicySpec defines the desired state of RoutingPolicy.
+// +kubebuilder:validation:XValidation:rule="has(self.instanceRef.name) && size(self.instanceRef.name) > 0",message="instanceRef.name is required"
+type RoutingPolicySpec struct {
+	// InstanceRef is the LiteLLMInstance whose router settings the policy is rendered into. The instance must be
+	// in the namespace of the policy, a policy referencing an instance in another namespace is not applied
+	InstanceRef InstanceRef `json:"instanceRef"`
+
+	// Fallbacks lists the models tried when a model fails
+	// +optional
+	Fallbacks []RoutingFallback `json:"fallbacks,omitempty"`
+
+	// ContextWindowFallbacks lists the models tried when a request exceeds the context window of a model
+	// +optional
+	ContextWindowFallbacks []RoutingFallback `json:"contextWindowFallbacks,omitempty"`
+
+	// ContentPolicyFallbacks lists the models tried when a model rejects a request on its content policy
+	// +optional
+	ContentPolicyFallbacks []RoutingFallback `json:"contentPolicyFallbacks,omitempty"`
+
+	// DefaultFallbackRefs are the names of the Model resources tried when a model without fallbacks fails
+	// +optional
+	DefaultFallbackRefs []string `json:"defaultFallbackRefs,omitempty"`
+
+	// NumRetries is the number of retries of a failed request across deployments
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	NumRetries *int `json:"numRetries,omitempty"`
+
+	// RetryPolicy sets the number of retries per type of error, overriding numRetries
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// AllowedFails is the number of failures within a minute before a deployment is cooled down
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	AllowedFails *int `json:"allowedFails,omitempty"`
+
+	// CooldownTime is the number of seconds a failing deployment is cooled down for
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	CooldownTime *int `json:"cooldownTime,omitempty"`
+}
+
+// RoutingFallback defines the ordered fallback models of a model by the names of their Model resources.
+type RoutingFallback struct {
+	// ModelRef is the name of the Model resource the fallbacks apply to
+	// +kubebuilder:validation:MinLength=1
+	ModelRef string `json:"modelRef"`
+	// FallbackRefs are the names of the Model resources tried in order
+	// +kubebuilder:validation:MinItems=1
+	FallbackRefs []string `json:"fallbackRefs"`
+}
+
+// RetryPolicy defines the number of retries per type of error.
+type RetryPolicy struct {
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BadRequestErrorRetries *int `json:"badRequestErrorRetries,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	AuthenticationErrorRetries *int `json:"authenticationErrorRetries,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TimeoutErrorRetries *int `json:"timeoutErrorRetries,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RateLimitErrorRetries *int `json:"rateLimitErrorRetries,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ContentPolicyViolationErrorRetries *int `json:"contentPolicyViolationErrorRetries,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InternalServerErrorRetries *int `json:"internalServerErrorRetries,omitempty"`
+}
+
+// RoutingPolicyStatus defines the observed state of RoutingPolicy.
+type RoutingPolicyStatus struct {
+	// ObservedGeneration represents the .metadata.generation that the condition was set based upon
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastUpdated represents the last time the status was updated
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+	// Conditions represent the latest available observations of the routing policy's state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ModelNames maps the referenced Model resources to their model names in the router settings
+	ModelNames map[string]string `json:"modelNames,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=rp
+// +kubebuilder:printcolumn:name="Instance",type="string",JSONPath=".spec.instanceRef.name",description="LiteLLMInstance the policy applies to"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Ready status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age of the routing policy"
+
+// RoutingPolicy is the Schema for the routingpolicies API.
+type RoutingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RoutingPolicySpec   `json:"spec,omitempty"`
+	Status RoutingPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RoutingPolicyList contains a list of RoutingPolicy.
+type RoutingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RoutingPolicy `json:"items"`
+}
+
+// GetConditions returns the conditions slice
+func (r *RoutingPolicy) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the conditions slice
+func (r *RoutingPolicy) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
+// ReferencedModels returns the names of the Model resources referenced by the policy, without duplicates.
+func (r *RoutingPolicy) ReferencedModels() []string {
+	seen := map[string]bool{}
+	var names []string
+	add := func(refs ...string) {
+		for _, ref := range refs {
+			if !seen[ref] {
+				seen[ref] = true
+				names = append(names, ref)
+			}
+		}
+	}
+	for _, fallbacks := range [][]RoutingFallback{r.Spec.Fallbacks, r.Spec.ContextWindowFallbacks, r.Spec.ContentPolicyFallbacks} {
+		for _, fallback := range fallbacks {
+			add(fallback.ModelRef)
+			add(fallback.FallbackRefs...)
+		}
+	}
+	add(r.Spec.DefaultFallbackRefs...)
+	return names
+}
+
+func init() {
+	SchemeBuilder.Register(&RoutingPolicy{}, &RoutingPolicyList{})
+}
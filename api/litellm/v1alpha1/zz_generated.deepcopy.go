@@ -21,124 +21,184 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionRef) DeepCopyInto(out *ConnectionRef) {
+func (in *Autoscaling) DeepCopyInto(out *Autoscaling) {
 	*out = *in
-	out.SecretRef = in.SecretRef
-	out.InstanceRef = in.InstanceRef
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionRef.
-func (in *ConnectionRef) DeepCopy() *ConnectionRef {
-	if in == nil {
-		return nil
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetMemoryUtilizationPercentage != nil {
+		in, out := &in.TargetMemoryUtilizationPercentage, &out.TargetMemoryUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ConnectionRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseSecretKeys) DeepCopyInto(out *DatabaseSecretKeys) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSecretKeys.
-func (in *DatabaseSecretKeys) DeepCopy() *DatabaseSecretKeys {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Autoscaling.
+func (in *Autoscaling) DeepCopy() *Autoscaling {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseSecretKeys)
+	out := new(Autoscaling)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseSecretRef) DeepCopyInto(out *DatabaseSecretRef) {
+func (in *BlueGreenStrategy) DeepCopyInto(out *BlueGreenStrategy) {
 	*out = *in
-	out.Keys = in.Keys
+	if in.AutoPromote != nil {
+		in, out := &in.AutoPromote, &out.AutoPromote
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PromotionDelaySeconds != nil {
+		in, out := &in.PromotionDelaySeconds, &out.PromotionDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSecretRef.
-func (in *DatabaseSecretRef) DeepCopy() *DatabaseSecretRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenStrategy.
+func (in *BlueGreenStrategy) DeepCopy() *BlueGreenStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseSecretRef)
+	out := new(BlueGreenStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Gateway) DeepCopyInto(out *Gateway) {
+func (in *Cache) DeepCopyInto(out *Cache) {
 	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int)
+		**out = **in
+	}
+	if in.SupportedCallTypes != nil {
+		in, out := &in.SupportedCallTypes, &out.SupportedCallTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EmbeddingModelRef != nil {
+		in, out := &in.EmbeddingModelRef, &out.EmbeddingModelRef
+		*out = new(ResourceRef)
+		**out = **in
+	}
+	if in.Qdrant != nil {
+		in, out := &in.Qdrant, &out.Qdrant
+		*out = new(QdrantCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3Cache)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gateway.
-func (in *Gateway) DeepCopy() *Gateway {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cache.
+func (in *Cache) DeepCopy() *Cache {
 	if in == nil {
 		return nil
 	}
-	out := new(Gateway)
+	out := new(Cache)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Ingress) DeepCopyInto(out *Ingress) {
+func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
 	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AnalysisSeconds != nil {
+		in, out := &in.AnalysisSeconds, &out.AnalysisSeconds
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ingress.
-func (in *Ingress) DeepCopy() *Ingress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStrategy.
+func (in *CanaryStrategy) DeepCopy() *CanaryStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(Ingress)
+	out := new(CanaryStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InitModelInstance) DeepCopyInto(out *InitModelInstance) {
+func (in *CertManagerIssuerRef) DeepCopyInto(out *CertManagerIssuerRef) {
 	*out = *in
-	out.ModelCredentials = in.ModelCredentials
-	in.LiteLLMParams.DeepCopyInto(&out.LiteLLMParams)
-	in.AdditionalProperties.DeepCopyInto(&out.AdditionalProperties)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitModelInstance.
-func (in *InitModelInstance) DeepCopy() *InitModelInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerRef.
+func (in *CertManagerIssuerRef) DeepCopy() *CertManagerIssuerRef {
 	if in == nil {
 		return nil
 	}
-	out := new(InitModelInstance)
+	out := new(CertManagerIssuerRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceRef) DeepCopyInto(out *InstanceRef) {
+func (in *ConnectionRef) DeepCopyInto(out *ConnectionRef) {
 	*out = *in
+	out.SecretRef = in.SecretRef
+	out.InstanceRef = in.InstanceRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRef.
-func (in *InstanceRef) DeepCopy() *InstanceRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionRef.
+func (in *ConnectionRef) DeepCopy() *ConnectionRef {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceRef)
+	out := new(ConnectionRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LiteLLMInstance) DeepCopyInto(out *LiteLLMInstance) {
+func (in *Credential) DeepCopyInto(out *Credential) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -146,18 +206,18 @@ func (in *LiteLLMInstance) DeepCopyInto(out *LiteLLMInstance) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteLLMInstance.
-func (in *LiteLLMInstance) DeepCopy() *LiteLLMInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Credential.
+func (in *Credential) DeepCopy() *Credential {
 	if in == nil {
 		return nil
 	}
-	out := new(LiteLLMInstance)
+	out := new(Credential)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LiteLLMInstance) DeepCopyObject() runtime.Object {
+func (in *Credential) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -165,31 +225,31 @@ func (in *LiteLLMInstance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LiteLLMInstanceList) DeepCopyInto(out *LiteLLMInstanceList) {
+func (in *CredentialList) DeepCopyInto(out *CredentialList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]LiteLLMInstance, len(*in))
+		*out = make([]Credential, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteLLMInstanceList.
-func (in *LiteLLMInstanceList) DeepCopy() *LiteLLMInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialList.
+func (in *CredentialList) DeepCopy() *CredentialList {
 	if in == nil {
 		return nil
 	}
-	out := new(LiteLLMInstanceList)
+	out := new(CredentialList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LiteLLMInstanceList) DeepCopyObject() runtime.Object {
+func (in *CredentialList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -197,40 +257,31 @@ func (in *LiteLLMInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LiteLLMInstanceSpec) DeepCopyInto(out *LiteLLMInstanceSpec) {
+func (in *CredentialSpec) DeepCopyInto(out *CredentialSpec) {
 	*out = *in
-	out.DatabaseSecretRef = in.DatabaseSecretRef
-	out.RedisSecretRef = in.RedisSecretRef
-	out.Ingress = in.Ingress
-	out.Gateway = in.Gateway
-	if in.Models != nil {
-		in, out := &in.Models, &out.Models
-		*out = make([]InitModelInstance, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ExtraEnvVars != nil {
-		in, out := &in.ExtraEnvVars, &out.ExtraEnvVars
-		*out = make([]v1.EnvVar, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	out.ConnectionRef = in.ConnectionRef
+	out.CredentialSecretRef = in.CredentialSecretRef
+	if in.CredentialInfo != nil {
+		in, out := &in.CredentialInfo, &out.CredentialInfo
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteLLMInstanceSpec.
-func (in *LiteLLMInstanceSpec) DeepCopy() *LiteLLMInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialSpec.
+func (in *CredentialSpec) DeepCopy() *CredentialSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LiteLLMInstanceSpec)
+	out := new(CredentialSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LiteLLMInstanceStatus) DeepCopyInto(out *LiteLLMInstanceStatus) {
+func (in *CredentialStatus) DeepCopyInto(out *CredentialStatus) {
 	*out = *in
 	if in.LastUpdated != nil {
 		in, out := &in.LastUpdated, &out.LastUpdated
@@ -238,373 +289,2085 @@ func (in *LiteLLMInstanceStatus) DeepCopyInto(out *LiteLLMInstanceStatus) {
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteLLMInstanceStatus.
-func (in *LiteLLMInstanceStatus) DeepCopy() *LiteLLMInstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialStatus.
+func (in *CredentialStatus) DeepCopy() *CredentialStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LiteLLMInstanceStatus)
+	out := new(CredentialStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LiteLLMParams) DeepCopyInto(out *LiteLLMParams) {
+func (in *Database) DeepCopyInto(out *Database) {
 	*out = *in
-	if in.InputCostPerToken != nil {
-		in, out := &in.InputCostPerToken, &out.InputCostPerToken
-		*out = new(string)
-		**out = **in
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(ManagedDatabase)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OutputCostPerToken != nil {
-		in, out := &in.OutputCostPerToken, &out.OutputCostPerToken
-		*out = new(string)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Database.
+func (in *Database) DeepCopy() *Database {
+	if in == nil {
+		return nil
+	}
+	out := new(Database)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSecretKeys) DeepCopyInto(out *DatabaseSecretKeys) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSecretKeys.
+func (in *DatabaseSecretKeys) DeepCopy() *DatabaseSecretKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSecretKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSecretRef) DeepCopyInto(out *DatabaseSecretRef) {
+	*out = *in
+	out.Keys = in.Keys
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSecretRef.
+func (in *DatabaseSecretRef) DeepCopy() *DatabaseSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogIntegration) DeepCopyInto(out *DatadogIntegration) {
+	*out = *in
+	in.APIKeySecretRef.DeepCopyInto(&out.APIKeySecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogIntegration.
+func (in *DatadogIntegration) DeepCopy() *DatadogIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCSIntegration) DeepCopyInto(out *GCSIntegration) {
+	*out = *in
+	if in.ServiceAccountSecretRef != nil {
+		in, out := &in.ServiceAccountSecretRef, &out.ServiceAccountSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCSIntegration.
+func (in *GCSIntegration) DeepCopy() *GCSIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(GCSIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Gateway) DeepCopyInto(out *Gateway) {
+	*out = *in
+	out.ParentRef = in.ParentRef
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]GatewayPathMatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(HTTPRouteTimeouts)
 		**out = **in
 	}
-	if in.InputCostPerSecond != nil {
-		in, out := &in.InputCostPerSecond, &out.InputCostPerSecond
-		*out = new(string)
+	if in.RequestHeaders != nil {
+		in, out := &in.RequestHeaders, &out.RequestHeaders
+		*out = new(HTTPHeaderModifier)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResponseHeaders != nil {
+		in, out := &in.ResponseHeaders, &out.ResponseHeaders
+		*out = new(HTTPHeaderModifier)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gateway.
+func (in *Gateway) DeepCopy() *Gateway {
+	if in == nil {
+		return nil
+	}
+	out := new(Gateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayParentRef) DeepCopyInto(out *GatewayParentRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayParentRef.
+func (in *GatewayParentRef) DeepCopy() *GatewayParentRef {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayParentRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayPathMatch) DeepCopyInto(out *GatewayPathMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayPathMatch.
+func (in *GatewayPathMatch) DeepCopy() *GatewayPathMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayPathMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneralSettings) DeepCopyInto(out *GeneralSettings) {
+	*out = *in
+	if in.AllowRequestsOnDBUnavailable != nil {
+		in, out := &in.AllowRequestsOnDBUnavailable, &out.AllowRequestsOnDBUnavailable
+		*out = new(bool)
 		**out = **in
 	}
-	if in.OutputCostPerSecond != nil {
-		in, out := &in.OutputCostPerSecond, &out.OutputCostPerSecond
-		*out = new(string)
+	if in.StoreModelInDB != nil {
+		in, out := &in.StoreModelInDB, &out.StoreModelInDB
+		*out = new(bool)
 		**out = **in
 	}
-	if in.InputCostPerPixel != nil {
-		in, out := &in.InputCostPerPixel, &out.InputCostPerPixel
-		*out = new(string)
+	if in.MaxParallelRequests != nil {
+		in, out := &in.MaxParallelRequests, &out.MaxParallelRequests
+		*out = new(int)
 		**out = **in
 	}
-	if in.OutputCostPerPixel != nil {
-		in, out := &in.OutputCostPerPixel, &out.OutputCostPerPixel
-		*out = new(string)
+	if in.GlobalMaxParallelRequests != nil {
+		in, out := &in.GlobalMaxParallelRequests, &out.GlobalMaxParallelRequests
+		*out = new(int)
 		**out = **in
 	}
-	if in.ApiKey != nil {
-		in, out := &in.ApiKey, &out.ApiKey
-		*out = new(string)
+	if in.Alerting != nil {
+		in, out := &in.Alerting, &out.Alerting
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlertingThreshold != nil {
+		in, out := &in.AlertingThreshold, &out.AlertingThreshold
+		*out = new(int)
 		**out = **in
 	}
-	if in.ApiBase != nil {
-		in, out := &in.ApiBase, &out.ApiBase
-		*out = new(string)
+	if in.AlertTypes != nil {
+		in, out := &in.AlertTypes, &out.AlertTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackgroundHealthChecks != nil {
+		in, out := &in.BackgroundHealthChecks, &out.BackgroundHealthChecks
+		*out = new(bool)
 		**out = **in
 	}
-	if in.ApiVersion != nil {
-		in, out := &in.ApiVersion, &out.ApiVersion
-		*out = new(string)
-		**out = **in
-	}
-	if in.VertexProject != nil {
-		in, out := &in.VertexProject, &out.VertexProject
-		*out = new(string)
-		**out = **in
-	}
-	if in.VertexLocation != nil {
-		in, out := &in.VertexLocation, &out.VertexLocation
-		*out = new(string)
-		**out = **in
-	}
-	if in.VertexCredentials != nil {
-		in, out := &in.VertexCredentials, &out.VertexCredentials
-		*out = new(string)
-		**out = **in
-	}
-	if in.RegionName != nil {
-		in, out := &in.RegionName, &out.RegionName
-		*out = new(string)
-		**out = **in
-	}
-	if in.AwsAccessKeyID != nil {
-		in, out := &in.AwsAccessKeyID, &out.AwsAccessKeyID
-		*out = new(string)
-		**out = **in
-	}
-	if in.AwsSecretAccessKey != nil {
-		in, out := &in.AwsSecretAccessKey, &out.AwsSecretAccessKey
-		*out = new(string)
-		**out = **in
-	}
-	if in.AwsRegionName != nil {
-		in, out := &in.AwsRegionName, &out.AwsRegionName
-		*out = new(string)
-		**out = **in
-	}
-	if in.WatsonXRegionName != nil {
-		in, out := &in.WatsonXRegionName, &out.WatsonXRegionName
-		*out = new(string)
+	if in.HealthCheckInterval != nil {
+		in, out := &in.HealthCheckInterval, &out.HealthCheckInterval
+		*out = new(int)
 		**out = **in
 	}
-	if in.CustomLLMProvider != nil {
-		in, out := &in.CustomLLMProvider, &out.CustomLLMProvider
-		*out = new(string)
+	if in.DisableSpendLogs != nil {
+		in, out := &in.DisableSpendLogs, &out.DisableSpendLogs
+		*out = new(bool)
 		**out = **in
 	}
-	if in.TPM != nil {
-		in, out := &in.TPM, &out.TPM
+	if in.MaxRequestSizeMB != nil {
+		in, out := &in.MaxRequestSizeMB, &out.MaxRequestSizeMB
 		*out = new(int)
 		**out = **in
 	}
-	if in.RPM != nil {
-		in, out := &in.RPM, &out.RPM
+	if in.MaxResponseSizeMB != nil {
+		in, out := &in.MaxResponseSizeMB, &out.MaxResponseSizeMB
 		*out = new(int)
 		**out = **in
 	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
+	if in.DatabaseConnectionPoolLimit != nil {
+		in, out := &in.DatabaseConnectionPoolLimit, &out.DatabaseConnectionPoolLimit
 		*out = new(int)
 		**out = **in
 	}
-	if in.StreamTimeout != nil {
-		in, out := &in.StreamTimeout, &out.StreamTimeout
+	if in.ProxyBatchWriteAt != nil {
+		in, out := &in.ProxyBatchWriteAt, &out.ProxyBatchWriteAt
 		*out = new(int)
 		**out = **in
 	}
-	if in.MaxRetries != nil {
-		in, out := &in.MaxRetries, &out.MaxRetries
-		*out = new(int)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneralSettings.
+func (in *GeneralSettings) DeepCopy() *GeneralSettings {
+	if in == nil {
+		return nil
 	}
-	if in.Organization != nil {
-		in, out := &in.Organization, &out.Organization
-		*out = new(string)
-		**out = **in
+	out := new(GeneralSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Guardrail) DeepCopyInto(out *Guardrail) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Guardrail.
+func (in *Guardrail) DeepCopy() *Guardrail {
+	if in == nil {
+		return nil
 	}
-	if in.ConfigurableClientsideAuthParams != nil {
-		in, out := &in.ConfigurableClientsideAuthParams, &out.ConfigurableClientsideAuthParams
-		*out = new([]runtime.RawExtension)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make([]runtime.RawExtension, len(*in))
-			for i := range *in {
-				(*in)[i].DeepCopyInto(&(*out)[i])
-			}
+	out := new(Guardrail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Guardrail) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardrailList) DeepCopyInto(out *GuardrailList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Guardrail, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.LiteLLMCredentialName != nil {
-		in, out := &in.LiteLLMCredentialName, &out.LiteLLMCredentialName
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardrailList.
+func (in *GuardrailList) DeepCopy() *GuardrailList {
+	if in == nil {
+		return nil
 	}
-	if in.LiteLLMTraceID != nil {
-		in, out := &in.LiteLLMTraceID, &out.LiteLLMTraceID
-		*out = new(string)
-		**out = **in
+	out := new(GuardrailList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GuardrailList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MaxFileSizeMB != nil {
-		in, out := &in.MaxFileSizeMB, &out.MaxFileSizeMB
-		*out = new(int)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardrailSpec) DeepCopyInto(out *GuardrailSpec) {
+	*out = *in
+	out.ConnectionRef = in.ConnectionRef
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.MaxBudget != nil {
-		in, out := &in.MaxBudget, &out.MaxBudget
-		*out = new(string)
+	if in.ParamsSecretRef != nil {
+		in, out := &in.ParamsSecretRef, &out.ParamsSecretRef
+		*out = new(SecretRef)
 		**out = **in
 	}
-	if in.BudgetDuration != nil {
-		in, out := &in.BudgetDuration, &out.BudgetDuration
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardrailSpec.
+func (in *GuardrailSpec) DeepCopy() *GuardrailSpec {
+	if in == nil {
+		return nil
 	}
-	if in.UseInPassThrough != nil {
-		in, out := &in.UseInPassThrough, &out.UseInPassThrough
-		*out = new(bool)
-		**out = **in
+	out := new(GuardrailSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardrailStatus) DeepCopyInto(out *GuardrailStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
 	}
-	if in.UseLiteLLMProxy != nil {
-		in, out := &in.UseLiteLLMProxy, &out.UseLiteLLMProxy
-		*out = new(bool)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.MergeReasoningContentInChoices != nil {
-		in, out := &in.MergeReasoningContentInChoices, &out.MergeReasoningContentInChoices
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardrailStatus.
+func (in *GuardrailStatus) DeepCopy() *GuardrailStatus {
+	if in == nil {
+		return nil
 	}
-	if in.ModelInfo != nil {
-		in, out := &in.ModelInfo, &out.ModelInfo
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	out := new(GuardrailStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeader) DeepCopyInto(out *HTTPHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeader.
+func (in *HTTPHeader) DeepCopy() *HTTPHeader {
+	if in == nil {
+		return nil
 	}
-	if in.MockResponse != nil {
-		in, out := &in.MockResponse, &out.MockResponse
-		*out = new(string)
-		**out = **in
+	out := new(HTTPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderModifier) DeepCopyInto(out *HTTPHeaderModifier) {
+	*out = *in
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = make([]HTTPHeader, len(*in))
+		copy(*out, *in)
 	}
-	if in.AutoRouterConfigPath != nil {
-		in, out := &in.AutoRouterConfigPath, &out.AutoRouterConfigPath
-		*out = new(string)
-		**out = **in
+	if in.Add != nil {
+		in, out := &in.Add, &out.Add
+		*out = make([]HTTPHeader, len(*in))
+		copy(*out, *in)
 	}
-	if in.AutoRouterConfig != nil {
-		in, out := &in.AutoRouterConfig, &out.AutoRouterConfig
-		*out = new(string)
-		**out = **in
+	if in.Remove != nil {
+		in, out := &in.Remove, &out.Remove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.AutoRouterDefaultModel != nil {
-		in, out := &in.AutoRouterDefaultModel, &out.AutoRouterDefaultModel
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderModifier.
+func (in *HTTPHeaderModifier) DeepCopy() *HTTPHeaderModifier {
+	if in == nil {
+		return nil
 	}
-	if in.AutoRouterEmbeddingModel != nil {
-		in, out := &in.AutoRouterEmbeddingModel, &out.AutoRouterEmbeddingModel
-		*out = new(string)
-		**out = **in
+	out := new(HTTPHeaderModifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteTimeouts) DeepCopyInto(out *HTTPRouteTimeouts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteTimeouts.
+func (in *HTTPRouteTimeouts) DeepCopy() *HTTPRouteTimeouts {
+	if in == nil {
+		return nil
 	}
-	if in.Model != nil {
-		in, out := &in.Model, &out.Model
-		*out = new(string)
-		**out = **in
+	out := new(HTTPRouteTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ingress) DeepCopyInto(out *Ingress) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.AdditionalProps != nil {
-		in, out := &in.AdditionalProps, &out.AdditionalProps
-		*out = new(runtime.RawExtension)
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]IngressPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(IngressTLS)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteLLMParams.
-func (in *LiteLLMParams) DeepCopy() *LiteLLMParams {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ingress.
+func (in *Ingress) DeepCopy() *Ingress {
+	if in == nil {
+		return nil
+	}
+	out := new(Ingress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressPath) DeepCopyInto(out *IngressPath) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressPath.
+func (in *IngressPath) DeepCopy() *IngressPath {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressTLS) DeepCopyInto(out *IngressTLS) {
+	*out = *in
+	if in.CertManagerIssuer != nil {
+		in, out := &in.CertManagerIssuer, &out.CertManagerIssuer
+		*out = new(CertManagerIssuerRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressTLS.
+func (in *IngressTLS) DeepCopy() *IngressTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InitModelInstance) DeepCopyInto(out *InitModelInstance) {
+	*out = *in
+	out.ModelCredentials = in.ModelCredentials
+	in.LiteLLMParams.DeepCopyInto(&out.LiteLLMParams)
+	in.AdditionalProperties.DeepCopyInto(&out.AdditionalProperties)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitModelInstance.
+func (in *InitModelInstance) DeepCopy() *InitModelInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(InitModelInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRef) DeepCopyInto(out *InstanceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRef.
+func (in *InstanceRef) DeepCopy() *InstanceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LangfuseIntegration) DeepCopyInto(out *LangfuseIntegration) {
+	*out = *in
+	in.PublicKeySecretRef.DeepCopyInto(&out.PublicKeySecretRef)
+	in.SecretKeySecretRef.DeepCopyInto(&out.SecretKeySecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LangfuseIntegration.
+func (in *LangfuseIntegration) DeepCopy() *LangfuseIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(LangfuseIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteLLMInstance) DeepCopyInto(out *LiteLLMInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteLLMInstance.
+func (in *LiteLLMInstance) DeepCopy() *LiteLLMInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteLLMInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LiteLLMInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteLLMInstanceList) DeepCopyInto(out *LiteLLMInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LiteLLMInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteLLMInstanceList.
+func (in *LiteLLMInstanceList) DeepCopy() *LiteLLMInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteLLMInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LiteLLMInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteLLMInstanceSpec) DeepCopyInto(out *LiteLLMInstanceSpec) {
+	*out = *in
+	out.DatabaseSecretRef = in.DatabaseSecretRef
+	in.RedisSecretRef.DeepCopyInto(&out.RedisSecretRef)
+	in.Ingress.DeepCopyInto(&out.Ingress)
+	in.Gateway.DeepCopyInto(&out.Gateway)
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]InitModelInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraEnvVars != nil {
+		in, out := &in.ExtraEnvVars, &out.ExtraEnvVars
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(PodTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(Autoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(Database)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migrations != nil {
+		in, out := &in.Migrations, &out.Migrations
+		*out = new(Migrations)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(UpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GeneralSettings != nil {
+		in, out := &in.GeneralSettings, &out.GeneralSettings
+		*out = new(GeneralSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LitellmSettings != nil {
+		in, out := &in.LitellmSettings, &out.LitellmSettings
+		*out = new(LitellmSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouterSettings != nil {
+		in, out := &in.RouterSettings, &out.RouterSettings
+		*out = new(RouterSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make([]LoggingIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(Cache)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteLLMInstanceSpec.
+func (in *LiteLLMInstanceSpec) DeepCopy() *LiteLLMInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteLLMInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteLLMInstanceStatus) DeepCopyInto(out *LiteLLMInstanceStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(UpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteLLMInstanceStatus.
+func (in *LiteLLMInstanceStatus) DeepCopy() *LiteLLMInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteLLMInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteLLMParams) DeepCopyInto(out *LiteLLMParams) {
+	*out = *in
+	if in.InputCostPerToken != nil {
+		in, out := &in.InputCostPerToken, &out.InputCostPerToken
+		*out = new(string)
+		**out = **in
+	}
+	if in.OutputCostPerToken != nil {
+		in, out := &in.OutputCostPerToken, &out.OutputCostPerToken
+		*out = new(string)
+		**out = **in
+	}
+	if in.InputCostPerSecond != nil {
+		in, out := &in.InputCostPerSecond, &out.InputCostPerSecond
+		*out = new(string)
+		**out = **in
+	}
+	if in.OutputCostPerSecond != nil {
+		in, out := &in.OutputCostPerSecond, &out.OutputCostPerSecond
+		*out = new(string)
+		**out = **in
+	}
+	if in.InputCostPerPixel != nil {
+		in, out := &in.InputCostPerPixel, &out.InputCostPerPixel
+		*out = new(string)
+		**out = **in
+	}
+	if in.OutputCostPerPixel != nil {
+		in, out := &in.OutputCostPerPixel, &out.OutputCostPerPixel
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApiKey != nil {
+		in, out := &in.ApiKey, &out.ApiKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApiBase != nil {
+		in, out := &in.ApiBase, &out.ApiBase
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApiVersion != nil {
+		in, out := &in.ApiVersion, &out.ApiVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.VertexProject != nil {
+		in, out := &in.VertexProject, &out.VertexProject
+		*out = new(string)
+		**out = **in
+	}
+	if in.VertexLocation != nil {
+		in, out := &in.VertexLocation, &out.VertexLocation
+		*out = new(string)
+		**out = **in
+	}
+	if in.VertexCredentials != nil {
+		in, out := &in.VertexCredentials, &out.VertexCredentials
+		*out = new(string)
+		**out = **in
+	}
+	if in.RegionName != nil {
+		in, out := &in.RegionName, &out.RegionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsAccessKeyID != nil {
+		in, out := &in.AwsAccessKeyID, &out.AwsAccessKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsSecretAccessKey != nil {
+		in, out := &in.AwsSecretAccessKey, &out.AwsSecretAccessKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.AwsRegionName != nil {
+		in, out := &in.AwsRegionName, &out.AwsRegionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.WatsonXRegionName != nil {
+		in, out := &in.WatsonXRegionName, &out.WatsonXRegionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomLLMProvider != nil {
+		in, out := &in.CustomLLMProvider, &out.CustomLLMProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.TPM != nil {
+		in, out := &in.TPM, &out.TPM
+		*out = new(int)
+		**out = **in
+	}
+	if in.RPM != nil {
+		in, out := &in.RPM, &out.RPM
+		*out = new(int)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.StreamTimeout != nil {
+		in, out := &in.StreamTimeout, &out.StreamTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.Organization != nil {
+		in, out := &in.Organization, &out.Organization
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigurableClientsideAuthParams != nil {
+		in, out := &in.ConfigurableClientsideAuthParams, &out.ConfigurableClientsideAuthParams
+		*out = new([]runtime.RawExtension)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]runtime.RawExtension, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+	if in.LiteLLMCredentialName != nil {
+		in, out := &in.LiteLLMCredentialName, &out.LiteLLMCredentialName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LiteLLMTraceID != nil {
+		in, out := &in.LiteLLMTraceID, &out.LiteLLMTraceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxFileSizeMB != nil {
+		in, out := &in.MaxFileSizeMB, &out.MaxFileSizeMB
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxBudget != nil {
+		in, out := &in.MaxBudget, &out.MaxBudget
+		*out = new(string)
+		**out = **in
+	}
+	if in.BudgetDuration != nil {
+		in, out := &in.BudgetDuration, &out.BudgetDuration
+		*out = new(string)
+		**out = **in
+	}
+	if in.UseInPassThrough != nil {
+		in, out := &in.UseInPassThrough, &out.UseInPassThrough
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UseLiteLLMProxy != nil {
+		in, out := &in.UseLiteLLMProxy, &out.UseLiteLLMProxy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeReasoningContentInChoices != nil {
+		in, out := &in.MergeReasoningContentInChoices, &out.MergeReasoningContentInChoices
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ModelInfo != nil {
+		in, out := &in.ModelInfo, &out.ModelInfo
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MockResponse != nil {
+		in, out := &in.MockResponse, &out.MockResponse
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoRouterConfigPath != nil {
+		in, out := &in.AutoRouterConfigPath, &out.AutoRouterConfigPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoRouterConfig != nil {
+		in, out := &in.AutoRouterConfig, &out.AutoRouterConfig
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoRouterDefaultModel != nil {
+		in, out := &in.AutoRouterDefaultModel, &out.AutoRouterDefaultModel
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoRouterEmbeddingModel != nil {
+		in, out := &in.AutoRouterEmbeddingModel, &out.AutoRouterEmbeddingModel
+		*out = new(string)
+		**out = **in
+	}
+	if in.Model != nil {
+		in, out := &in.Model, &out.Model
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdditionalProps != nil {
+		in, out := &in.AdditionalProps, &out.AdditionalProps
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteLLMParams.
+func (in *LiteLLMParams) DeepCopy() *LiteLLMParams {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteLLMParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LitellmSettings) DeepCopyInto(out *LitellmSettings) {
+	*out = *in
+	if in.Callbacks != nil {
+		in, out := &in.Callbacks, &out.Callbacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SuccessCallback != nil {
+		in, out := &in.SuccessCallback, &out.SuccessCallback
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailureCallback != nil {
+		in, out := &in.FailureCallback, &out.FailureCallback
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceCallback != nil {
+		in, out := &in.ServiceCallback, &out.ServiceCallback
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DropParams != nil {
+		in, out := &in.DropParams, &out.DropParams
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequestTimeout != nil {
+		in, out := &in.RequestTimeout, &out.RequestTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.NumRetries != nil {
+		in, out := &in.NumRetries, &out.NumRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.SetVerbose != nil {
+		in, out := &in.SetVerbose, &out.SetVerbose
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JSONLogs != nil {
+		in, out := &in.JSONLogs, &out.JSONLogs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CacheParams != nil {
+		in, out := &in.CacheParams, &out.CacheParams
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LitellmSettings.
+func (in *LitellmSettings) DeepCopy() *LitellmSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(LitellmSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingIntegration) DeepCopyInto(out *LoggingIntegration) {
+	*out = *in
+	if in.Langfuse != nil {
+		in, out := &in.Langfuse, &out.Langfuse
+		*out = new(LangfuseIntegration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenTelemetry != nil {
+		in, out := &in.OpenTelemetry, &out.OpenTelemetry
+		*out = new(OpenTelemetryIntegration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3Integration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCS != nil {
+		in, out := &in.GCS, &out.GCS
+		*out = new(GCSIntegration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Datadog != nil {
+		in, out := &in.Datadog, &out.Datadog
+		*out = new(DatadogIntegration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookIntegration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingIntegration.
+func (in *LoggingIntegration) DeepCopy() *LoggingIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPAuthSecretRef) DeepCopyInto(out *MCPAuthSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuthSecretRef.
+func (in *MCPAuthSecretRef) DeepCopy() *MCPAuthSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPAuthSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServer) DeepCopyInto(out *MCPServer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
+func (in *MCPServer) DeepCopy() *MCPServer {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerList) DeepCopyInto(out *MCPServerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerList.
+func (in *MCPServerList) DeepCopy() *MCPServerList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerSpec) DeepCopyInto(out *MCPServerSpec) {
+	*out = *in
+	out.ConnectionRef = in.ConnectionRef
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(MCPServiceRef)
+		**out = **in
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(MCPAuthSecretRef)
+		**out = **in
+	}
+	if in.AllowedTools != nil {
+		in, out := &in.AllowedTools, &out.AllowedTools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedTeams != nil {
+		in, out := &in.AllowedTeams, &out.AllowedTeams
+		*out = make([]ResourceRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedKeys != nil {
+		in, out := &in.AllowedKeys, &out.AllowedKeys
+		*out = make([]ResourceRef, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerSpec.
+func (in *MCPServerSpec) DeepCopy() *MCPServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerStatus) DeepCopyInto(out *MCPServerStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]MCPTool, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerStatus.
+func (in *MCPServerStatus) DeepCopy() *MCPServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServiceRef) DeepCopyInto(out *MCPServiceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServiceRef.
+func (in *MCPServiceRef) DeepCopy() *MCPServiceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServiceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPTool) DeepCopyInto(out *MCPTool) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPTool.
+func (in *MCPTool) DeepCopy() *MCPTool {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPTool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedDatabase) DeepCopyInto(out *ManagedDatabase) {
+	*out = *in
+	out.StorageSize = in.StorageSize.DeepCopy()
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedDatabase.
+func (in *ManagedDatabase) DeepCopy() *ManagedDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Migrations) DeepCopyInto(out *Migrations) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Migrations.
+func (in *Migrations) DeepCopy() *Migrations {
+	if in == nil {
+		return nil
+	}
+	out := new(Migrations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Model) DeepCopyInto(out *Model) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Model.
+func (in *Model) DeepCopy() *Model {
+	if in == nil {
+		return nil
+	}
+	out := new(Model)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Model) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelCredentialSecretKeys) DeepCopyInto(out *ModelCredentialSecretKeys) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelCredentialSecretKeys.
+func (in *ModelCredentialSecretKeys) DeepCopy() *ModelCredentialSecretKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelCredentialSecretKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelCredentialSecretRef) DeepCopyInto(out *ModelCredentialSecretRef) {
+	*out = *in
+	out.Keys = in.Keys
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelCredentialSecretRef.
+func (in *ModelCredentialSecretRef) DeepCopy() *ModelCredentialSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelCredentialSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelFallback) DeepCopyInto(out *ModelFallback) {
+	*out = *in
+	if in.Fallbacks != nil {
+		in, out := &in.Fallbacks, &out.Fallbacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelFallback.
+func (in *ModelFallback) DeepCopy() *ModelFallback {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelFallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelInfo) DeepCopyInto(out *ModelInfo) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBModel != nil {
+		in, out := &in.DBModel, &out.DBModel
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TeamID != nil {
+		in, out := &in.TeamID, &out.TeamID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TeamPublicModelName != nil {
+		in, out := &in.TeamPublicModelName, &out.TeamPublicModelName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdditionalProps != nil {
+		in, out := &in.AdditionalProps, &out.AdditionalProps
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelInfo.
+func (in *ModelInfo) DeepCopy() *ModelInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelList) DeepCopyInto(out *ModelList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Model, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelList.
+func (in *ModelList) DeepCopy() *ModelList {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
+	*out = *in
+	out.ConnectionRef = in.ConnectionRef
+	in.LiteLLMParams.DeepCopyInto(&out.LiteLLMParams)
+	in.ModelInfo.DeepCopyInto(&out.ModelInfo)
+	out.ModelSecretRef = in.ModelSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
+func (in *ModelSpec) DeepCopy() *ModelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ModelName != nil {
+		in, out := &in.ModelName, &out.ModelName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LiteLLMParams != nil {
+		in, out := &in.LiteLLMParams, &out.LiteLLMParams
+		*out = new(LiteLLMParams)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ModelId != nil {
+		in, out := &in.ModelId, &out.ModelId
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStatus.
+func (in *ModelStatus) DeepCopy() *ModelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NilKeys) DeepCopyInto(out *NilKeys) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NilKeys.
+func (in *NilKeys) DeepCopy() *NilKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(NilKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenTelemetryIntegration) DeepCopyInto(out *OpenTelemetryIntegration) {
+	*out = *in
+	if in.HeadersSecretRef != nil {
+		in, out := &in.HeadersSecretRef, &out.HeadersSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenTelemetryIntegration.
+func (in *OpenTelemetryIntegration) DeepCopy() *OpenTelemetryIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenTelemetryIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PassThroughEndpoint) DeepCopyInto(out *PassThroughEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PassThroughEndpoint.
+func (in *PassThroughEndpoint) DeepCopy() *PassThroughEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(PassThroughEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PassThroughEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PassThroughEndpointList) DeepCopyInto(out *PassThroughEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PassThroughEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PassThroughEndpointList.
+func (in *PassThroughEndpointList) DeepCopy() *PassThroughEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(PassThroughEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PassThroughEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PassThroughEndpointSpec) DeepCopyInto(out *PassThroughEndpointSpec) {
+	*out = *in
+	out.ConnectionRef = in.ConnectionRef
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HeadersSecretRef != nil {
+		in, out := &in.HeadersSecretRef, &out.HeadersSecretRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PassThroughEndpointSpec.
+func (in *PassThroughEndpointSpec) DeepCopy() *PassThroughEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PassThroughEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PassThroughEndpointStatus) DeepCopyInto(out *PassThroughEndpointStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PassThroughEndpointStatus.
+func (in *PassThroughEndpointStatus) DeepCopy() *PassThroughEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PassThroughEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudget) DeepCopyInto(out *PodDisruptionBudget) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudget.
+func (in *PodDisruptionBudget) DeepCopy() *PodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodTemplate) DeepCopyInto(out *PodTemplate) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(corev1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerSecurityContext != nil {
+		in, out := &in.ContainerSecurityContext, &out.ContainerSecurityContext
+		*out = new(corev1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTemplate.
+func (in *PodTemplate) DeepCopy() *PodTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(PodTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QdrantCache) DeepCopyInto(out *QdrantCache) {
+	*out = *in
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QdrantCache.
+func (in *QdrantCache) DeepCopy() *QdrantCache {
+	if in == nil {
+		return nil
+	}
+	out := new(QdrantCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisSecretKeys) DeepCopyInto(out *RedisSecretKeys) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSecretKeys.
+func (in *RedisSecretKeys) DeepCopy() *RedisSecretKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisSecretKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisSecretRef) DeepCopyInto(out *RedisSecretRef) {
+	*out = *in
+	out.Keys = in.Keys
+	if in.Sentinel != nil {
+		in, out := &in.Sentinel, &out.Sentinel
+		*out = new(RedisSentinel)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterNodes != nil {
+		in, out := &in.ClusterNodes, &out.ClusterNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSecretRef.
+func (in *RedisSecretRef) DeepCopy() *RedisSecretRef {
 	if in == nil {
 		return nil
 	}
-	out := new(LiteLLMParams)
+	out := new(RedisSecretRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Model) DeepCopyInto(out *Model) {
+func (in *RedisSentinel) DeepCopyInto(out *RedisSentinel) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Model.
-func (in *Model) DeepCopy() *Model {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSentinel.
+func (in *RedisSentinel) DeepCopy() *RedisSentinel {
 	if in == nil {
 		return nil
 	}
-	out := new(Model)
+	out := new(RedisSentinel)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Model) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelCredentialSecretKeys) DeepCopyInto(out *ModelCredentialSecretKeys) {
+func (in *ResourceRef) DeepCopyInto(out *ResourceRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelCredentialSecretKeys.
-func (in *ModelCredentialSecretKeys) DeepCopy() *ModelCredentialSecretKeys {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRef.
+func (in *ResourceRef) DeepCopy() *ResourceRef {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelCredentialSecretKeys)
+	out := new(ResourceRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelCredentialSecretRef) DeepCopyInto(out *ModelCredentialSecretRef) {
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
 	*out = *in
-	out.Keys = in.Keys
+	if in.BadRequestErrorRetries != nil {
+		in, out := &in.BadRequestErrorRetries, &out.BadRequestErrorRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.AuthenticationErrorRetries != nil {
+		in, out := &in.AuthenticationErrorRetries, &out.AuthenticationErrorRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.TimeoutErrorRetries != nil {
+		in, out := &in.TimeoutErrorRetries, &out.TimeoutErrorRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.RateLimitErrorRetries != nil {
+		in, out := &in.RateLimitErrorRetries, &out.RateLimitErrorRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.ContentPolicyViolationErrorRetries != nil {
+		in, out := &in.ContentPolicyViolationErrorRetries, &out.ContentPolicyViolationErrorRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.InternalServerErrorRetries != nil {
+		in, out := &in.InternalServerErrorRetries, &out.InternalServerErrorRetries
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelCredentialSecretRef.
-func (in *ModelCredentialSecretRef) DeepCopy() *ModelCredentialSecretRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelCredentialSecretRef)
+	out := new(RetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelInfo) DeepCopyInto(out *ModelInfo) {
+func (in *RouterSettings) DeepCopyInto(out *RouterSettings) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
+	if in.NumRetries != nil {
+		in, out := &in.NumRetries, &out.NumRetries
+		*out = new(int)
 		**out = **in
 	}
-	if in.DBModel != nil {
-		in, out := &in.DBModel, &out.DBModel
-		*out = new(bool)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int)
 		**out = **in
 	}
-	if in.TeamID != nil {
-		in, out := &in.TeamID, &out.TeamID
-		*out = new(string)
+	if in.AllowedFails != nil {
+		in, out := &in.AllowedFails, &out.AllowedFails
+		*out = new(int)
 		**out = **in
 	}
-	if in.TeamPublicModelName != nil {
-		in, out := &in.TeamPublicModelName, &out.TeamPublicModelName
-		*out = new(string)
+	if in.CooldownTime != nil {
+		in, out := &in.CooldownTime, &out.CooldownTime
+		*out = new(int)
 		**out = **in
 	}
-	if in.AdditionalProps != nil {
-		in, out := &in.AdditionalProps, &out.AdditionalProps
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	if in.RetryAfter != nil {
+		in, out := &in.RetryAfter, &out.RetryAfter
+		*out = new(int)
+		**out = **in
+	}
+	if in.Fallbacks != nil {
+		in, out := &in.Fallbacks, &out.Fallbacks
+		*out = make([]ModelFallback, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ContextWindowFallbacks != nil {
+		in, out := &in.ContextWindowFallbacks, &out.ContextWindowFallbacks
+		*out = make([]ModelFallback, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ContentPolicyFallbacks != nil {
+		in, out := &in.ContentPolicyFallbacks, &out.ContentPolicyFallbacks
+		*out = make([]ModelFallback, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultFallbacks != nil {
+		in, out := &in.DefaultFallbacks, &out.DefaultFallbacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnablePreCallChecks != nil {
+		in, out := &in.EnablePreCallChecks, &out.EnablePreCallChecks
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ModelGroupAlias != nil {
+		in, out := &in.ModelGroupAlias, &out.ModelGroupAlias
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelInfo.
-func (in *ModelInfo) DeepCopy() *ModelInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterSettings.
+func (in *RouterSettings) DeepCopy() *RouterSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelInfo)
+	out := new(RouterSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelList) DeepCopyInto(out *ModelList) {
+func (in *RoutingFallback) DeepCopyInto(out *RoutingFallback) {
+	*out = *in
+	if in.FallbackRefs != nil {
+		in, out := &in.FallbackRefs, &out.FallbackRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingFallback.
+func (in *RoutingFallback) DeepCopy() *RoutingFallback {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingFallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingPolicy) DeepCopyInto(out *RoutingPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingPolicy.
+func (in *RoutingPolicy) DeepCopy() *RoutingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RoutingPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingPolicyList) DeepCopyInto(out *RoutingPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Model, len(*in))
+		*out = make([]RoutingPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelList.
-func (in *ModelList) DeepCopy() *ModelList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingPolicyList.
+func (in *RoutingPolicyList) DeepCopy() *RoutingPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelList)
+	out := new(RoutingPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ModelList) DeepCopyObject() runtime.Object {
+func (in *RoutingPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -612,26 +2375,69 @@ func (in *ModelList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
+func (in *RoutingPolicySpec) DeepCopyInto(out *RoutingPolicySpec) {
 	*out = *in
-	out.ConnectionRef = in.ConnectionRef
-	in.LiteLLMParams.DeepCopyInto(&out.LiteLLMParams)
-	in.ModelInfo.DeepCopyInto(&out.ModelInfo)
-	out.ModelSecretRef = in.ModelSecretRef
+	out.InstanceRef = in.InstanceRef
+	if in.Fallbacks != nil {
+		in, out := &in.Fallbacks, &out.Fallbacks
+		*out = make([]RoutingFallback, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ContextWindowFallbacks != nil {
+		in, out := &in.ContextWindowFallbacks, &out.ContextWindowFallbacks
+		*out = make([]RoutingFallback, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ContentPolicyFallbacks != nil {
+		in, out := &in.ContentPolicyFallbacks, &out.ContentPolicyFallbacks
+		*out = make([]RoutingFallback, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultFallbackRefs != nil {
+		in, out := &in.DefaultFallbackRefs, &out.DefaultFallbackRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NumRetries != nil {
+		in, out := &in.NumRetries, &out.NumRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedFails != nil {
+		in, out := &in.AllowedFails, &out.AllowedFails
+		*out = new(int)
+		**out = **in
+	}
+	if in.CooldownTime != nil {
+		in, out := &in.CooldownTime, &out.CooldownTime
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
-func (in *ModelSpec) DeepCopy() *ModelSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingPolicySpec.
+func (in *RoutingPolicySpec) DeepCopy() *RoutingPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelSpec)
+	out := new(RoutingPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
+func (in *RoutingPolicyStatus) DeepCopyInto(out *RoutingPolicyStatus) {
 	*out = *in
 	if in.LastUpdated != nil {
 		in, out := &in.LastUpdated, &out.LastUpdated
@@ -639,95 +2445,189 @@ func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.ModelName != nil {
-		in, out := &in.ModelName, &out.ModelName
-		*out = new(string)
-		**out = **in
+	if in.ModelNames != nil {
+		in, out := &in.ModelNames, &out.ModelNames
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.LiteLLMParams != nil {
-		in, out := &in.LiteLLMParams, &out.LiteLLMParams
-		*out = new(LiteLLMParams)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingPolicyStatus.
+func (in *RoutingPolicyStatus) DeepCopy() *RoutingPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3Cache) DeepCopyInto(out *S3Cache) {
+	*out = *in
+	if in.AccessKeyIDSecretRef != nil {
+		in, out := &in.AccessKeyIDSecretRef, &out.AccessKeyIDSecretRef
+		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ModelId != nil {
-		in, out := &in.ModelId, &out.ModelId
-		*out = new(string)
-		**out = **in
+	if in.SecretAccessKeySecretRef != nil {
+		in, out := &in.SecretAccessKeySecretRef, &out.SecretAccessKeySecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStatus.
-func (in *ModelStatus) DeepCopy() *ModelStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Cache.
+func (in *S3Cache) DeepCopy() *S3Cache {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelStatus)
+	out := new(S3Cache)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NilKeys) DeepCopyInto(out *NilKeys) {
+func (in *S3Integration) DeepCopyInto(out *S3Integration) {
 	*out = *in
+	if in.AccessKeyIDSecretRef != nil {
+		in, out := &in.AccessKeyIDSecretRef, &out.AccessKeyIDSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretAccessKeySecretRef != nil {
+		in, out := &in.SecretAccessKeySecretRef, &out.SecretAccessKeySecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NilKeys.
-func (in *NilKeys) DeepCopy() *NilKeys {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Integration.
+func (in *S3Integration) DeepCopy() *S3Integration {
 	if in == nil {
 		return nil
 	}
-	out := new(NilKeys)
+	out := new(S3Integration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedisSecretKeys) DeepCopyInto(out *RedisSecretKeys) {
+func (in *SecretRef) DeepCopyInto(out *SecretRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSecretKeys.
-func (in *RedisSecretKeys) DeepCopy() *RedisSecretKeys {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRef.
+func (in *SecretRef) DeepCopy() *SecretRef {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisSecretKeys)
+	out := new(SecretRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedisSecretRef) DeepCopyInto(out *RedisSecretRef) {
+func (in *UpgradeHealthCheck) DeepCopyInto(out *UpgradeHealthCheck) {
 	*out = *in
-	out.Keys = in.Keys
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSecretRef.
-func (in *RedisSecretRef) DeepCopy() *RedisSecretRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeHealthCheck.
+func (in *UpgradeHealthCheck) DeepCopy() *UpgradeHealthCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisSecretRef)
+	out := new(UpgradeHealthCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretRef) DeepCopyInto(out *SecretRef) {
+func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
 	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.HealthySince != nil {
+		in, out := &in.HealthySince, &out.HealthySince
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRef.
-func (in *SecretRef) DeepCopy() *SecretRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStatus.
+func (in *UpgradeStatus) DeepCopy() *UpgradeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretRef)
+	out := new(UpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStrategy) DeepCopyInto(out *UpgradeStrategy) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(UpgradeHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProgressDeadlineSeconds != nil {
+		in, out := &in.ProgressDeadlineSeconds, &out.ProgressDeadlineSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStrategy.
+func (in *UpgradeStrategy) DeepCopy() *UpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookIntegration) DeepCopyInto(out *WebhookIntegration) {
+	*out = *in
+	if in.HeadersSecretRef != nil {
+		in, out := &in.HeadersSecretRef, &out.HeadersSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookIntegration.
+func (in *WebhookIntegration) DeepCopy() *WebhookIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookIntegration)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -38,8 +38,15 @@ import (
 	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
 	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
 	"github.com/bbdsoftware/litellm-operator/internal/controller/association"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/budget"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/credential"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/guardrail"
 	"github.com/bbdsoftware/litellm-operator/internal/controller/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/mcpserver"
 	"github.com/bbdsoftware/litellm-operator/internal/controller/model"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/organization"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/passthroughendpoint"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/routingpolicy"
 	"github.com/bbdsoftware/litellm-operator/internal/controller/team"
 	"github.com/bbdsoftware/litellm-operator/internal/controller/user"
 	"github.com/bbdsoftware/litellm-operator/internal/controller/virtualkey"
@@ -174,6 +181,11 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "TeamMemberAssociation")
 		os.Exit(1)
 	}
+	organizationReconciler := organization.NewOrganizationReconciler(mgr.GetClient(), mgr.GetScheme())
+	if err = organizationReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Organization")
+		os.Exit(1)
+	}
 	litellmInstanceReconciler := litellm.NewLiteLLMInstanceReconciler(mgr.GetClient(), mgr.GetScheme())
 	if err = litellmInstanceReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "LiteLLMInstance")
@@ -184,6 +196,36 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "Model")
 		os.Exit(1)
 	}
+	credentialReconciler := credential.NewCredentialReconciler(mgr.GetClient(), mgr.GetScheme())
+	if err := credentialReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Credential")
+		os.Exit(1)
+	}
+	budgetReconciler := budget.NewBudgetReconciler(mgr.GetClient(), mgr.GetScheme())
+	if err := budgetReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Budget")
+		os.Exit(1)
+	}
+	guardrailReconciler := guardrail.NewGuardrailReconciler(mgr.GetClient(), mgr.GetScheme())
+	if err := guardrailReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Guardrail")
+		os.Exit(1)
+	}
+	mcpserverReconciler := mcpserver.NewMCPServerReconciler(mgr.GetClient(), mgr.GetScheme())
+	if err := mcpserverReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "MCPServer")
+		os.Exit(1)
+	}
+	passthroughendpointReconciler := passthroughendpoint.NewPassThroughEndpointReconciler(mgr.GetClient(), mgr.GetScheme())
+	if err := passthroughendpointReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PassThroughEndpoint")
+		os.Exit(1)
+	}
+	routingpolicyReconciler := routingpolicy.NewRoutingPolicyReconciler(mgr.GetClient(), mgr.GetScheme())
+	if err := routingpolicyReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RoutingPolicy")
+		os.Exit(1)
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -22,9 +22,11 @@ import (
 	"strconv"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -66,6 +68,7 @@ type ExternalData struct {
 // +kubebuilder:rbac:groups=auth.litellm.ai,resources=teammemberassociations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=auth.litellm.ai,resources=teammemberassociations/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=auth.litellm.ai,resources=teammemberassociations/finalizers,verbs=update
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=budgets,verbs=get;list;watch
 
 // Reconcile implements the single-loop ensure* pattern with finalizer, conditions, and drift sync
 func (r *TeamMemberAssociationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -257,19 +260,43 @@ func (r *TeamMemberAssociationReconciler) ensureExternal(ctx context.Context, te
 	}
 	userEmail := user.Spec.UserEmail
 
-	if r.isUserCorrectlyInTeam(teamResponse, teamMemberAssociation, userEmail) {
-		log.V(1).Info("User is already correctly associated with team", "userEmail", userEmail, "teamAlias", teamAlias)
-		// Update status with current state
+	associationRequest, err := r.convertToTeamMemberAssociationRequest(teamMemberAssociation, userEmail, teamAlias)
+	if err != nil {
+		log.Error(err, "Failed to create team member association request")
+		return r.HandleErrorRetryable(ctx, teamMemberAssociation, err, base.ReasonInvalidSpec)
+	}
+
+	// Use the referenced Budget as the user's budget in the team
+	if teamMemberAssociation.Spec.BudgetRef != nil {
+		budget, err := common.ResolveBudgetRef(ctx, r.Client, teamMemberAssociation.Spec.BudgetRef, teamMemberAssociation.Namespace)
+		if err != nil {
+			log.Error(err, "Failed to resolve budgetRef", "budgetRef", teamMemberAssociation.Spec.BudgetRef.Name)
+			return r.HandleErrorRetryable(ctx, teamMemberAssociation, err, base.ReasonDependencyNotReady)
+		}
+		if err := budget.RejectLimits(common.BudgetLimitModelMaxBudget); err != nil {
+			log.Error(err, "Referenced Budget sets limits a team member cannot apply", "budgetRef", teamMemberAssociation.Spec.BudgetRef.Name)
+			return r.HandleErrorRetryable(ctx, teamMemberAssociation, err, base.ReasonConfigError)
+		}
+		associationRequest.MaxBudgetInTeam = budget.MaxBudget
+	}
+
+	if member := findTeamMember(teamResponse, userEmail); member != nil {
 		externalData.TeamAlias = teamAlias
 		externalData.TeamID = teamID
 		externalData.UserEmail = userEmail
-		// Find user ID from team members
-		for _, member := range teamResponse.MembersWithRole {
-			if member.UserEmail == userEmail {
-				externalData.UserID = member.UserID
-				break
+		externalData.UserID = member.UserID
+
+		if r.isUserCorrectlyInTeam(teamResponse, member, &associationRequest) {
+			log.V(1).Info("User is already correctly associated with team", "userEmail", userEmail, "teamAlias", teamAlias)
+		} else {
+			// The user is in the team with another role or budget
+			log.Info("Updating team member association in LiteLLM", "userEmail", userEmail, "teamAlias", teamAlias)
+			if err := r.LitellmClient.UpdateTeamMemberAssociation(ctx, &associationRequest); err != nil {
+				log.Error(err, "Failed to update team member association in LiteLLM")
+				return r.HandleErrorRetryable(ctx, teamMemberAssociation, err, base.ReasonLitellmError)
 			}
 		}
+
 		r.updateTeamMemberAssociationStatus(teamMemberAssociation, externalData)
 		if err := r.PatchStatus(ctx, teamMemberAssociation); err != nil {
 			log.Error(err, "Failed to update status")
@@ -278,15 +305,9 @@ func (r *TeamMemberAssociationReconciler) ensureExternal(ctx context.Context, te
 		return ctrl.Result{}, nil
 	}
 
-	// Create or update the association
+	// Create the association
 	log.Info("Creating team member association in LiteLLM", "userEmail", userEmail, "teamAlias", teamAlias)
 
-	associationRequest, err := r.convertToTeamMemberAssociationRequest(teamMemberAssociation, userEmail, teamAlias)
-	if err != nil {
-		log.Error(err, "Failed to create team member association request")
-		return r.HandleErrorRetryable(ctx, teamMemberAssociation, err, base.ReasonInvalidSpec)
-	}
-
 	createResponse, err := r.LitellmClient.CreateTeamMemberAssociation(ctx, &associationRequest)
 	if err != nil {
 		log.Error(err, "Failed to create team member association in LiteLLM")
@@ -307,11 +328,28 @@ func (r *TeamMemberAssociationReconciler) ensureExternal(ctx context.Context, te
 	return ctrl.Result{}, nil
 }
 
-// isUserCorrectlyInTeam checks if the User is already in the Team with the correct role
-func (r *TeamMemberAssociationReconciler) isUserCorrectlyInTeam(teamResponse litellm.TeamResponse, teamMemberAssociation *authv1alpha1.TeamMemberAssociation, userEmail string) bool {
-	for _, member := range teamResponse.MembersWithRole {
-		if member.UserEmail == userEmail {
-			return member.Role == teamMemberAssociation.Spec.Role
+// findTeamMember returns the member of the Team with the given email, or nil when the User is not in the Team
+func findTeamMember(teamResponse litellm.TeamResponse, userEmail string) *litellm.TeamMemberWithRole {
+	for i := range teamResponse.MembersWithRole {
+		if teamResponse.MembersWithRole[i].UserEmail == userEmail {
+			return &teamResponse.MembersWithRole[i]
+		}
+	}
+	return nil
+}
+
+// isUserCorrectlyInTeam checks if the member of the Team has the requested role and budget. A budget that is not
+// requested is left as it is
+func (r *TeamMemberAssociationReconciler) isUserCorrectlyInTeam(teamResponse litellm.TeamResponse, member *litellm.TeamMemberWithRole, associationRequest *litellm.TeamMemberAssociationRequest) bool {
+	if member.Role != associationRequest.Role {
+		return false
+	}
+	if associationRequest.MaxBudgetInTeam == 0 {
+		return true
+	}
+	for _, membership := range teamResponse.TeamMemberships {
+		if membership.UserID == member.UserID {
+			return membership.LiteLLMBudgetTable != nil && membership.LiteLLMBudgetTable.MaxBudget == associationRequest.MaxBudgetInTeam
 		}
 	}
 	return false
@@ -411,11 +449,32 @@ func (r *TeamMemberAssociationReconciler) SetupWithManager(mgr ctrl.Manager) err
 		return r.mapTeamToAssociations(obj)
 	})
 
+	// Index the connection secret so that rotating it re-reconciles the associations using it
+	if err := common.IndexSecretRefs(mgr, &authv1alpha1.TeamMemberAssociation{}, func(obj client.Object) []string {
+		association := obj.(*authv1alpha1.TeamMemberAssociation)
+		return common.ConnectionSecretIndexValues(association.Spec.ConnectionRef, association.Namespace)
+	}); err != nil {
+		return err
+	}
+
+	// Index the referenced Budget so that changing it re-reconciles the associations using it
+	if err := common.IndexBudgetRefs(mgr, &authv1alpha1.TeamMemberAssociation{}, func(obj client.Object) []string {
+		association := obj.(*authv1alpha1.TeamMemberAssociation)
+		return common.BudgetRefIndexValues(association.Spec.BudgetRef, association.Namespace)
+	}); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&authv1alpha1.TeamMemberAssociation{}).
-		Watches(&authv1alpha1.User{}, userHandler).
-		Watches(&authv1alpha1.Team{}, teamHandler).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		For(&authv1alpha1.TeamMemberAssociation{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&authv1alpha1.User{}, userHandler, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&authv1alpha1.Team{}, teamHandler, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{},
+			common.EnqueueForSecret(mgr.GetClient(), func() client.ObjectList { return &authv1alpha1.TeamMemberAssociationList{} }),
+			builder.WithPredicates(common.SecretDataChangedPredicate())).
+		Watches(&authv1alpha1.Budget{},
+			common.EnqueueForBudget(mgr.GetClient(), func() client.ObjectList { return &authv1alpha1.TeamMemberAssociationList{} }),
+			builder.WithPredicates(common.BudgetChangedPredicate())).
 		Named("litellm-teammemberassociation").
 		Complete(r)
 }
@@ -41,6 +41,8 @@ import (
 type mockLitellmTeamMemberAssociationClient struct {
 	associations map[string]map[string]*litellm.TeamMemberWithRole // teamAlias -> userEmail -> member info
 	teams        map[string]*litellm.TeamResponse                  // teamAlias -> team info
+	budgets      map[string]float64                                // userID -> max budget in team
+	updates      int
 	createError  error
 	deleteError  error
 	getTeamError error
@@ -51,6 +53,7 @@ func newMockLitellmTeamMemberAssociationClient() *mockLitellmTeamMemberAssociati
 	return &mockLitellmTeamMemberAssociationClient{
 		associations: make(map[string]map[string]*litellm.TeamMemberWithRole),
 		teams:        make(map[string]*litellm.TeamResponse),
+		budgets:      make(map[string]float64),
 	}
 }
 
@@ -71,6 +74,7 @@ func (m *mockLitellmTeamMemberAssociationClient) CreateTeamMemberAssociation(ctx
 		Role:      req.Role,
 	}
 	m.associations[req.TeamAlias][req.UserEmail] = member
+	m.budgets[member.UserID] = req.MaxBudgetInTeam
 
 	return litellm.TeamMemberAssociationResponse{
 		TeamAlias: req.TeamAlias,
@@ -80,6 +84,30 @@ func (m *mockLitellmTeamMemberAssociationClient) CreateTeamMemberAssociation(ctx
 	}, nil
 }
 
+func (m *mockLitellmTeamMemberAssociationClient) UpdateTeamMemberAssociation(ctx context.Context, req *litellm.TeamMemberAssociationRequest) error {
+	member := m.associations[req.TeamAlias][req.UserEmail]
+	if member == nil {
+		return errors.New("user is not in the team")
+	}
+	member.Role = req.Role
+	m.budgets[member.UserID] = req.MaxBudgetInTeam
+	m.updates++
+	return nil
+}
+
+// memberships returns the team memberships with the budgets of the members
+func (m *mockLitellmTeamMemberAssociationClient) memberships(members []litellm.TeamMemberWithRole) []litellm.TeamMembership {
+	var memberships []litellm.TeamMembership
+	for _, member := range members {
+		membership := litellm.TeamMembership{UserID: member.UserID}
+		if budget, ok := m.budgets[member.UserID]; ok && budget != 0 {
+			membership.LiteLLMBudgetTable = &litellm.TeamMemberBudget{MaxBudget: budget}
+		}
+		memberships = append(memberships, membership)
+	}
+	return memberships
+}
+
 func (m *mockLitellmTeamMemberAssociationClient) DeleteTeamMemberAssociation(ctx context.Context, teamAlias string, userEmail string) error {
 	if m.deleteError != nil {
 		return m.deleteError
@@ -107,6 +135,7 @@ func (m *mockLitellmTeamMemberAssociationClient) GetTeam(ctx context.Context, te
 				}
 			}
 			team.MembersWithRole = members
+			team.TeamMemberships = m.memberships(members)
 			return *team, nil
 		}
 	}
@@ -123,6 +152,7 @@ func (m *mockLitellmTeamMemberAssociationClient) GetTeam(ctx context.Context, te
 			members = append(members, *member)
 		}
 		team.MembersWithRole = members
+		team.TeamMemberships = m.memberships(members)
 	}
 	m.teams[teamAlias] = team
 	return *team, nil
@@ -268,6 +298,97 @@ var _ = Describe("TeamMemberAssociation Controller", func() {
 		)
 	})
 
+	Context("When the user is already in the team", func() {
+		reconcileAssociation := func(association *authv1alpha1.TeamMemberAssociation) {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "default"},
+				Data: map[string][]byte{
+					"masterkey": []byte("test-key"),
+					"url":       []byte("http://test-url"),
+				},
+			}
+			Expect(reconciler.Create(context.Background(), secret)).To(Succeed())
+			Expect(reconciler.Create(context.Background(), association)).To(Succeed())
+
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: association.Name, Namespace: association.Namespace},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			mockClient.associations["test-team"] = map[string]*litellm.TeamMemberWithRole{
+				"test@example.com": {UserID: "user-test@example.com", UserEmail: "test@example.com", Role: "user"},
+			}
+			mockClient.budgets["user-test@example.com"] = 10
+		})
+
+		It("should update the budget of the user in the team", func() {
+			association := createTestTeamMemberAssociation("budget-update-test")
+			association.Spec.MaxBudgetInTeam = "20"
+			reconcileAssociation(association)
+
+			Expect(mockClient.updates).To(Equal(1))
+			Expect(mockClient.budgets["user-test@example.com"]).To(Equal(20.0))
+		})
+
+		It("should use the referenced Budget as the budget of the user in the team", func() {
+			budget := &authv1alpha1.Budget{
+				ObjectMeta: metav1.ObjectMeta{Name: "member-budget", Namespace: "default"},
+				Spec:       authv1alpha1.BudgetSpec{MaxBudget: "15"},
+				Status:     authv1alpha1.BudgetStatus{BudgetID: "member-budget"},
+			}
+			Expect(reconciler.Create(context.Background(), budget)).To(Succeed())
+
+			association := createTestTeamMemberAssociation("budget-ref-update-test")
+			association.Spec.BudgetRef = &authv1alpha1.CRDRef{Name: "member-budget"}
+			reconcileAssociation(association)
+
+			Expect(mockClient.updates).To(Equal(1))
+			Expect(mockClient.budgets["user-test@example.com"]).To(Equal(15.0))
+		})
+
+		It("should refuse a referenced Budget with limits a team member cannot apply", func() {
+			budget := &authv1alpha1.Budget{
+				ObjectMeta: metav1.ObjectMeta{Name: "model-budget", Namespace: "default"},
+				Spec: authv1alpha1.BudgetSpec{
+					MaxBudget:      "15",
+					ModelMaxBudget: map[string]authv1alpha1.ModelBudget{"gpt-4o": {MaxBudget: "5"}},
+				},
+				Status: authv1alpha1.BudgetStatus{BudgetID: "model-budget"},
+			}
+			Expect(reconciler.Create(context.Background(), budget)).To(Succeed())
+
+			association := createTestTeamMemberAssociation("model-budget-ref-test")
+			association.Spec.BudgetRef = &authv1alpha1.CRDRef{Name: "model-budget"}
+			reconcileAssociation(association)
+
+			Expect(mockClient.updates).To(BeZero())
+			updatedAssociation := &authv1alpha1.TeamMemberAssociation{}
+			Expect(reconciler.Get(context.Background(), types.NamespacedName{Name: association.Name, Namespace: "default"}, updatedAssociation)).To(Succeed())
+			condition := findCondition(updatedAssociation.Status.Conditions, base.CondDegraded)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(base.ReasonConfigError))
+			Expect(condition.Message).To(ContainSubstring("modelMaxBudget"))
+		})
+
+		It("should update the role of the user in the team", func() {
+			association := createTestTeamMemberAssociation("role-update-test")
+			association.Spec.Role = "admin"
+			reconcileAssociation(association)
+
+			Expect(mockClient.updates).To(Equal(1))
+			Expect(mockClient.associations["test-team"]["test@example.com"].Role).To(Equal("admin"))
+		})
+
+		It("should leave a budget that is not requested as it is", func() {
+			reconcileAssociation(createTestTeamMemberAssociation("budget-unset-test"))
+
+			Expect(mockClient.updates).To(BeZero())
+			Expect(mockClient.budgets["user-test@example.com"]).To(Equal(10.0))
+		})
+	})
+
 	Context("When handling finalizer lifecycle", func() {
 		It("should successfully delete external resources and remove finalizer", func() {
 			association := &authv1alpha1.TeamMemberAssociation{
@@ -0,0 +1,316 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+	litellm "github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// BudgetReconciler reconciles a Budget object
+type BudgetReconciler struct {
+	*base.BaseController[*authv1alpha1.Budget]
+	LitellmClient litellm.LitellmBudget
+}
+
+// NewBudgetReconciler creates a new BudgetReconciler instance
+func NewBudgetReconciler(client client.Client, scheme *runtime.Scheme) *BudgetReconciler {
+	return &BudgetReconciler{
+		BaseController: &base.BaseController[*authv1alpha1.Budget]{
+			Client:         client,
+			Scheme:         scheme,
+			DefaultTimeout: 20 * time.Second,
+			ControllerName: "budget",
+		},
+		LitellmClient: nil,
+	}
+}
+
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=budgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=budgets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=budgets/finalizers,verbs=update
+
+// Reconcile implements the single-loop ensure* pattern with finalizer, conditions, and drift sync
+func (r *BudgetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// Add timeout to avoid long-running reconciliation
+	ctx, cancel := r.WithTimeout(ctx)
+	defer cancel()
+
+	// Instrument the reconcile loop
+	r.InstrumentReconcileLoop()
+	timer := r.InstrumentReconcileLatency()
+	defer timer.ObserveDuration()
+
+	log := log.FromContext(ctx)
+
+	// Phase 1: Fetch and validate the resource
+	budget := &authv1alpha1.Budget{}
+	budget, err := r.FetchResource(ctx, req.NamespacedName, budget)
+	if err != nil {
+		log.Error(err, "Failed to get Budget")
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+	if budget == nil {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Reconciling external budget resource", "budget", budget.Name)
+	// Phase 2: Set up connections and clients
+	if err := r.ensureConnectionSetup(ctx, budget); err != nil {
+		log.Error(err, "Failed to setup connections")
+		return r.HandleErrorRetryable(ctx, budget, err, base.ReasonConnectionError)
+	}
+
+	// Phase 3: Handle deletion if resource is being deleted
+	if !budget.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, budget)
+	}
+
+	// Phase 4: Upsert branch - ensure finalizer
+	if err := r.AddFinalizer(ctx, budget, util.FinalizerName); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 5: Ensure external resource (create/patch/repair drift)
+	if res, err := r.ensureExternal(ctx, budget); res.RequeueAfter > 0 || err != nil {
+		r.InstrumentReconcileError()
+		return res, err
+	}
+
+	// Phase 6: Mark Ready and persist ObservedGeneration
+	r.SetSuccessConditions(budget, "Budget is in desired state")
+	budget.Status.ObservedGeneration = budget.GetGeneration()
+	if err := r.PatchStatus(ctx, budget); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 7: Periodic drift sync (external might change out of band)
+	return ctrl.Result{RequeueAfter: 60 * time.Second}, nil
+}
+
+// ensureConnectionSetup configures the LiteLLM client
+func (r *BudgetReconciler) ensureConnectionSetup(ctx context.Context, budget *authv1alpha1.Budget) error {
+	if r.LitellmClient == nil {
+		litellmConnectionHandler, err := common.NewLitellmConnectionHandler(r.Client, ctx, budget.Spec.ConnectionRef, budget.Namespace)
+		if err != nil {
+			return err
+		}
+		r.LitellmClient = litellmConnectionHandler.GetLitellmClient()
+	}
+
+	return nil
+}
+
+// reconcileDelete handles the deletion branch with idempotent external cleanup
+func (r *BudgetReconciler) reconcileDelete(ctx context.Context, budget *authv1alpha1.Budget) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	if !r.HasFinalizer(budget, util.FinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	r.SetCondition(budget, base.CondReady, metav1.ConditionFalse, base.ReasonDeleting, "Budget is being deleted")
+	if err := r.PatchStatus(ctx, budget); err != nil {
+		log.Error(err, "Failed to update status during deletion")
+		// Continue with deletion even if status update fails
+	}
+
+	// Idempotent external cleanup
+	if budget.Status.BudgetID != "" {
+		if err := r.LitellmClient.DeleteBudget(ctx, budget.Status.BudgetID); err != nil && !errors.Is(err, litellm.ErrNotFound) {
+			log.Error(err, "Failed to delete budget from LiteLLM")
+			return r.HandleErrorRetryable(ctx, budget, err, base.ReasonDeleteFailed)
+		}
+		log.Info("Successfully deleted budget from LiteLLM", "budgetID", budget.Status.BudgetID)
+	}
+
+	if err := r.RemoveFinalizer(ctx, budget, util.FinalizerName); err != nil {
+		log.Error(err, "Failed to remove finalizer")
+		return r.HandleErrorRetryable(ctx, budget, err, base.ReasonDeleteFailed)
+	}
+
+	log.Info("Successfully deleted budget", "budget", budget.Name)
+	return ctrl.Result{}, nil
+}
+
+// ensureExternal manages the external budget resource (create/patch/repair drift)
+func (r *BudgetReconciler) ensureExternal(ctx context.Context, budget *authv1alpha1.Budget) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("Ensuring external budget resource", "budget", budget.Name)
+
+	r.SetProgressingConditions(budget, "Reconciling budget in LiteLLM")
+	if err := r.PatchStatus(ctx, budget); err != nil {
+		log.Error(err, "Failed to update progressing status")
+		// Continue despite status update failure
+	}
+
+	budgetRequest, err := convertToBudgetRequest(budget)
+	if err != nil {
+		log.Error(err, "Failed to create budget request")
+		return r.HandleErrorRetryable(ctx, budget, err, base.ReasonInvalidSpec)
+	}
+
+	observedBudget, err := r.LitellmClient.GetBudget(ctx, budgetRequest.BudgetID)
+	if errors.Is(err, litellm.ErrNotFound) {
+		log.Info("Creating new budget in LiteLLM", "budgetID", budgetRequest.BudgetID)
+		createResponse, err := r.LitellmClient.CreateBudget(ctx, &budgetRequest)
+		if err != nil {
+			log.Error(err, "Failed to create budget in LiteLLM")
+			return r.HandleErrorRetryable(ctx, budget, err, base.ReasonLitellmError)
+		}
+
+		updateBudgetStatus(budget, createResponse)
+		if err := r.PatchStatus(ctx, budget); err != nil {
+			log.Error(err, "Failed to update status after creation")
+			return r.HandleErrorRetryable(ctx, budget, err, base.ReasonReconcileError)
+		}
+		log.Info("Successfully created budget in LiteLLM", "budgetID", createResponse.BudgetID)
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		log.Error(err, "Failed to get budget from LiteLLM")
+		return r.HandleErrorRetryable(ctx, budget, err, base.ReasonLitellmError)
+	}
+
+	if r.LitellmClient.IsBudgetUpdateNeeded(ctx, &observedBudget, &budgetRequest) {
+		log.Info("Repairing drift in LiteLLM", "budgetID", budgetRequest.BudgetID)
+		observedBudget, err = r.LitellmClient.UpdateBudget(ctx, &budgetRequest)
+		if err != nil {
+			log.Error(err, "Failed to update budget in LiteLLM")
+			return r.HandleErrorRetryable(ctx, budget, err, base.ReasonLitellmError)
+		}
+		log.Info("Successfully repaired drift in LiteLLM", "budgetID", budgetRequest.BudgetID)
+	} else {
+		log.V(1).Info("Budget is up to date in LiteLLM", "budgetID", budgetRequest.BudgetID)
+	}
+
+	updateBudgetStatus(budget, observedBudget)
+	if err := r.PatchStatus(ctx, budget); err != nil {
+		log.Error(err, "Failed to update status after drift check")
+		return r.HandleErrorRetryable(ctx, budget, err, base.ReasonReconcileError)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// budgetID returns the ID of the budget in LiteLLM
+func budgetID(budget *authv1alpha1.Budget) string {
+	if budget.Spec.BudgetID != "" {
+		return budget.Spec.BudgetID
+	}
+	return budget.Name
+}
+
+// parseBudget parses a budget amount, naming the field in the returned error
+func parseBudget(field, value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, errors.New(field + ": " + err.Error())
+	}
+	return amount, nil
+}
+
+// convertToBudgetRequest creates a BudgetRequest from a Budget (isolated for testing)
+func convertToBudgetRequest(budget *authv1alpha1.Budget) (litellm.BudgetRequest, error) {
+	budgetRequest := litellm.BudgetRequest{
+		BudgetDuration:      budget.Spec.BudgetDuration,
+		BudgetID:            budgetID(budget),
+		MaxParallelRequests: budget.Spec.MaxParallelRequests,
+		RPMLimit:            budget.Spec.RPMLimit,
+		TPMLimit:            budget.Spec.TPMLimit,
+	}
+
+	var err error
+	if budgetRequest.MaxBudget, err = parseBudget("maxBudget", budget.Spec.MaxBudget); err != nil {
+		return litellm.BudgetRequest{}, err
+	}
+	if budgetRequest.SoftBudget, err = parseBudget("softBudget", budget.Spec.SoftBudget); err != nil {
+		return litellm.BudgetRequest{}, err
+	}
+
+	if len(budget.Spec.ModelMaxBudget) > 0 {
+		budgetRequest.ModelMaxBudget = make(map[string]litellm.ModelBudget, len(budget.Spec.ModelMaxBudget))
+		for model, modelBudget := range budget.Spec.ModelMaxBudget {
+			maxBudget, err := parseBudget(fmt.Sprintf("modelMaxBudget[%s].maxBudget", model), modelBudget.MaxBudget)
+			if err != nil {
+				return litellm.BudgetRequest{}, err
+			}
+			budgetRequest.ModelMaxBudget[model] = litellm.ModelBudget{
+				BudgetDuration: modelBudget.BudgetDuration,
+				MaxBudget:      maxBudget,
+				RPMLimit:       modelBudget.RPMLimit,
+				TPMLimit:       modelBudget.TPMLimit,
+			}
+		}
+	}
+
+	return budgetRequest, nil
+}
+
+// updateBudgetStatus updates the status of the k8s Budget from the litellm response
+func updateBudgetStatus(budget *authv1alpha1.Budget, budgetResponse litellm.BudgetTable) {
+	budget.Status.BudgetDuration = budgetResponse.BudgetDuration
+	budget.Status.BudgetID = budgetResponse.BudgetID
+	budget.Status.BudgetResetAt = budgetResponse.BudgetResetAt
+	budget.Status.CreatedAt = budgetResponse.CreatedAt
+	budget.Status.MaxBudget = fmt.Sprintf("%.2f", budgetResponse.MaxBudget)
+	budget.Status.RPMLimit = budgetResponse.RPMLimit
+	budget.Status.SoftBudget = fmt.Sprintf("%.2f", budgetResponse.SoftBudget)
+	budget.Status.TPMLimit = budgetResponse.TPMLimit
+	budget.Status.UpdatedAt = budgetResponse.UpdatedAt
+}
+
+func (r *BudgetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index the connection secret so that rotating it re-reconciles the Budgets using it
+	if err := common.IndexSecretRefs(mgr, &authv1alpha1.Budget{}, func(obj client.Object) []string {
+		budget := obj.(*authv1alpha1.Budget)
+		return common.ConnectionSecretIndexValues(budget.Spec.ConnectionRef, budget.Namespace)
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&authv1alpha1.Budget{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{},
+			common.EnqueueForSecret(mgr.GetClient(), func() client.ObjectList { return &authv1alpha1.BudgetList{} }),
+			builder.WithPredicates(common.SecretDataChangedPredicate())).
+		Named("litellm-budget").
+		Complete(r)
+}
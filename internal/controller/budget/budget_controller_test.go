@@ -0,0 +1,206 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/util"
+)
+
+// mockLitellmBudgetClient implements the LitellmBudget interface for testing
+type mockLitellmBudgetClient struct {
+	budgets      map[string]*litellm.BudgetTable
+	createCalled bool
+	updateCalled bool
+	deleteCalled bool
+}
+
+func (m *mockLitellmBudgetClient) store(req *litellm.BudgetRequest) litellm.BudgetTable {
+	budget := &litellm.BudgetTable{
+		BudgetDuration:      req.BudgetDuration,
+		BudgetID:            req.BudgetID,
+		MaxBudget:           req.MaxBudget,
+		MaxParallelRequests: req.MaxParallelRequests,
+		ModelMaxBudget:      req.ModelMaxBudget,
+		RPMLimit:            req.RPMLimit,
+		SoftBudget:          req.SoftBudget,
+		TPMLimit:            req.TPMLimit,
+	}
+	m.budgets[req.BudgetID] = budget
+	return *budget
+}
+
+func (m *mockLitellmBudgetClient) CreateBudget(ctx context.Context, req *litellm.BudgetRequest) (litellm.BudgetTable, error) {
+	m.createCalled = true
+	return m.store(req), nil
+}
+
+func (m *mockLitellmBudgetClient) DeleteBudget(ctx context.Context, budgetID string) error {
+	m.deleteCalled = true
+	delete(m.budgets, budgetID)
+	return nil
+}
+
+func (m *mockLitellmBudgetClient) GetBudget(ctx context.Context, budgetID string) (litellm.BudgetTable, error) {
+	budget, ok := m.budgets[budgetID]
+	if !ok {
+		return litellm.BudgetTable{}, fmt.Errorf("%w: budget %s", litellm.ErrNotFound, budgetID)
+	}
+	return *budget, nil
+}
+
+func (m *mockLitellmBudgetClient) IsBudgetUpdateNeeded(ctx context.Context, budget *litellm.BudgetTable, req *litellm.BudgetRequest) bool {
+	return litellm.NewLitellmClient("", "").IsBudgetUpdateNeeded(ctx, budget, req)
+}
+
+func (m *mockLitellmBudgetClient) UpdateBudget(ctx context.Context, req *litellm.BudgetRequest) (litellm.BudgetTable, error) {
+	m.updateCalled = true
+	return m.store(req), nil
+}
+
+func setupTestBudgetReconciler(objects ...client.Object) (*BudgetReconciler, *mockLitellmBudgetClient) {
+	scheme := runtime.NewScheme()
+	_ = authv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&authv1alpha1.Budget{}).
+		Build()
+
+	mockClient := &mockLitellmBudgetClient{budgets: map[string]*litellm.BudgetTable{}}
+	reconciler := NewBudgetReconciler(fakeClient, scheme)
+	reconciler.LitellmClient = mockClient
+	return reconciler, mockClient
+}
+
+func createTestBudget() *authv1alpha1.Budget {
+	return &authv1alpha1.Budget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "finance",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: authv1alpha1.BudgetSpec{
+			ConnectionRef: authv1alpha1.ConnectionRef{
+				SecretRef: &authv1alpha1.SecretRef{
+					Name: "test-connection",
+					Keys: authv1alpha1.SecretKeys{
+						MasterKey: "masterkey",
+						URL:       "url",
+					},
+				},
+			},
+			MaxBudget:      "500",
+			SoftBudget:     "400",
+			BudgetDuration: "30d",
+			ModelMaxBudget: map[string]authv1alpha1.ModelBudget{
+				"gpt-4o": {MaxBudget: "100", BudgetDuration: "1d"},
+			},
+		},
+	}
+}
+
+var _ = Describe("Budget Controller", func() {
+	var (
+		ctx     context.Context
+		request ctrl.Request
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		request = ctrl.Request{NamespacedName: types.NamespacedName{Name: "finance", Namespace: "default"}}
+	})
+
+	It("creates the budget under the resource name and records its ID", func() {
+		reconciler, mockClient := setupTestBudgetReconciler(createTestBudget())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.createCalled).To(BeTrue())
+		Expect(mockClient.budgets).To(HaveKey("finance"))
+		Expect(mockClient.budgets["finance"].ModelMaxBudget).To(HaveKeyWithValue("gpt-4o", litellm.ModelBudget{MaxBudget: 100, BudgetDuration: "1d"}))
+
+		budget := &authv1alpha1.Budget{}
+		Expect(reconciler.Get(ctx, request.NamespacedName, budget)).To(Succeed())
+		Expect(budget.Status.BudgetID).To(Equal("finance"))
+		Expect(budget.Status.MaxBudget).To(Equal("500.00"))
+		Expect(budget.Finalizers).To(ContainElement(util.FinalizerName))
+	})
+
+	It("updates the budget when it drifts from the spec", func() {
+		reconciler, mockClient := setupTestBudgetReconciler(createTestBudget())
+		mockClient.budgets["finance"] = &litellm.BudgetTable{BudgetID: "finance", MaxBudget: 50}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.createCalled).To(BeFalse())
+		Expect(mockClient.updateCalled).To(BeTrue())
+		Expect(mockClient.budgets["finance"].MaxBudget).To(Equal(500.0))
+	})
+
+	It("deletes the budget from LiteLLM when the resource is deleted", func() {
+		budget := createTestBudget()
+		now := metav1.Now()
+		budget.DeletionTimestamp = &now
+		budget.Finalizers = []string{util.FinalizerName}
+		budget.Status.BudgetID = "finance"
+		reconciler, mockClient := setupTestBudgetReconciler(budget)
+		mockClient.budgets["finance"] = &litellm.BudgetTable{BudgetID: "finance"}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.deleteCalled).To(BeTrue())
+		Expect(mockClient.budgets).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Budget helpers", func() {
+	It("uses spec.budgetID over the resource name", func() {
+		budget := createTestBudget()
+		budget.Spec.BudgetID = "shared-finance"
+		req, err := convertToBudgetRequest(budget)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(req.BudgetID).To(Equal("shared-finance"))
+		Expect(req.SoftBudget).To(Equal(400.0))
+	})
+
+	It("rejects an invalid model budget", func() {
+		budget := createTestBudget()
+		budget.Spec.ModelMaxBudget["gpt-4o"] = authv1alpha1.ModelBudget{MaxBudget: "lots"}
+		_, err := convertToBudgetRequest(budget)
+		Expect(err).To(MatchError(ContainSubstring("modelMaxBudget[gpt-4o].maxBudget")))
+	})
+})
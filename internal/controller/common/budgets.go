@@ -0,0 +1,166 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+)
+
+// BudgetIndexKey is the field index listing the Budget a resource references, as a "namespace/name" value
+const BudgetIndexKey = ".spec.budgetRef"
+
+// Limits of a Budget that not every resource referencing it can apply
+const (
+	BudgetLimitMaxParallelRequests = "maxParallelRequests"
+	BudgetLimitModelMaxBudget      = "modelMaxBudget"
+	BudgetLimitSoftBudget          = "softBudget"
+)
+
+// ResolvedBudget holds the limits of a Budget resource in the form LiteLLM requests expect
+type ResolvedBudget struct {
+	BudgetID            string
+	BudgetDuration      string
+	MaxBudget           float64
+	MaxParallelRequests int
+	ModelMaxBudget      map[string]authv1alpha1.ModelBudget
+	RPMLimit            int
+	SoftBudget          float64
+	TPMLimit            int
+}
+
+// RejectLimits returns an error when the Budget sets any of the given limits, which the referencing resource
+// cannot apply, so that a limit is not silently dropped
+func (b ResolvedBudget) RejectLimits(limits ...string) error {
+	var set []string
+	for _, limit := range limits {
+		switch limit {
+		case BudgetLimitMaxParallelRequests:
+			if b.MaxParallelRequests != 0 {
+				set = append(set, limit)
+			}
+		case BudgetLimitModelMaxBudget:
+			if len(b.ModelMaxBudget) > 0 {
+				set = append(set, limit)
+			}
+		case BudgetLimitSoftBudget:
+			if b.SoftBudget != 0 {
+				set = append(set, limit)
+			}
+		}
+	}
+	if len(set) > 0 {
+		return fmt.Errorf("budget %s sets %s, which cannot be applied through budgetRef", b.BudgetID, strings.Join(set, ", "))
+	}
+	return nil
+}
+
+// BudgetRefIndexValues returns the index value of the Budget referenced by budgetRef, if any
+func BudgetRefIndexValues(budgetRef *authv1alpha1.CRDRef, namespace string) []string {
+	if budgetRef == nil || budgetRef.Name == "" {
+		return nil
+	}
+	if budgetRef.Namespace != "" {
+		namespace = budgetRef.Namespace
+	}
+	return []string{NamespacedIndexValue(namespace, budgetRef.Name)}
+}
+
+// IndexBudgetRefs registers the BudgetIndexKey field index for obj using extract
+func IndexBudgetRefs(mgr ctrl.Manager, obj client.Object, extract func(client.Object) []string) error {
+	return mgr.GetFieldIndexer().IndexField(context.Background(), obj, BudgetIndexKey, extract)
+}
+
+// EnqueueForBudget returns an event handler that enqueues every object in the list type
+// whose BudgetIndexKey index references the Budget that triggered the event
+func EnqueueForBudget(c client.Client, newList func() client.ObjectList) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		return mapIndexToRequests(ctx, c, newList(), BudgetIndexKey, NamespacedIndexValue(obj.GetNamespace(), obj.GetName()))
+	})
+}
+
+// BudgetChangedPredicate filters Budget updates down to those that change the Budget's limits or its LiteLLM ID,
+// so that resources referencing a Budget are reconciled once it has been created in LiteLLM
+func BudgetChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldBudget, ok := e.ObjectOld.(*authv1alpha1.Budget)
+			if !ok {
+				return false
+			}
+			newBudget, ok := e.ObjectNew.(*authv1alpha1.Budget)
+			if !ok {
+				return false
+			}
+			return oldBudget.Generation != newBudget.Generation || oldBudget.Status.BudgetID != newBudget.Status.BudgetID
+		},
+	}
+}
+
+// ResolveBudgetRef fetches the Budget referenced by budgetRef, defaulting its namespace to the given one,
+// and returns its limits once it has been created in LiteLLM
+func ResolveBudgetRef(ctx context.Context, c client.Client, budgetRef *authv1alpha1.CRDRef, namespace string) (ResolvedBudget, error) {
+	if budgetRef.Namespace != "" {
+		namespace = budgetRef.Namespace
+	}
+
+	budget := &authv1alpha1.Budget{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: budgetRef.Name}, budget); err != nil {
+		return ResolvedBudget{}, fmt.Errorf("failed to get budget %s/%s: %w", namespace, budgetRef.Name, err)
+	}
+	if budget.Status.BudgetID == "" {
+		return ResolvedBudget{}, fmt.Errorf("budget %s/%s has not been created in LiteLLM yet", namespace, budget.Name)
+	}
+
+	resolved := ResolvedBudget{
+		BudgetID:            budget.Status.BudgetID,
+		BudgetDuration:      budget.Spec.BudgetDuration,
+		MaxParallelRequests: budget.Spec.MaxParallelRequests,
+		ModelMaxBudget:      budget.Spec.ModelMaxBudget,
+		RPMLimit:            budget.Spec.RPMLimit,
+		TPMLimit:            budget.Spec.TPMLimit,
+	}
+
+	if budget.Spec.MaxBudget != "" {
+		maxBudget, err := strconv.ParseFloat(budget.Spec.MaxBudget, 64)
+		if err != nil {
+			return ResolvedBudget{}, fmt.Errorf("budget maxBudget: %w", err)
+		}
+		resolved.MaxBudget = maxBudget
+	}
+
+	if budget.Spec.SoftBudget != "" {
+		softBudget, err := strconv.ParseFloat(budget.Spec.SoftBudget, 64)
+		if err != nil {
+			return ResolvedBudget{}, fmt.Errorf("budget softBudget: %w", err)
+		}
+		resolved.SoftBudget = softBudget
+	}
+
+	return resolved, nil
+}
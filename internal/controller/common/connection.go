@@ -183,7 +183,7 @@ func (h *LitellmConnectionHandler) getConnectionDetailsFromInstanceRef(ctx conte
 	}
 
 	// Get the master key from the instance's secret
-	secretName := fmt.Sprintf("%s-secrets", instance.Name)
+	secretName := InstanceSecretName(instance.Name)
 	secret := &corev1.Secret{}
 	secretKey := types.NamespacedName{
 		Name:      secretName,
@@ -0,0 +1,91 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+)
+
+// ValidateGuardrails checks that every named guardrail exists. A name matching a Guardrail resource
+// requires that resource to have been created in LiteLLM; any other name must be known to LiteLLM,
+// which covers guardrails defined in the proxy config
+func ValidateGuardrails(ctx context.Context, c client.Client, lister litellm.GuardrailLister, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	guardrails := &litellmv1alpha1.GuardrailList{}
+	if err := c.List(ctx, guardrails); err != nil {
+		return fmt.Errorf("failed to list guardrails: %w", err)
+	}
+
+	var unresolved []string
+	for _, name := range names {
+		guardrail := findGuardrail(guardrails.Items, name)
+		if guardrail == nil {
+			unresolved = append(unresolved, name)
+			continue
+		}
+		if guardrail.Status.GuardrailID == "" {
+			return fmt.Errorf("guardrail %s/%s has not been created in LiteLLM yet", guardrail.Namespace, guardrail.Name)
+		}
+	}
+	if len(unresolved) == 0 {
+		return nil
+	}
+
+	known, err := lister.ListGuardrails(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list guardrails in LiteLLM: %w", err)
+	}
+	knownNames := make(map[string]bool, len(known))
+	for _, guardrail := range known {
+		knownNames[guardrail.GuardrailName] = true
+	}
+
+	var missing []string
+	for _, name := range unresolved {
+		if !knownNames[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("guardrails not found: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// findGuardrail returns the Guardrail resource that defines the named guardrail, or nil if there is none
+func findGuardrail(guardrails []litellmv1alpha1.Guardrail, name string) *litellmv1alpha1.Guardrail {
+	for i := range guardrails {
+		guardrailName := guardrails[i].Spec.GuardrailName
+		if guardrailName == "" {
+			guardrailName = guardrails[i].Name
+		}
+		if guardrailName == name {
+			return &guardrails[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+)
+
+const (
+	// TargetNamespaceIndexKey is the field index listing the namespaces a resource copies its key Secret into
+	TargetNamespaceIndexKey = ".spec.targetNamespaces"
+
+	// Labels identifying the resource a copy of a key Secret was delivered from. Owner references cannot cross
+	// namespaces, so copies are found and cleaned up through these labels
+	DeliveredFromKindLabel      = "auth.litellm.ai/delivered-from-kind"
+	DeliveredFromNamespaceLabel = "auth.litellm.ai/delivered-from-namespace"
+	DeliveredFromNameLabel      = "auth.litellm.ai/delivered-from-name"
+)
+
+// IndexTargetNamespaces registers the TargetNamespaceIndexKey field index for obj using extract
+func IndexTargetNamespaces(mgr ctrl.Manager, obj client.Object, extract func(client.Object) []string) error {
+	return mgr.GetFieldIndexer().IndexField(context.Background(), obj, TargetNamespaceIndexKey, extract)
+}
+
+// EnqueueForKeyDeliveryGrant returns an event handler that enqueues every object in the list type
+// whose TargetNamespaceIndexKey index includes the namespace of the KeyDeliveryGrant that triggered the event
+func EnqueueForKeyDeliveryGrant(c client.Client, newList func() client.ObjectList) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		return mapIndexToRequests(ctx, c, newList(), TargetNamespaceIndexKey, obj.GetNamespace())
+	})
+}
+
+// EnqueueForDeliveredSecret returns an event handler that enqueues the resource of the kind a copy of a key
+// Secret was delivered from, so that copies changed or deleted out of band are restored
+func EnqueueForDeliveredSecret(kind string) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		labels := obj.GetLabels()
+		if labels[DeliveredFromKindLabel] != kind || labels[DeliveredFromNameLabel] == "" {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: client.ObjectKey{
+			Namespace: labels[DeliveredFromNamespaceLabel],
+			Name:      labels[DeliveredFromNameLabel],
+		}}}
+	})
+}
+
+// DeliveredFromLabels returns the labels identifying the copies of the key Secret of the resource
+func DeliveredFromLabels(kind string, owner client.Object) map[string]string {
+	return map[string]string{
+		DeliveredFromKindLabel:      kind,
+		DeliveredFromNamespaceLabel: owner.GetNamespace(),
+		DeliveredFromNameLabel:      owner.GetName(),
+	}
+}
+
+// KeyDeliveryGranted reports whether a KeyDeliveryGrant in the namespace allows the resource to copy its key Secret into it
+func KeyDeliveryGranted(ctx context.Context, c client.Client, namespace string, owner client.Object) (bool, error) {
+	grants := &authv1alpha1.KeyDeliveryGrantList{}
+	if err := c.List(ctx, grants, client.InNamespace(namespace)); err != nil {
+		return false, fmt.Errorf("failed to list key delivery grants in namespace %s: %w", namespace, err)
+	}
+	for i := range grants.Items {
+		if grants.Items[i].Allows(owner.GetNamespace(), owner.GetName()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,386 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/interfaces"
+	"github.com/bbdsoftware/litellm-operator/internal/secretstore"
+)
+
+const (
+	// KeySecretKey holds the generated key in the key Secret
+	KeySecretKey = "key"
+	// PreviousKeySecretKey holds the key replaced by a rotation in the key Secret during its grace period
+	PreviousKeySecretKey = "previousKey"
+
+	// Formats of the document rendered from the data of a SecretTemplate
+	SecretFormatEnv  = "env"
+	SecretFormatJSON = "json"
+
+	// ReasonSecretConflict reports that the key Secret exists and is not controlled by the resource
+	ReasonSecretConflict = "SecretConflict"
+)
+
+// ErrKeySecretConflict is returned when the key Secret exists and was not written for the resource. Such a Secret is
+// never read, overwritten or adopted.
+var ErrKeySecretConflict = errors.New("secret already exists and is not controlled by the resource")
+
+// KeySecretValues are the values written to a key Secret and available to the templates of a SecretTemplate
+type KeySecretValues struct {
+	Key         string
+	KeyAlias    string
+	TeamID      string
+	UserID      string
+	URL         string
+	PreviousKey string
+}
+
+// KeyHash returns the SHA-256 hash of a key, which LiteLLM stores and reports in place of the key
+func KeyHash(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// KeySecretName returns the name of the Secret holding a generated key: the name set by the template, or the default name
+func KeySecretName(secretTemplate *authv1alpha1.SecretTemplate, defaultName string) string {
+	if secretTemplate != nil && secretTemplate.Name != "" {
+		return secretTemplate.Name
+	}
+	return defaultName
+}
+
+// ConnectionURL returns the URL of the LiteLLM instance the connection reference points to
+func ConnectionURL(ctx context.Context, k8sClient client.Client, connectionRef interfaces.ConnectionRefInterface, namespace string) (string, error) {
+	h := &LitellmConnectionHandler{Client: k8sClient}
+	connectionDetails, err := h.GetConnectionDetails(ctx, connectionRef, namespace)
+	if err != nil {
+		return "", err
+	}
+	return connectionDetails.URL, nil
+}
+
+// EnsureKeySecret writes a generated key to the Secret with the given name, rendering the template into it. LiteLLM
+// shows a key only once, so when the key is not known the key already in the Secret, or in the Secret previously
+// holding it, is rendered instead, and nothing is written when neither has one. When the template renames the Secret
+// the key is moved and the previous Secret is deleted.
+func EnsureKeySecret(ctx context.Context, k8sClient client.Client, scheme *runtime.Scheme, owner client.Object, name, previousName string, secretTemplate *authv1alpha1.SecretTemplate, values KeySecretValues) error {
+	var previous *corev1.Secret
+	if previousName != "" && previousName != name {
+		previous = &corev1.Secret{}
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: previousName, Namespace: owner.GetNamespace()}, previous); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to get secret %s: %w", previousName, err)
+			}
+			previous = nil
+		}
+		// Only a Secret written for the owner is read and deleted, never one created by something else under the previous name
+		if previous != nil && !metav1.IsControlledBy(previous, owner) {
+			previous = nil
+		}
+	}
+
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: owner.GetNamespace()}, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get secret %s: %w", name, err)
+		}
+	} else if !metav1.IsControlledBy(secret, owner) {
+		return fmt.Errorf("%w: %s", ErrKeySecretConflict, name)
+	}
+	for _, existing := range []*corev1.Secret{secret, previous} {
+		if existing == nil {
+			continue
+		}
+		if values.Key == "" {
+			values.Key = string(existing.Data[KeySecretKey])
+		}
+		if values.PreviousKey == "" {
+			values.PreviousKey = string(existing.Data[PreviousKeySecretKey])
+		}
+	}
+	if values.Key == "" {
+		return nil // The key is not known, so there is nothing to write
+	}
+
+	secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: owner.GetNamespace()}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, k8sClient, secret, func() error {
+		// Set controller reference for garbage collection
+		if err := controllerutil.SetControllerReference(owner, secret, scheme); err != nil {
+			return err
+		}
+		return RenderKeySecret(secret, secretTemplate, values)
+	}); err != nil {
+		return err
+	}
+
+	if previous != nil {
+		if err := k8sClient.Delete(ctx, previous); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete secret %s: %w", previous.Name, err)
+		}
+	}
+	return nil
+}
+
+// CheckKeySecretAvailable fails with ErrKeySecretConflict when the Secret with the given name exists and is not
+// controlled by the owner. LiteLLM shows a key only once, so it is checked before a key is generated.
+func CheckKeySecretAvailable(ctx context.Context, k8sClient client.Client, owner client.Object, name string) error {
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: owner.GetNamespace()}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get secret %s: %w", name, err)
+	}
+	if !metav1.IsControlledBy(secret, owner) {
+		return fmt.Errorf("%w: %s", ErrKeySecretConflict, name)
+	}
+	return nil
+}
+
+// RenderKeySecret replaces the data of the Secret with the key, the previous key during a rotation grace period and
+// the data of the template, and adds the labels and annotations of the template. The template cannot replace the
+// key or the previous key.
+func RenderKeySecret(secret *corev1.Secret, secretTemplate *authv1alpha1.SecretTemplate, values KeySecretValues) error {
+	data := map[string][]byte{KeySecretKey: []byte(values.Key)}
+	if values.PreviousKey != "" {
+		data[PreviousKeySecretKey] = []byte(values.PreviousKey)
+	}
+
+	if secretTemplate != nil {
+		for name := range secretTemplate.Data {
+			if isReservedSecretKey(name) {
+				return fmt.Errorf("secretTemplate data cannot set the reserved key %s", name)
+			}
+		}
+		rendered, err := renderSecretTemplateData(secretTemplate.Data, values)
+		if err != nil {
+			return err
+		}
+		for name, value := range rendered {
+			data[name] = []byte(value)
+		}
+
+		if secretTemplate.Format != "" {
+			formatKey := secretFormatKey(secretTemplate)
+			if isReservedSecretKey(formatKey) {
+				return fmt.Errorf("secretTemplate formatKey cannot be the reserved key %s", formatKey)
+			}
+			document, err := renderSecretDocument(secretTemplate.Format, rendered)
+			if err != nil {
+				return err
+			}
+			data[formatKey] = document
+		}
+
+		if len(secretTemplate.Labels) > 0 {
+			if secret.Labels == nil {
+				secret.Labels = map[string]string{}
+			}
+			maps.Copy(secret.Labels, secretTemplate.Labels)
+		}
+		if len(secretTemplate.Annotations) > 0 {
+			if secret.Annotations == nil {
+				secret.Annotations = map[string]string{}
+			}
+			maps.Copy(secret.Annotations, secretTemplate.Annotations)
+		}
+	}
+
+	secret.Data = data
+	return nil
+}
+
+// isReservedSecretKey reports whether the Secret key holds the key or the previous key
+func isReservedSecretKey(name string) bool {
+	return name == KeySecretKey || name == PreviousKeySecretKey
+}
+
+// renderSecretTemplateData executes the Go templates of the data of a SecretTemplate
+func renderSecretTemplateData(templates map[string]string, values KeySecretValues) (map[string]string, error) {
+	rendered := make(map[string]string, len(templates))
+	for name, text := range templates {
+		tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secretTemplate data %s: %w", name, err)
+		}
+		var value bytes.Buffer
+		if err := tmpl.Execute(&value, values); err != nil {
+			return nil, fmt.Errorf("failed to render secretTemplate data %s: %w", name, err)
+		}
+		rendered[name] = value.String()
+	}
+	return rendered, nil
+}
+
+// renderSecretDocument renders the data into a .env file or a JSON object, in key order
+func renderSecretDocument(format string, data map[string]string) ([]byte, error) {
+	switch format {
+	case SecretFormatEnv:
+		var document strings.Builder
+		for _, name := range slices.Sorted(maps.Keys(data)) {
+			value := data[name]
+			if strings.ContainsAny(value, " \t\r\n#'\"\\$`") {
+				value = strconv.Quote(value)
+			}
+			fmt.Fprintf(&document, "%s=%s\n", name, value)
+		}
+		return []byte(document.String()), nil
+	case SecretFormatJSON:
+		return json.Marshal(data)
+	}
+	return nil, fmt.Errorf("unsupported secretTemplate format %q", format)
+}
+
+// secretFormatKey returns the Secret key holding the document rendered from the data of the template
+func secretFormatKey(secretTemplate *authv1alpha1.SecretTemplate) string {
+	if secretTemplate.FormatKey != "" {
+		return secretTemplate.FormatKey
+	}
+	if secretTemplate.Format == SecretFormatJSON {
+		return "config.json"
+	}
+	return ".env"
+}
+
+// SecretStoreEntry is the entry a key Secret was last written to in an external secret store, as recorded in
+// status
+type SecretStoreEntry struct {
+	// Ref is the secret store the key Secret was written to
+	Ref *authv1alpha1.SecretStoreRef
+	// Path is the location of the entry in the secret store
+	Path string
+	// Checksum is the checksum of the data written
+	Checksum string
+}
+
+// SyncSecretStore writes the key Secret to the external secret store of the reference when its data, path or
+// store has changed since the last write, identified by the checksum stored in status, and removes it from the
+// previous entry through the store it was written to. A reference that has been removed removes the previous entry.
+// It returns the entry of the last write.
+func SyncSecretStore(ctx context.Context, k8sClient client.Client, scheme *runtime.Scheme, owner client.Object, ref *authv1alpha1.SecretStoreRef, secretName, keyAlias string, last SecretStoreEntry) (SecretStoreEntry, error) {
+	if last.Ref == nil && last.Path != "" {
+		// Entries recorded before the store was recorded were written to the current store
+		last.Ref = ref
+	}
+	if ref == nil {
+		if err := RemoveFromSecretStore(ctx, k8sClient, scheme, owner, secretName, last); err != nil {
+			return last, err
+		}
+		return SecretStoreEntry{}, nil
+	}
+	if secretName == "" {
+		return last, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: secretName, Namespace: owner.GetNamespace()}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return last, nil // The key Secret has not been written yet
+		}
+		return last, fmt.Errorf("failed to get secret %s: %w", secretName, err)
+	}
+	if len(secret.Data[KeySecretKey]) == 0 {
+		return last, nil
+	}
+
+	path, err := secretstore.Path(ref, owner.GetNamespace(), keyAlias)
+	if err != nil {
+		return last, err
+	}
+	store, err := json.Marshal(ref)
+	if err != nil {
+		return last, err
+	}
+	// Changes to the store are written like changes to the data
+	values := map[string]string{"@path": path, "@store": string(store)}
+	for key, value := range secret.Data {
+		values[key] = string(value)
+	}
+	checksum := SecretChecksum(values)
+	written := SecretStoreEntry{Ref: ref, Path: path, Checksum: checksum}
+	if checksum == last.Checksum {
+		return written, nil
+	}
+
+	sink, err := secretstore.NewSink(ctx, k8sClient, scheme, ref)
+	if err != nil {
+		return last, err
+	}
+	entry := secretstore.KeySecret{Owner: owner, SecretName: secretName, Path: path, Data: secret.Data}
+	if err := sink.Write(ctx, entry); err != nil {
+		return last, err
+	}
+	if last.Path != "" && (last.Path != path || !sameSecretStore(last.Ref, ref)) {
+		if err := RemoveFromSecretStore(ctx, k8sClient, scheme, owner, secretName, last); err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// RemoveFromSecretStore removes the key Secret from the entry it was last written to, through the store it was
+// written to. An entry in a ClusterVaultStore that no longer exists cannot be removed and is treated as removed.
+func RemoveFromSecretStore(ctx context.Context, k8sClient client.Client, scheme *runtime.Scheme, owner client.Object, secretName string, last SecretStoreEntry) error {
+	if last.Ref == nil || last.Path == "" {
+		return nil
+	}
+
+	sink, err := secretstore.NewSink(ctx, k8sClient, scheme, last.Ref)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return sink.Delete(ctx, secretstore.KeySecret{Owner: owner, SecretName: secretName, Path: last.Path})
+}
+
+// sameSecretStore reports whether both references write to the same store. PushSecrets are one object per resource,
+// whose previous store is cleaned up by the External Secrets Operator when the PushSecret is updated.
+func sameSecretStore(a, b *authv1alpha1.SecretStoreRef) bool {
+	switch {
+	case a == nil || b == nil:
+		return a == b
+	case a.Vault != nil && b.Vault != nil:
+		return a.Vault.Name == b.Vault.Name
+	case a.PushSecret != nil && b.PushSecret != nil:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/interfaces"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+)
+
+// MCPServerAllowedTeams returns the Teams granted access to an MCPServer
+func MCPServerAllowedTeams(server *litellmv1alpha1.MCPServer) []litellmv1alpha1.ResourceRef {
+	return server.Spec.AllowedTeams
+}
+
+// MCPServerAllowedKeys returns the VirtualKeys granted access to an MCPServer
+func MCPServerAllowedKeys(server *litellmv1alpha1.MCPServer) []litellmv1alpha1.ResourceRef {
+	return server.Spec.AllowedKeys
+}
+
+// MCPServerPermission returns the object permission granting obj access to every MCPServer on the same LiteLLM
+// connection whose allowed list references it. managed holds the IDs of the MCP servers access was last granted
+// to by the operator. It returns nil when obj is neither referenced by an MCPServer nor was granted access by the
+// operator, leaving permissions managed outside the operator untouched
+func MCPServerPermission(ctx context.Context, c client.Client, obj client.Object, connectionRef interfaces.ConnectionRefInterface, managed []string, allowed func(*litellmv1alpha1.MCPServer) []litellmv1alpha1.ResourceRef) (*litellm.ObjectPermission, error) {
+	servers := &litellmv1alpha1.MCPServerList{}
+	if err := c.List(ctx, servers); err != nil {
+		return nil, fmt.Errorf("failed to list MCP servers: %w", err)
+	}
+
+	connection := ConnectionSecretIndexValues(connectionRef, obj.GetNamespace())
+	key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	serverIDs := []string{}
+	for i := range servers.Items {
+		server := &servers.Items[i]
+		if server.Status.ServerID == "" || !server.DeletionTimestamp.IsZero() {
+			continue
+		}
+		// A server ID is only meaningful on the LiteLLM instance the server is registered with
+		if len(connection) == 0 || !slices.Equal(ConnectionSecretIndexValues(server.Spec.ConnectionRef, server.Namespace), connection) {
+			continue
+		}
+		for _, ref := range allowed(server) {
+			if resourceRefKey(ref, server.Namespace) == key {
+				serverIDs = append(serverIDs, server.Status.ServerID)
+				break
+			}
+		}
+	}
+	if len(serverIDs) == 0 && len(managed) == 0 {
+		return nil, nil
+	}
+	sort.Strings(serverIDs)
+	return &litellm.ObjectPermission{MCPServers: serverIDs}, nil
+}
+
+// MCPServerIDs returns the IDs of the MCP servers an object permission grants access to, for recording in status
+func MCPServerIDs(permission *litellm.ObjectPermission) []string {
+	if permission == nil || len(permission.MCPServers) == 0 {
+		return nil
+	}
+	return permission.MCPServers
+}
+
+// EnqueueForMCPServer returns an event handler that enqueues the resources an MCPServer grants access to.
+// Update events map both the old and the new object, so resources removed from the list are enqueued too
+func EnqueueForMCPServer(allowed func(*litellmv1alpha1.MCPServer) []litellmv1alpha1.ResourceRef) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		server, ok := obj.(*litellmv1alpha1.MCPServer)
+		if !ok {
+			return nil
+		}
+		refs := allowed(server)
+		requests := make([]reconcile.Request, 0, len(refs))
+		for _, ref := range refs {
+			requests = append(requests, reconcile.Request{NamespacedName: resourceRefKey(ref, server.Namespace)})
+		}
+		return requests
+	})
+}
+
+// MCPServerAccessChangedPredicate filters MCPServer updates down to those that change which resources are
+// granted access or the server's LiteLLM ID
+func MCPServerAccessChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldServer, ok := e.ObjectOld.(*litellmv1alpha1.MCPServer)
+			if !ok {
+				return false
+			}
+			newServer, ok := e.ObjectNew.(*litellmv1alpha1.MCPServer)
+			if !ok {
+				return false
+			}
+			return oldServer.Generation != newServer.Generation || oldServer.Status.ServerID != newServer.Status.ServerID
+		},
+	}
+}
+
+// resourceRefKey returns the key of the resource referenced by ref, defaulting its namespace to the given one
+func resourceRefKey(ref litellmv1alpha1.ResourceRef, namespace string) types.NamespacedName {
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: ref.Name}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+)
+
+// RoutingPolicyInstanceKey returns the key of the LiteLLMInstance a RoutingPolicy references. Only a policy in the
+// namespace of the instance is applied to it, see RoutingPolicyNamespaceAllowed
+func RoutingPolicyInstanceKey(policy *litellmv1alpha1.RoutingPolicy) types.NamespacedName {
+	namespace := policy.Spec.InstanceRef.Namespace
+	if namespace == "" {
+		namespace = policy.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: policy.Spec.InstanceRef.Name}
+}
+
+// RoutingPolicyNamespaceAllowed reports whether the policy references an instance in its own namespace. A policy
+// cannot change the router settings of an instance in another namespace
+func RoutingPolicyNamespaceAllowed(policy *litellmv1alpha1.RoutingPolicy) bool {
+	return policy.Spec.InstanceRef.Namespace == "" || policy.Spec.InstanceRef.Namespace == policy.Namespace
+}
+
+// RoutingPolicyModelNames resolves the Model resources referenced by a RoutingPolicy to the tagged model
+// names they are registered under in LiteLLM
+func RoutingPolicyModelNames(ctx context.Context, c client.Client, policy *litellmv1alpha1.RoutingPolicy) (map[string]string, error) {
+	modelNames := map[string]string{}
+	for _, name := range policy.ReferencedModels() {
+		model := &litellmv1alpha1.Model{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: policy.Namespace, Name: name}, model); err != nil {
+			return nil, fmt.Errorf("failed to get model %s/%s: %w", policy.Namespace, name, err)
+		}
+		modelNames[name] = AppendModelSourceTag(model.Spec.ModelName, ModelTagCRD)
+	}
+	return modelNames, nil
+}
+
+// RoutingPolicyConflict names a router setting of a policy that an earlier policy of the same instance already sets
+type RoutingPolicyConflict struct {
+	Setting string
+	Policy  string
+}
+
+// RoutingPoliciesForInstance returns the RoutingPolicies in the namespace of a LiteLLMInstance that apply to it,
+// oldest first and then by name, the order in which they are rendered and their conflicts are resolved
+func RoutingPoliciesForInstance(ctx context.Context, c client.Client, instance client.Object) ([]litellmv1alpha1.RoutingPolicy, error) {
+	policies := &litellmv1alpha1.RoutingPolicyList{}
+	if err := c.List(ctx, policies, client.InNamespace(instance.GetNamespace())); err != nil {
+		return nil, fmt.Errorf("failed to list routing policies: %w", err)
+	}
+
+	key := types.NamespacedName{Namespace: instance.GetNamespace(), Name: instance.GetName()}
+	var matching []litellmv1alpha1.RoutingPolicy
+	for _, policy := range policies.Items {
+		if policy.DeletionTimestamp.IsZero() && RoutingPolicyNamespaceAllowed(&policy) && RoutingPolicyInstanceKey(&policy) == key {
+			matching = append(matching, policy)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool {
+		if !matching[i].CreationTimestamp.Equal(&matching[j].CreationTimestamp) {
+			return matching[i].CreationTimestamp.Before(&matching[j].CreationTimestamp)
+		}
+		return matching[i].Name < matching[j].Name
+	})
+	return matching, nil
+}
+
+// RoutingPolicyConflicts returns the policies, by name, that set numRetries, allowedFails, cooldownTime or a
+// retryPolicy entry already set by an earlier policy. The policies are expected in the order returned by
+// RoutingPoliciesForInstance; a conflicting policy is left out of the router settings as a whole.
+func RoutingPolicyConflicts(policies []litellmv1alpha1.RoutingPolicy) map[string]RoutingPolicyConflict {
+	conflicts := map[string]RoutingPolicyConflict{}
+	owners := map[string]string{}
+	for i := range policies {
+		settings := routingPolicySettings(&policies[i])
+		conflicting := false
+		for _, setting := range settings {
+			if owner, ok := owners[setting]; ok {
+				conflicts[policies[i].Name] = RoutingPolicyConflict{Setting: setting, Policy: owner}
+				conflicting = true
+				break
+			}
+		}
+		if conflicting {
+			continue
+		}
+		for _, setting := range settings {
+			owners[setting] = policies[i].Name
+		}
+	}
+	return conflicts
+}
+
+// routingPolicySettings returns the names of the router settings the policy replaces rather than adds to
+func routingPolicySettings(policy *litellmv1alpha1.RoutingPolicy) []string {
+	var settings []string
+	spec := policy.Spec
+	if spec.NumRetries != nil {
+		settings = append(settings, "numRetries")
+	}
+	if spec.AllowedFails != nil {
+		settings = append(settings, "allowedFails")
+	}
+	if spec.CooldownTime != nil {
+		settings = append(settings, "cooldownTime")
+	}
+	if retryPolicy := spec.RetryPolicy; retryPolicy != nil {
+		for _, entry := range []struct {
+			name    string
+			retries *int
+		}{
+			{"badRequestErrorRetries", retryPolicy.BadRequestErrorRetries},
+			{"authenticationErrorRetries", retryPolicy.AuthenticationErrorRetries},
+			{"timeoutErrorRetries", retryPolicy.TimeoutErrorRetries},
+			{"rateLimitErrorRetries", retryPolicy.RateLimitErrorRetries},
+			{"contentPolicyViolationErrorRetries", retryPolicy.ContentPolicyViolationErrorRetries},
+			{"internalServerErrorRetries", retryPolicy.InternalServerErrorRetries},
+		} {
+			if entry.retries != nil {
+				settings = append(settings, "retryPolicy."+entry.name)
+			}
+		}
+	}
+	return settings
+}
+
+// EnqueueForRoutingPolicyModel returns an event handler that enqueues the LiteLLMInstances of the RoutingPolicies
+// referencing a Model, so that a renamed Model is re-rendered into their fallbacks
+func EnqueueForRoutingPolicyModel(c client.Client) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		policies := &litellmv1alpha1.RoutingPolicyList{}
+		if err := c.List(ctx, policies, client.InNamespace(obj.GetNamespace())); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to list routing policies for model", "model", obj.GetName())
+			return nil
+		}
+
+		seen := map[types.NamespacedName]bool{}
+		var requests []reconcile.Request
+		for i := range policies.Items {
+			policy := &policies.Items[i]
+			key := RoutingPolicyInstanceKey(policy)
+			if seen[key] || !RoutingPolicyNamespaceAllowed(policy) || !slices.Contains(policy.ReferencedModels(), obj.GetName()) {
+				continue
+			}
+			seen[key] = true
+			requests = append(requests, reconcile.Request{NamespacedName: key})
+		}
+		return requests
+	})
+}
+
+// EnqueueForRoutingPolicy returns an event handler that enqueues the LiteLLMInstance a RoutingPolicy applies to
+func EnqueueForRoutingPolicy() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		policy, ok := obj.(*litellmv1alpha1.RoutingPolicy)
+		if !ok {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: RoutingPolicyInstanceKey(policy)}}
+	})
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/bbdsoftware/litellm-operator/internal/interfaces"
+)
+
+// SecretIndexKey is the field index listing the Secrets a resource reads, as "namespace/name" values
+const SecretIndexKey = ".spec.secretRefs"
+
+// SecretIndexValue returns the index value for the Secret with the given namespace and name
+func SecretIndexValue(namespace, name string) string {
+	return NamespacedIndexValue(namespace, name)
+}
+
+// InstanceSecretName returns the name of the Secret holding the master key of a LiteLLM instance
+func InstanceSecretName(instanceName string) string {
+	return fmt.Sprintf("%s-secrets", instanceName)
+}
+
+// ConnectionSecretIndexValues returns the index values of the Secrets LitellmConnectionHandler
+// reads for the connection reference: the connection secret, or the referenced instance's master key secret
+func ConnectionSecretIndexValues(connectionRef interfaces.ConnectionRefInterface, namespace string) []string {
+	if connectionRef.HasSecretRef() {
+		secretRef, ok := connectionRef.GetSecretRef().(interfaces.SecretRefInterface)
+		if !ok || secretRef.GetSecretName() == "" {
+			return nil
+		}
+		secretNamespace := secretRef.GetNamespace()
+		if secretNamespace == "" {
+			secretNamespace = namespace
+		}
+		return []string{SecretIndexValue(secretNamespace, secretRef.GetSecretName())}
+	}
+
+	if connectionRef.HasInstanceRef() {
+		instanceRef, ok := connectionRef.GetInstanceRef().(interfaces.InstanceRefInterface)
+		if !ok || instanceRef.GetInstanceName() == "" {
+			return nil
+		}
+		instanceNamespace := instanceRef.GetNamespace()
+		if instanceNamespace == "" {
+			instanceNamespace = namespace
+		}
+		return []string{SecretIndexValue(instanceNamespace, InstanceSecretName(instanceRef.GetInstanceName()))}
+	}
+
+	return nil
+}
+
+// IndexSecretRefs registers the SecretIndexKey field index for obj using extract
+func IndexSecretRefs(mgr ctrl.Manager, obj client.Object, extract func(client.Object) []string) error {
+	return mgr.GetFieldIndexer().IndexField(context.Background(), obj, SecretIndexKey, extract)
+}
+
+// EnqueueForSecret returns an event handler that enqueues every object in the list type
+// whose SecretIndexKey index references the Secret that triggered the event
+func EnqueueForSecret(c client.Client, newList func() client.ObjectList) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		return MapSecretToRequests(ctx, c, newList(), obj)
+	})
+}
+
+// MapSecretToRequests lists the objects referencing the Secret through the SecretIndexKey index
+// and returns a reconcile request for each of them
+func MapSecretToRequests(ctx context.Context, c client.Client, list client.ObjectList, secret client.Object) []reconcile.Request {
+	return mapIndexToRequests(ctx, c, list, SecretIndexKey, SecretIndexValue(secret.GetNamespace(), secret.GetName()))
+}
+
+// mapIndexToRequests lists the objects whose field index key matches value and returns a reconcile request for each of them
+func mapIndexToRequests(ctx context.Context, c client.Client, list client.ObjectList, key, value string) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	if err := c.List(ctx, list, client.MatchingFields{key: value}); err != nil {
+		log.Error(err, "Failed to list referencing resources", "index", key, "value", value)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	_ = meta.EachListItem(list, func(item runtime.Object) error {
+		if o, ok := item.(client.Object); ok {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+		}
+		return nil
+	})
+	return requests
+}
+
+// SecretDataChangedPredicate filters Secret updates down to those that change the Secret's contents
+func SecretDataChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldSecret, ok := e.ObjectOld.(*corev1.Secret)
+			if !ok {
+				return false
+			}
+			newSecret, ok := e.ObjectNew.(*corev1.Secret)
+			if !ok {
+				return false
+			}
+			return !reflect.DeepEqual(oldSecret.Data, newSecret.Data) || !reflect.DeepEqual(oldSecret.StringData, newSecret.StringData)
+		},
+	}
+}
+
+// SecretChecksum hashes the secret data in a stable order, so that pushes of credentials
+// LiteLLM does not return can be detected by comparing against a checksum stored in status
+func SecretChecksum(secretMap map[string]string) string {
+	hash := sha256.New()
+	for _, key := range slices.Sorted(maps.Keys(secretMap)) {
+		fmt.Fprintf(hash, "%s=%x\n", key, secretMap[key])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -29,6 +29,11 @@ const (
 	ModelTagInst = "-[inst]" // Models created from LiteLLMInstance resources
 )
 
+// NamespacedIndexValue returns the "namespace/name" field index value of the object with the given namespace and name
+func NamespacedIndexValue(namespace, name string) string {
+	return namespace + "/" + name
+}
+
 // ParseAndAssign parses a string field and assigns the float64 value to target.
 // Used for parsing cost and budget fields across multiple controllers.
 func ParseAndAssign(field *string, target *float64, fieldName string) error {
@@ -0,0 +1,337 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credential
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// CredentialReconciler reconciles a Credential object
+type CredentialReconciler struct {
+	*base.BaseController[*litellmv1alpha1.Credential]
+	LitellmCredentialClient litellm.LitellmCredential
+}
+
+// NewCredentialReconciler creates a new CredentialReconciler instance
+func NewCredentialReconciler(client client.Client, scheme *runtime.Scheme) *CredentialReconciler {
+	return &CredentialReconciler{
+		BaseController: &base.BaseController[*litellmv1alpha1.Credential]{
+			Client:         client,
+			Scheme:         scheme,
+			DefaultTimeout: 20 * time.Second,
+			ControllerName: "credential",
+		},
+		LitellmCredentialClient: nil,
+	}
+}
+
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=credentials,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=credentials/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=credentials/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// ============================================================================
+// Main Reconciler
+// ============================================================================
+
+// Reconcile implements the single-loop ensure* pattern with finalizer, conditions, and drift sync
+func (r *CredentialReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// Add timeout to avoid long-running reconciliation
+	ctx, cancel := r.WithTimeout(ctx)
+	defer cancel()
+
+	// Instrument the reconcile loop
+	r.InstrumentReconcileLoop()
+	timer := r.InstrumentReconcileLatency()
+	defer timer.ObserveDuration()
+
+	log := logf.FromContext(ctx)
+
+	// Phase 1: Fetch and validate the resource
+	credential := &litellmv1alpha1.Credential{}
+	credential, err := r.FetchResource(ctx, req.NamespacedName, credential)
+	if err != nil {
+		log.Error(err, "Failed to get Credential")
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+	if credential == nil {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Reconciling credential resource", "credential", credential.Name)
+	// Phase 2: Set up connections and clients
+	if err := r.ensureConnectionSetup(ctx, credential); err != nil {
+		log.Error(err, "Failed to setup connections")
+		return r.HandleErrorRetryable(ctx, credential, err, base.ReasonConnectionError)
+	}
+
+	// Phase 3: Handle deletion if resource is being deleted
+	if !credential.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, credential)
+	}
+
+	// Phase 4: Upsert branch - ensure finalizer
+	if err := r.AddFinalizer(ctx, credential, util.FinalizerName); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 5: Ensure external resource (create/patch/repair drift)
+	if res, err := r.ensureExternal(ctx, credential); res.Requeue || res.RequeueAfter > 0 || err != nil {
+		r.InstrumentReconcileError()
+		return res, err
+	}
+
+	// Phase 6: Mark Ready and persist ObservedGeneration
+	r.SetSuccessConditions(credential, "Credential is in desired state")
+	credential.Status.ObservedGeneration = credential.GetGeneration()
+	if err := r.PatchStatus(ctx, credential); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 7: Periodic drift sync (external might change out of band)
+	return ctrl.Result{RequeueAfter: 60 * time.Second}, nil
+}
+
+// ensureConnectionSetup configures the LiteLLM client
+func (r *CredentialReconciler) ensureConnectionSetup(ctx context.Context, credential *litellmv1alpha1.Credential) error {
+	if r.LitellmCredentialClient == nil {
+		litellmConnectionHandler, err := common.NewLitellmConnectionHandler(r.Client, ctx, credential.Spec.ConnectionRef, credential.Namespace)
+		if err != nil {
+			return err
+		}
+		r.LitellmCredentialClient = litellmConnectionHandler.GetLitellmClient()
+	}
+
+	return nil
+}
+
+// reconcileDelete handles the deletion branch with idempotent external cleanup
+func (r *CredentialReconciler) reconcileDelete(ctx context.Context, credential *litellmv1alpha1.Credential) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if !r.HasFinalizer(credential, util.FinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	// Set deleting condition and update status
+	r.SetCondition(credential, base.CondReady, metav1.ConditionFalse, base.ReasonDeleting, "Credential is being deleted")
+	if err := r.PatchStatus(ctx, credential); err != nil {
+		log.Error(err, "Failed to update status during deletion")
+		// Continue with deletion even if status update fails
+	}
+
+	// Idempotent external cleanup
+	if credential.Status.CredentialName != "" {
+		if err := r.deleteExternal(ctx, credential.Status.CredentialName); err != nil {
+			log.Error(err, "Failed to delete credential from LiteLLM")
+			return r.HandleErrorRetryable(ctx, credential, err, base.ReasonDeleteFailed)
+		}
+	}
+
+	// Remove finalizer
+	if err := r.RemoveFinalizer(ctx, credential, util.FinalizerName); err != nil {
+		log.Error(err, "Failed to remove finalizer")
+		return r.HandleErrorRetryable(ctx, credential, err, base.ReasonDeleteFailed)
+	}
+
+	log.Info("Successfully deleted credential", "credential", credential.Name)
+	return ctrl.Result{}, nil
+}
+
+// deleteExternal deletes the named credential from LiteLLM, treating an already deleted credential as success
+func (r *CredentialReconciler) deleteExternal(ctx context.Context, credentialName string) error {
+	log := logf.FromContext(ctx)
+
+	if err := r.LitellmCredentialClient.DeleteCredential(ctx, credentialName); err != nil {
+		if errors.Is(err, litellm.ErrNotFound) {
+			log.Info("Remote credential already not found in LiteLLM; proceeding to cleanup", "credentialName", credentialName)
+			return nil
+		}
+		return err
+	}
+
+	log.Info("Successfully deleted credential from LiteLLM", "credentialName", credentialName)
+	return nil
+}
+
+// ensureExternal manages the external credential (create/patch/repair drift)
+func (r *CredentialReconciler) ensureExternal(ctx context.Context, credential *litellmv1alpha1.Credential) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	log.Info("Ensuring external credential resource", "credential", credential.Name)
+
+	// Set progressing condition
+	r.SetProgressingConditions(credential, "Reconciling credential in LiteLLM")
+	if err := r.PatchStatus(ctx, credential); err != nil {
+		log.Error(err, "Failed to update progressing status")
+		// Continue despite status update failure
+	}
+
+	credentialRequest, err := r.convertToCredentialRequest(ctx, credential)
+	if err != nil {
+		log.Error(err, "Failed to create credential request")
+		return r.HandleErrorRetryable(ctx, credential, err, base.ReasonInvalidSpec)
+	}
+	secretChecksum := common.SecretChecksum(credentialRequest.CredentialValues)
+
+	// Remove the credential created under a previous name before creating the renamed one
+	if credential.Status.CredentialName != "" && credential.Status.CredentialName != credentialRequest.CredentialName {
+		log.Info("Credential name changed, deleting previous credential", "previous", credential.Status.CredentialName)
+		if err := r.deleteExternal(ctx, credential.Status.CredentialName); err != nil {
+			return r.HandleErrorRetryable(ctx, credential, err, base.ReasonDeleteFailed)
+		}
+		credential.Status.CredentialName = ""
+		credential.Status.SecretChecksum = ""
+	}
+
+	observedCredential, err := r.LitellmCredentialClient.GetCredential(ctx, credentialRequest.CredentialName)
+	if errors.Is(err, litellm.ErrNotFound) {
+		log.Info("Creating new credential in LiteLLM", "credentialName", credentialRequest.CredentialName)
+		if err := r.LitellmCredentialClient.CreateCredential(ctx, credentialRequest); err != nil {
+			log.Error(err, "Failed to create credential in LiteLLM")
+			return r.HandleErrorRetryable(ctx, credential, err, base.ReasonLitellmError)
+		}
+
+		r.updateCredentialStatus(credential, credentialRequest.CredentialName, secretChecksum)
+		if err := r.PatchStatus(ctx, credential); err != nil {
+			log.Error(err, "Failed to update status after creation")
+			return r.HandleErrorRetryable(ctx, credential, err, base.ReasonReconcileError)
+		}
+		log.Info("Successfully created credential in LiteLLM", "credentialName", credentialRequest.CredentialName)
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		log.Error(err, "Failed to get credential from LiteLLM")
+		return r.HandleErrorRetryable(ctx, credential, err, base.ReasonLitellmError)
+	}
+
+	// LiteLLM masks credential values, so a rotated secret is detected through its checksum
+	secretChanged := credential.Status.SecretChecksum != secretChecksum
+
+	if r.LitellmCredentialClient.IsCredentialUpdateNeeded(ctx, &observedCredential, credentialRequest) || secretChanged {
+		log.Info("Repairing drift in LiteLLM", "credentialName", credentialRequest.CredentialName, "secretChanged", secretChanged)
+		if err := r.LitellmCredentialClient.UpdateCredential(ctx, credentialRequest); err != nil {
+			log.Error(err, "Failed to update credential in LiteLLM")
+			return r.HandleErrorRetryable(ctx, credential, err, base.ReasonLitellmError)
+		}
+
+		r.updateCredentialStatus(credential, credentialRequest.CredentialName, secretChecksum)
+		if err := r.PatchStatus(ctx, credential); err != nil {
+			log.Error(err, "Failed to update status after update")
+			return r.HandleErrorRetryable(ctx, credential, err, base.ReasonReconcileError)
+		}
+		log.Info("Successfully repaired drift in LiteLLM", "credentialName", credentialRequest.CredentialName)
+	} else {
+		log.V(1).Info("Credential is up to date in LiteLLM", "credentialName", credentialRequest.CredentialName)
+		credential.Status.CredentialName = credentialRequest.CredentialName
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// updateCredentialStatus records the pushed credential on the status of the k8s Credential
+func (r *CredentialReconciler) updateCredentialStatus(credential *litellmv1alpha1.Credential, credentialName, secretChecksum string) {
+	credential.Status.ObservedGeneration = credential.Generation
+	now := metav1.Now()
+	credential.Status.LastUpdated = &now
+	credential.Status.CredentialName = credentialName
+	credential.Status.SecretChecksum = secretChecksum
+}
+
+// ============================================================================
+// Conversion Functions
+// ============================================================================
+
+// credentialName returns the name of the credential in LiteLLM
+func credentialName(credential *litellmv1alpha1.Credential) string {
+	if credential.Spec.CredentialName != "" {
+		return credential.Spec.CredentialName
+	}
+	return credential.Name
+}
+
+// credentialSecretKey returns the key of the Secret holding the credential values
+func credentialSecretKey(credential *litellmv1alpha1.Credential) client.ObjectKey {
+	namespace := credential.Spec.CredentialSecretRef.Namespace
+	if namespace == "" {
+		namespace = credential.Namespace
+	}
+	return client.ObjectKey{Namespace: namespace, Name: credential.Spec.CredentialSecretRef.SecretName}
+}
+
+// convertToCredentialRequest converts a Kubernetes Credential to a LiteLLM CredentialRequest
+func (r *CredentialReconciler) convertToCredentialRequest(ctx context.Context, credential *litellmv1alpha1.Credential) (*litellm.CredentialRequest, error) {
+	secretMap, err := util.GetMapFromSecret(ctx, r.Client, credentialSecretKey(credential))
+	if err != nil {
+		return nil, err
+	}
+	if len(secretMap) == 0 {
+		return nil, fmt.Errorf("secret %s contains no credential values", credential.Spec.CredentialSecretRef.SecretName)
+	}
+
+	return &litellm.CredentialRequest{
+		CredentialName:   credentialName(credential),
+		CredentialValues: secretMap,
+		CredentialInfo:   credential.Spec.CredentialInfo,
+	}, nil
+}
+
+// credentialSecretIndexValues returns the index values of the Secrets read when reconciling the Credential
+func credentialSecretIndexValues(obj client.Object) []string {
+	credential := obj.(*litellmv1alpha1.Credential)
+	values := common.ConnectionSecretIndexValues(credential.Spec.ConnectionRef, credential.Namespace)
+	if credential.Spec.CredentialSecretRef.SecretName != "" {
+		key := credentialSecretKey(credential)
+		values = append(values, common.SecretIndexValue(key.Namespace, key.Name))
+	}
+	return values
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CredentialReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index the credential and connection secrets so that rotating either re-pushes the credential
+	if err := common.IndexSecretRefs(mgr, &litellmv1alpha1.Credential{}, credentialSecretIndexValues); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&litellmv1alpha1.Credential{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{},
+			common.EnqueueForSecret(mgr.GetClient(), func() client.ObjectList { return &litellmv1alpha1.CredentialList{} }),
+			builder.WithPredicates(common.SecretDataChangedPredicate())).
+		Named("litellm-credential").
+		Complete(r)
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credential
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/util"
+)
+
+// FakeLitellmCredentialClient implements litellm.LitellmCredential for testing
+type FakeLitellmCredentialClient struct {
+	Credentials map[string]*litellm.CredentialRequest
+
+	// call tracking
+	CreateCalled bool
+	UpdateCalled bool
+	DeleteCalled bool
+}
+
+func (f *FakeLitellmCredentialClient) CreateCredential(ctx context.Context, req *litellm.CredentialRequest) error {
+	f.CreateCalled = true
+	f.Credentials[req.CredentialName] = req
+	return nil
+}
+func (f *FakeLitellmCredentialClient) UpdateCredential(ctx context.Context, req *litellm.CredentialRequest) error {
+	f.UpdateCalled = true
+	f.Credentials[req.CredentialName] = req
+	return nil
+}
+func (f *FakeLitellmCredentialClient) DeleteCredential(ctx context.Context, credentialName string) error {
+	f.DeleteCalled = true
+	if _, ok := f.Credentials[credentialName]; !ok {
+		return fmt.Errorf("%w: credential %s", litellm.ErrNotFound, credentialName)
+	}
+	delete(f.Credentials, credentialName)
+	return nil
+}
+func (f *FakeLitellmCredentialClient) GetCredential(ctx context.Context, credentialName string) (litellm.CredentialResponse, error) {
+	req, ok := f.Credentials[credentialName]
+	if !ok {
+		return litellm.CredentialResponse{}, fmt.Errorf("%w: credential %s", litellm.ErrNotFound, credentialName)
+	}
+	info := map[string]interface{}{}
+	for key, value := range req.CredentialInfo {
+		info[key] = value
+	}
+	return litellm.CredentialResponse{CredentialName: req.CredentialName, CredentialInfo: info}, nil
+}
+func (f *FakeLitellmCredentialClient) IsCredentialUpdateNeeded(ctx context.Context, credential *litellm.CredentialResponse, req *litellm.CredentialRequest) bool {
+	return litellm.NewLitellmClient("", "").IsCredentialUpdateNeeded(ctx, credential, req)
+}
+
+var _ = Describe("CredentialReconciler", func() {
+	const (
+		namespace            = "default"
+		resourceName         = "test-credential"
+		secretName           = "test-secret"
+		credentialSecretName = "test-credential-secret"
+	)
+
+	upsertSecret := func(name string, data map[string][]byte) {
+		secret := &corev1.Secret{}
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, secret)
+		if errors.IsNotFound(err) {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Data:       data,
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			return
+		} else if err != nil {
+			Fail("unexpected error getting secret: " + err.Error())
+		}
+		secret.Data = data
+		Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+	}
+
+	cleanupSecret := func(name string) {
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, secret); err == nil {
+			_ = k8sClient.Delete(ctx, secret)
+		}
+	}
+
+	newCredentialCR := func() *litellmv1alpha1.Credential {
+		return &litellmv1alpha1.Credential{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resourceName,
+				Namespace: namespace,
+			},
+			Spec: litellmv1alpha1.CredentialSpec{
+				ConnectionRef: litellmv1alpha1.ConnectionRef{
+					SecretRef: litellmv1alpha1.SecretRef{
+						Namespace:  namespace,
+						SecretName: secretName,
+					},
+				},
+				CredentialName:      "shared-openai",
+				CredentialSecretRef: litellmv1alpha1.SecretRef{SecretName: credentialSecretName},
+				CredentialInfo:      map[string]string{"custom_llm_provider": "openai"},
+			},
+		}
+	}
+
+	reconcileCredential := func(reconciler *CredentialReconciler) {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: resourceName, Namespace: namespace}})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Context("Credential reconciliation behaviour", func() {
+		var fakeClient *FakeLitellmCredentialClient
+		var reconciler *CredentialReconciler
+
+		BeforeEach(func() {
+			upsertSecret(secretName, map[string][]byte{
+				"masterkey": []byte("dummy-master-key"),
+				"url":       []byte("http://dummy-url"),
+			})
+			upsertSecret(credentialSecretName, map[string][]byte{
+				"api_key": []byte("sk-first"),
+			})
+			fakeClient = &FakeLitellmCredentialClient{Credentials: map[string]*litellm.CredentialRequest{}}
+			reconciler = NewCredentialReconciler(k8sClient, k8sClient.Scheme())
+			reconciler.LitellmCredentialClient = fakeClient
+		})
+
+		AfterEach(func() {
+			res := &litellmv1alpha1.Credential{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: namespace}, res)
+			if err == nil {
+				res.Finalizers = nil
+				_ = k8sClient.Update(ctx, res)
+				_ = k8sClient.Delete(ctx, res)
+				Eventually(func() bool {
+					r := &litellmv1alpha1.Credential{}
+					e := k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: namespace}, r)
+					return errors.IsNotFound(e)
+				}, time.Second*5, time.Millisecond*200).Should(BeTrue())
+			} else if !errors.IsNotFound(err) {
+				Fail("unexpected get error: " + err.Error())
+			}
+			cleanupSecret(secretName)
+			cleanupSecret(credentialSecretName)
+		})
+
+		It("creates the credential from the secret and records its checksum", func() {
+			Expect(k8sClient.Create(ctx, newCredentialCR())).To(Succeed())
+
+			reconcileCredential(reconciler)
+
+			Expect(fakeClient.CreateCalled).To(BeTrue())
+			pushed := fakeClient.Credentials["shared-openai"]
+			Expect(pushed).NotTo(BeNil())
+			Expect(pushed.CredentialValues).To(Equal(map[string]string{"api_key": "sk-first"}))
+			Expect(pushed.CredentialInfo).To(Equal(map[string]string{"custom_llm_provider": "openai"}))
+
+			fetched := &litellmv1alpha1.Credential{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: namespace}, fetched)).To(Succeed())
+			Expect(fetched.Status.CredentialName).To(Equal("shared-openai"))
+			Expect(fetched.Status.SecretChecksum).To(Equal(common.SecretChecksum(map[string]string{"api_key": "sk-first"})))
+		})
+
+		It("updates the credential when the secret is rotated", func() {
+			Expect(k8sClient.Create(ctx, newCredentialCR())).To(Succeed())
+			reconcileCredential(reconciler)
+			Expect(fakeClient.UpdateCalled).To(BeFalse())
+
+			upsertSecret(credentialSecretName, map[string][]byte{"api_key": []byte("sk-second")})
+			reconcileCredential(reconciler)
+
+			Expect(fakeClient.UpdateCalled).To(BeTrue())
+			Expect(fakeClient.Credentials["shared-openai"].CredentialValues).To(Equal(map[string]string{"api_key": "sk-second"}))
+		})
+
+		It("deletes the credential from LiteLLM when the resource is deleted", func() {
+			Expect(k8sClient.Create(ctx, newCredentialCR())).To(Succeed())
+			reconcileCredential(reconciler)
+
+			fetched := &litellmv1alpha1.Credential{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: namespace}, fetched)).To(Succeed())
+			Expect(fetched.Finalizers).To(ContainElement(util.FinalizerName))
+			Expect(k8sClient.Delete(ctx, fetched)).To(Succeed())
+
+			reconcileCredential(reconciler)
+
+			Expect(fakeClient.DeleteCalled).To(BeTrue())
+			Expect(fakeClient.Credentials).NotTo(HaveKey("shared-openai"))
+			Eventually(func() bool {
+				e := k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: namespace}, &litellmv1alpha1.Credential{})
+				return errors.IsNotFound(e)
+			}, time.Second*5, time.Millisecond*200).Should(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("Credential helpers", func() {
+	It("defaults the credential name to the resource name", func() {
+		credential := &litellmv1alpha1.Credential{ObjectMeta: metav1.ObjectMeta{Name: "openai"}}
+		Expect(credentialName(credential)).To(Equal("openai"))
+
+		credential.Spec.CredentialName = "shared-openai"
+		Expect(credentialName(credential)).To(Equal("shared-openai"))
+	})
+
+	It("indexes the connection and credential secrets", func() {
+		credential := &litellmv1alpha1.Credential{
+			ObjectMeta: metav1.ObjectMeta{Name: "openai", Namespace: "team-a"},
+			Spec: litellmv1alpha1.CredentialSpec{
+				ConnectionRef:       litellmv1alpha1.ConnectionRef{InstanceRef: litellmv1alpha1.InstanceRef{Name: "proxy", Namespace: "litellm"}},
+				CredentialSecretRef: litellmv1alpha1.SecretRef{SecretName: "openai-key"},
+			},
+		}
+		Expect(credentialSecretIndexValues(credential)).To(Equal([]string{"litellm/proxy-secrets", "team-a/openai-key"}))
+	})
+})
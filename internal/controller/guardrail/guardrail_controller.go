@@ -0,0 +1,341 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardrail
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// GuardrailReconciler reconciles a Guardrail object
+type GuardrailReconciler struct {
+	*base.BaseController[*litellmv1alpha1.Guardrail]
+	LitellmGuardrailClient litellm.LitellmGuardrail
+}
+
+// NewGuardrailReconciler creates a new GuardrailReconciler instance
+func NewGuardrailReconciler(client client.Client, scheme *runtime.Scheme) *GuardrailReconciler {
+	return &GuardrailReconciler{
+		BaseController: &base.BaseController[*litellmv1alpha1.Guardrail]{
+			Client:         client,
+			Scheme:         scheme,
+			DefaultTimeout: 20 * time.Second,
+			ControllerName: "guardrail",
+		},
+		LitellmGuardrailClient: nil,
+	}
+}
+
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=guardrails,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=guardrails/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=guardrails/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// ============================================================================
+// Main Reconciler
+// ============================================================================
+
+// Reconcile implements the single-loop ensure* pattern with finalizer, conditions, and drift sync
+func (r *GuardrailReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// Add timeout to avoid long-running reconciliation
+	ctx, cancel := r.WithTimeout(ctx)
+	defer cancel()
+
+	// Instrument the reconcile loop
+	r.InstrumentReconcileLoop()
+	timer := r.InstrumentReconcileLatency()
+	defer timer.ObserveDuration()
+
+	log := logf.FromContext(ctx)
+
+	// Phase 1: Fetch and validate the resource
+	guardrail := &litellmv1alpha1.Guardrail{}
+	guardrail, err := r.FetchResource(ctx, req.NamespacedName, guardrail)
+	if err != nil {
+		log.Error(err, "Failed to get Guardrail")
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+	if guardrail == nil {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Reconciling guardrail resource", "guardrail", guardrail.Name)
+	// Phase 2: Set up connections and clients
+	if err := r.ensureConnectionSetup(ctx, guardrail); err != nil {
+		log.Error(err, "Failed to setup connections")
+		return r.HandleErrorRetryable(ctx, guardrail, err, base.ReasonConnectionError)
+	}
+
+	// Phase 3: Handle deletion if resource is being deleted
+	if !guardrail.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, guardrail)
+	}
+
+	// Phase 4: Upsert branch - ensure finalizer
+	if err := r.AddFinalizer(ctx, guardrail, util.FinalizerName); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 5: Ensure external resource (create/patch/repair drift)
+	if res, err := r.ensureExternal(ctx, guardrail); res.Requeue || res.RequeueAfter > 0 || err != nil {
+		r.InstrumentReconcileError()
+		return res, err
+	}
+
+	// Phase 6: Mark Ready and persist ObservedGeneration
+	r.SetSuccessConditions(guardrail, "Guardrail is in desired state")
+	guardrail.Status.ObservedGeneration = guardrail.GetGeneration()
+	if err := r.PatchStatus(ctx, guardrail); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 7: Periodic drift sync (external might change out of band)
+	return ctrl.Result{RequeueAfter: 60 * time.Second}, nil
+}
+
+// ensureConnectionSetup configures the LiteLLM client
+func (r *GuardrailReconciler) ensureConnectionSetup(ctx context.Context, guardrail *litellmv1alpha1.Guardrail) error {
+	if r.LitellmGuardrailClient == nil {
+		litellmConnectionHandler, err := common.NewLitellmConnectionHandler(r.Client, ctx, guardrail.Spec.ConnectionRef, guardrail.Namespace)
+		if err != nil {
+			return err
+		}
+		r.LitellmGuardrailClient = litellmConnectionHandler.GetLitellmClient()
+	}
+
+	return nil
+}
+
+// reconcileDelete handles the deletion branch with idempotent external cleanup
+func (r *GuardrailReconciler) reconcileDelete(ctx context.Context, guardrail *litellmv1alpha1.Guardrail) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if !r.HasFinalizer(guardrail, util.FinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	// Set deleting condition and update status
+	r.SetCondition(guardrail, base.CondReady, metav1.ConditionFalse, base.ReasonDeleting, "Guardrail is being deleted")
+	if err := r.PatchStatus(ctx, guardrail); err != nil {
+		log.Error(err, "Failed to update status during deletion")
+		// Continue with deletion even if status update fails
+	}
+
+	// Idempotent external cleanup
+	if guardrail.Status.GuardrailID != "" {
+		if err := r.LitellmGuardrailClient.DeleteGuardrail(ctx, guardrail.Status.GuardrailID); err != nil {
+			if !errors.Is(err, litellm.ErrNotFound) {
+				log.Error(err, "Failed to delete guardrail from LiteLLM")
+				return r.HandleErrorRetryable(ctx, guardrail, err, base.ReasonDeleteFailed)
+			}
+			log.Info("Remote guardrail already not found in LiteLLM; proceeding to cleanup", "guardrailID", guardrail.Status.GuardrailID)
+		}
+	}
+
+	// Remove finalizer
+	if err := r.RemoveFinalizer(ctx, guardrail, util.FinalizerName); err != nil {
+		log.Error(err, "Failed to remove finalizer")
+		return r.HandleErrorRetryable(ctx, guardrail, err, base.ReasonDeleteFailed)
+	}
+
+	log.Info("Successfully deleted guardrail", "guardrail", guardrail.Name)
+	return ctrl.Result{}, nil
+}
+
+// ensureExternal manages the external guardrail (create/patch/repair drift)
+func (r *GuardrailReconciler) ensureExternal(ctx context.Context, guardrail *litellmv1alpha1.Guardrail) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	log.Info("Ensuring external guardrail resource", "guardrail", guardrail.Name)
+
+	// Set progressing condition
+	r.SetProgressingConditions(guardrail, "Reconciling guardrail in LiteLLM")
+	if err := r.PatchStatus(ctx, guardrail); err != nil {
+		log.Error(err, "Failed to update progressing status")
+		// Continue despite status update failure
+	}
+
+	guardrailRequest, err := r.convertToGuardrailRequest(ctx, guardrail)
+	if err != nil {
+		log.Error(err, "Failed to create guardrail request")
+		return r.HandleErrorRetryable(ctx, guardrail, err, base.ReasonInvalidSpec)
+	}
+	secretChecksum := common.SecretChecksum(guardrailRequest.SecretParams)
+
+	// Adopt a guardrail previously created under the same name before creating a new one
+	guardrailID := guardrail.Status.GuardrailID
+	if guardrailID == "" {
+		guardrailID, err = r.LitellmGuardrailClient.GetGuardrailID(ctx, guardrailRequest.GuardrailName)
+		if err != nil {
+			log.Error(err, "Failed to look up guardrail in LiteLLM")
+			return r.HandleErrorRetryable(ctx, guardrail, err, base.ReasonLitellmError)
+		}
+	}
+
+	var observedGuardrail litellm.GuardrailResponse
+	if guardrailID != "" {
+		observedGuardrail, err = r.LitellmGuardrailClient.GetGuardrail(ctx, guardrailID)
+		if errors.Is(err, litellm.ErrNotFound) {
+			log.Info("Guardrail not found in LiteLLM, recreating", "guardrailID", guardrailID)
+			guardrailID = ""
+		} else if err != nil {
+			log.Error(err, "Failed to get guardrail from LiteLLM")
+			return r.HandleErrorRetryable(ctx, guardrail, err, base.ReasonLitellmError)
+		}
+	}
+
+	if guardrailID == "" {
+		log.Info("Creating new guardrail in LiteLLM", "guardrailName", guardrailRequest.GuardrailName)
+		createdGuardrail, err := r.LitellmGuardrailClient.CreateGuardrail(ctx, guardrailRequest)
+		if err != nil {
+			log.Error(err, "Failed to create guardrail in LiteLLM")
+			return r.HandleErrorRetryable(ctx, guardrail, err, base.ReasonLitellmError)
+		}
+
+		updateGuardrailStatus(guardrail, &createdGuardrail, secretChecksum)
+		if err := r.PatchStatus(ctx, guardrail); err != nil {
+			log.Error(err, "Failed to update status after creation")
+			return r.HandleErrorRetryable(ctx, guardrail, err, base.ReasonReconcileError)
+		}
+		log.Info("Successfully created guardrail in LiteLLM", "guardrailID", createdGuardrail.GuardrailID)
+		return ctrl.Result{}, nil
+	}
+
+	// LiteLLM may mask secret params, so a rotated secret is detected through its checksum
+	secretChanged := guardrail.Status.SecretChecksum != secretChecksum
+
+	if r.LitellmGuardrailClient.IsGuardrailUpdateNeeded(ctx, &observedGuardrail, guardrailRequest) || secretChanged {
+		log.Info("Repairing drift in LiteLLM", "guardrailID", guardrailID, "secretChanged", secretChanged)
+		updatedGuardrail, err := r.LitellmGuardrailClient.UpdateGuardrail(ctx, guardrailID, guardrailRequest)
+		if err != nil {
+			log.Error(err, "Failed to update guardrail in LiteLLM")
+			return r.HandleErrorRetryable(ctx, guardrail, err, base.ReasonLitellmError)
+		}
+		if updatedGuardrail.GuardrailID == "" {
+			updatedGuardrail.GuardrailID = guardrailID
+		}
+
+		updateGuardrailStatus(guardrail, &updatedGuardrail, secretChecksum)
+		if err := r.PatchStatus(ctx, guardrail); err != nil {
+			log.Error(err, "Failed to update status after update")
+			return r.HandleErrorRetryable(ctx, guardrail, err, base.ReasonReconcileError)
+		}
+		log.Info("Successfully repaired drift in LiteLLM", "guardrailID", guardrailID)
+	} else {
+		log.V(1).Info("Guardrail is up to date in LiteLLM", "guardrailID", guardrailID)
+		guardrail.Status.GuardrailID = guardrailID
+		guardrail.Status.GuardrailName = guardrailRequest.GuardrailName
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// updateGuardrailStatus records the pushed guardrail on the status of the k8s Guardrail
+func updateGuardrailStatus(guardrail *litellmv1alpha1.Guardrail, guardrailResponse *litellm.GuardrailResponse, secretChecksum string) {
+	guardrail.Status.ObservedGeneration = guardrail.Generation
+	now := metav1.Now()
+	guardrail.Status.LastUpdated = &now
+	guardrail.Status.GuardrailID = guardrailResponse.GuardrailID
+	guardrail.Status.GuardrailName = guardrailResponse.GuardrailName
+	guardrail.Status.SecretChecksum = secretChecksum
+}
+
+// ============================================================================
+// Conversion Functions
+// ============================================================================
+
+// guardrailName returns the name of the guardrail in LiteLLM
+func guardrailName(guardrail *litellmv1alpha1.Guardrail) string {
+	if guardrail.Spec.GuardrailName != "" {
+		return guardrail.Spec.GuardrailName
+	}
+	return guardrail.Name
+}
+
+// paramsSecretKey returns the key of the Secret holding the secret params
+func paramsSecretKey(guardrail *litellmv1alpha1.Guardrail) client.ObjectKey {
+	namespace := guardrail.Spec.ParamsSecretRef.Namespace
+	if namespace == "" {
+		namespace = guardrail.Namespace
+	}
+	return client.ObjectKey{Namespace: namespace, Name: guardrail.Spec.ParamsSecretRef.SecretName}
+}
+
+// convertToGuardrailRequest converts a Kubernetes Guardrail to a LiteLLM GuardrailRequest
+func (r *GuardrailReconciler) convertToGuardrailRequest(ctx context.Context, guardrail *litellmv1alpha1.Guardrail) (*litellm.GuardrailRequest, error) {
+	req := &litellm.GuardrailRequest{
+		GuardrailName: guardrailName(guardrail),
+		Provider:      guardrail.Spec.Provider,
+		Mode:          guardrail.Spec.Mode,
+		DefaultOn:     guardrail.Spec.DefaultOn,
+		Params:        guardrail.Spec.Params,
+	}
+
+	if guardrail.Spec.ParamsSecretRef != nil {
+		secretMap, err := util.GetMapFromSecret(ctx, r.Client, paramsSecretKey(guardrail))
+		if err != nil {
+			return nil, err
+		}
+		req.SecretParams = secretMap
+	}
+
+	return req, nil
+}
+
+// guardrailSecretIndexValues returns the index values of the Secrets read when reconciling the Guardrail
+func guardrailSecretIndexValues(obj client.Object) []string {
+	guardrail := obj.(*litellmv1alpha1.Guardrail)
+	values := common.ConnectionSecretIndexValues(guardrail.Spec.ConnectionRef, guardrail.Namespace)
+	if guardrail.Spec.ParamsSecretRef != nil && guardrail.Spec.ParamsSecretRef.SecretName != "" {
+		key := paramsSecretKey(guardrail)
+		values = append(values, common.SecretIndexValue(key.Namespace, key.Name))
+	}
+	return values
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *GuardrailReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index the params and connection secrets so that rotating either re-pushes the guardrail
+	if err := common.IndexSecretRefs(mgr, &litellmv1alpha1.Guardrail{}, guardrailSecretIndexValues); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&litellmv1alpha1.Guardrail{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{},
+			common.EnqueueForSecret(mgr.GetClient(), func() client.ObjectList { return &litellmv1alpha1.GuardrailList{} }),
+			builder.WithPredicates(common.SecretDataChangedPredicate())).
+		Named("litellm-guardrail").
+		Complete(r)
+}
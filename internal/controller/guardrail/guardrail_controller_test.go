@@ -0,0 +1,227 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardrail
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/util"
+)
+
+// mockLitellmGuardrailClient implements the LitellmGuardrail interface for testing
+type mockLitellmGuardrailClient struct {
+	guardrails   map[string]*litellm.GuardrailResponse
+	lastRequest  *litellm.GuardrailRequest
+	createCalled bool
+	updateCalled bool
+	deleteCalled bool
+}
+
+func (m *mockLitellmGuardrailClient) store(guardrailID string, req *litellm.GuardrailRequest) litellm.GuardrailResponse {
+	m.lastRequest = req
+	guardrail := &litellm.GuardrailResponse{
+		GuardrailID:   guardrailID,
+		GuardrailName: req.GuardrailName,
+		LitellmParams: map[string]interface{}{"guardrail": req.Provider, "mode": req.Mode, "default_on": req.DefaultOn},
+	}
+	for key, value := range req.Params {
+		guardrail.LitellmParams[key] = value
+	}
+	m.guardrails[guardrailID] = guardrail
+	return *guardrail
+}
+
+func (m *mockLitellmGuardrailClient) CreateGuardrail(ctx context.Context, req *litellm.GuardrailRequest) (litellm.GuardrailResponse, error) {
+	m.createCalled = true
+	return m.store("g-"+req.GuardrailName, req), nil
+}
+
+func (m *mockLitellmGuardrailClient) DeleteGuardrail(ctx context.Context, guardrailID string) error {
+	m.deleteCalled = true
+	delete(m.guardrails, guardrailID)
+	return nil
+}
+
+func (m *mockLitellmGuardrailClient) GetGuardrail(ctx context.Context, guardrailID string) (litellm.GuardrailResponse, error) {
+	guardrail, ok := m.guardrails[guardrailID]
+	if !ok {
+		return litellm.GuardrailResponse{}, fmt.Errorf("%w: guardrail %s", litellm.ErrNotFound, guardrailID)
+	}
+	return *guardrail, nil
+}
+
+func (m *mockLitellmGuardrailClient) GetGuardrailID(ctx context.Context, guardrailName string) (string, error) {
+	for _, guardrail := range m.guardrails {
+		if guardrail.GuardrailName == guardrailName {
+			return guardrail.GuardrailID, nil
+		}
+	}
+	return "", nil
+}
+
+func (m *mockLitellmGuardrailClient) IsGuardrailUpdateNeeded(ctx context.Context, guardrail *litellm.GuardrailResponse, req *litellm.GuardrailRequest) bool {
+	return litellm.NewLitellmClient("", "").IsGuardrailUpdateNeeded(ctx, guardrail, req)
+}
+
+func (m *mockLitellmGuardrailClient) UpdateGuardrail(ctx context.Context, guardrailID string, req *litellm.GuardrailRequest) (litellm.GuardrailResponse, error) {
+	m.updateCalled = true
+	return m.store(guardrailID, req), nil
+}
+
+func (m *mockLitellmGuardrailClient) ListGuardrails(ctx context.Context) ([]litellm.GuardrailResponse, error) {
+	guardrails := make([]litellm.GuardrailResponse, 0, len(m.guardrails))
+	for _, guardrail := range m.guardrails {
+		guardrails = append(guardrails, *guardrail)
+	}
+	return guardrails, nil
+}
+
+func setupTestGuardrailReconciler(objects ...client.Object) (*GuardrailReconciler, *mockLitellmGuardrailClient) {
+	scheme := runtime.NewScheme()
+	_ = litellmv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&litellmv1alpha1.Guardrail{}).
+		Build()
+
+	mockClient := &mockLitellmGuardrailClient{guardrails: map[string]*litellm.GuardrailResponse{}}
+	reconciler := NewGuardrailReconciler(fakeClient, scheme)
+	reconciler.LitellmGuardrailClient = mockClient
+	return reconciler, mockClient
+}
+
+func createTestGuardrail() *litellmv1alpha1.Guardrail {
+	return &litellmv1alpha1.Guardrail{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "aporia-pre",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: litellmv1alpha1.GuardrailSpec{
+			ConnectionRef: litellmv1alpha1.ConnectionRef{
+				SecretRef: litellmv1alpha1.SecretRef{SecretName: "test-connection"},
+			},
+			Provider:        "aporia",
+			Mode:            "pre_call",
+			DefaultOn:       true,
+			Params:          map[string]string{"api_base": "https://aporia.example.com"},
+			ParamsSecretRef: &litellmv1alpha1.SecretRef{SecretName: "aporia-credentials"},
+		},
+	}
+}
+
+func createTestParamsSecret(apiKey string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "aporia-credentials", Namespace: "default"},
+		Data:       map[string][]byte{"api_key": []byte(apiKey)},
+	}
+}
+
+var _ = Describe("Guardrail Controller", func() {
+	var (
+		ctx     context.Context
+		request ctrl.Request
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		request = ctrl.Request{NamespacedName: types.NamespacedName{Name: "aporia-pre", Namespace: "default"}}
+	})
+
+	It("creates the guardrail with its secret params and records its ID", func() {
+		reconciler, mockClient := setupTestGuardrailReconciler(createTestGuardrail(), createTestParamsSecret("sk-aporia"))
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.createCalled).To(BeTrue())
+		Expect(mockClient.lastRequest.SecretParams).To(HaveKeyWithValue("api_key", "sk-aporia"))
+
+		guardrail := &litellmv1alpha1.Guardrail{}
+		Expect(reconciler.Get(ctx, request.NamespacedName, guardrail)).To(Succeed())
+		Expect(guardrail.Status.GuardrailID).To(Equal("g-aporia-pre"))
+		Expect(guardrail.Status.GuardrailName).To(Equal("aporia-pre"))
+		Expect(guardrail.Status.SecretChecksum).NotTo(BeEmpty())
+		Expect(guardrail.Finalizers).To(ContainElement(util.FinalizerName))
+	})
+
+	It("adopts a guardrail with the same name and repairs its drift", func() {
+		reconciler, mockClient := setupTestGuardrailReconciler(createTestGuardrail(), createTestParamsSecret("sk-aporia"))
+		mockClient.guardrails["existing"] = &litellm.GuardrailResponse{
+			GuardrailID:   "existing",
+			GuardrailName: "aporia-pre",
+			LitellmParams: map[string]interface{}{"guardrail": "aporia", "mode": "post_call"},
+		}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.createCalled).To(BeFalse())
+		Expect(mockClient.updateCalled).To(BeTrue())
+		Expect(mockClient.guardrails["existing"].LitellmParams).To(HaveKeyWithValue("mode", "pre_call"))
+
+		guardrail := &litellmv1alpha1.Guardrail{}
+		Expect(reconciler.Get(ctx, request.NamespacedName, guardrail)).To(Succeed())
+		Expect(guardrail.Status.GuardrailID).To(Equal("existing"))
+	})
+
+	It("re-pushes the guardrail when the params secret is rotated", func() {
+		reconciler, mockClient := setupTestGuardrailReconciler(createTestGuardrail(), createTestParamsSecret("sk-aporia"))
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mockClient.updateCalled).To(BeFalse())
+
+		Expect(reconciler.Update(ctx, createTestParamsSecret("sk-rotated"))).To(Succeed())
+		_, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.updateCalled).To(BeTrue())
+		Expect(mockClient.lastRequest.SecretParams).To(HaveKeyWithValue("api_key", "sk-rotated"))
+	})
+
+	It("deletes the guardrail from LiteLLM when the resource is deleted", func() {
+		guardrail := createTestGuardrail()
+		now := metav1.Now()
+		guardrail.DeletionTimestamp = &now
+		guardrail.Finalizers = []string{util.FinalizerName}
+		guardrail.Status.GuardrailID = "g-aporia-pre"
+		reconciler, mockClient := setupTestGuardrailReconciler(guardrail)
+		mockClient.guardrails["g-aporia-pre"] = &litellm.GuardrailResponse{GuardrailID: "g-aporia-pre"}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.deleteCalled).To(BeTrue())
+		Expect(mockClient.guardrails).To(BeEmpty())
+	})
+})
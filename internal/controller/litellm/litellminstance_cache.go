@@ -0,0 +1,199 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+)
+
+const (
+	// Response cache types
+	CacheTypeRedis          = "redis"
+	CacheTypeRedisSemantic  = "redis-semantic"
+	CacheTypeQdrantSemantic = "qdrant-semantic"
+	CacheTypeS3             = "s3"
+	CacheTypeLocal          = "local"
+
+	// Environment variables referenced from cache_params
+	QdrantAPIKeyEnv           = "QDRANT_API_KEY"
+	S3CacheAccessKeyIDEnv     = "S3_CACHE_AWS_ACCESS_KEY_ID"
+	S3CacheSecretAccessKeyEnv = "S3_CACHE_AWS_SECRET_ACCESS_KEY"
+)
+
+// applyCache enables the response cache of the instance and renders it into the cache_params of the
+// litellm_settings section. Keys set in litellmSettings.cacheParams take precedence over the rendered ones.
+func applyCache(litellmSettings *LitellmSettingsYAML, cache *litellmv1alpha1.Cache, redis *litellmv1alpha1.RedisSecretRef, embeddingModel string) error {
+	if cache == nil {
+		return nil
+	}
+
+	params, err := buildCacheParams(cache, redis, embeddingModel)
+	if err != nil {
+		return err
+	}
+	maps.Copy(params, litellmSettings.CacheParams)
+
+	litellmSettings.Cache = true
+	litellmSettings.CacheParams = params
+	return nil
+}
+
+// buildCacheParams renders the cache_params of the cache. Secret values are referenced through the
+// environment variables built by buildCacheEnvironmentVariables.
+func buildCacheParams(cache *litellmv1alpha1.Cache, redis *litellmv1alpha1.RedisSecretRef, embeddingModel string) (map[string]interface{}, error) {
+	cacheType := defaultString(cache.Type, CacheTypeRedis)
+	params := map[string]interface{}{"type": cacheType}
+	if cache.TTL != nil {
+		params["ttl"] = *cache.TTL
+	}
+	if cache.Namespace != "" {
+		params["namespace"] = cache.Namespace
+	}
+	if len(cache.SupportedCallTypes) > 0 {
+		params["supported_call_types"] = cache.SupportedCallTypes
+	}
+	if cache.SimilarityThreshold != "" {
+		threshold, err := strconv.ParseFloat(cache.SimilarityThreshold, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cache similarityThreshold %q: %w", cache.SimilarityThreshold, err)
+		}
+		params["similarity_threshold"] = threshold
+	}
+
+	switch cacheType {
+	case CacheTypeRedis, CacheTypeRedisSemantic:
+		if redis.NameRef == "" {
+			return nil, fmt.Errorf("cache type %s requires redisSecretRef", cacheType)
+		}
+		// Sentinel and Cluster connections are read by LiteLLM from the REDIS_* environment variables
+		if defaultString(redis.Mode, RedisModeStandalone) == RedisModeStandalone {
+			params["host"] = envReference(RedisHostEnv)
+			params["port"] = envReference(RedisPortEnv)
+			if redis.Keys.PasswordSecret != "" {
+				params["password"] = envReference(RedisPasswordEnv)
+			}
+		}
+		if cacheType == CacheTypeRedisSemantic && embeddingModel != "" {
+			params["redis_semantic_cache_embedding_model"] = embeddingModel
+		}
+	case CacheTypeQdrantSemantic:
+		if cache.Qdrant == nil {
+			return nil, fmt.Errorf("cache type %s requires qdrant", cacheType)
+		}
+		params["qdrant_api_base"] = cache.Qdrant.APIBase
+		params["qdrant_collection_name"] = cache.Qdrant.CollectionName
+		params["qdrant_quantization_config"] = defaultString(cache.Qdrant.QuantizationConfig, "binary")
+		if cache.Qdrant.APIKeySecretRef != nil {
+			params["qdrant_api_key"] = envReference(QdrantAPIKeyEnv)
+		}
+		if embeddingModel != "" {
+			params["qdrant_semantic_cache_embedding_model"] = embeddingModel
+		}
+	case CacheTypeS3:
+		if cache.S3 == nil {
+			return nil, fmt.Errorf("cache type %s requires s3", cacheType)
+		}
+		params["s3_bucket_name"] = cache.S3.BucketName
+		if cache.S3.Region != "" {
+			params["s3_region_name"] = cache.S3.Region
+		}
+		if cache.S3.EndpointURL != "" {
+			params["s3_endpoint_url"] = cache.S3.EndpointURL
+		}
+		if cache.S3.AccessKeyIDSecretRef != nil {
+			params["s3_aws_access_key_id"] = envReference(S3CacheAccessKeyIDEnv)
+		}
+		if cache.S3.SecretAccessKeySecretRef != nil {
+			params["s3_aws_secret_access_key"] = envReference(S3CacheSecretAccessKeyEnv)
+		}
+	}
+
+	return params, nil
+}
+
+// resolveCacheEmbeddingModel returns the model name, as served by the proxy, of the model embedding
+// the prompts of a semantic cache.
+func resolveCacheEmbeddingModel(ctx context.Context, k8sClient client.Client, llm *litellmv1alpha1.LiteLLMInstance) (string, error) {
+	cache := llm.Spec.Cache
+	if cache == nil {
+		return "", nil
+	}
+	if cache.EmbeddingModel != "" {
+		return common.AppendModelSourceTag(cache.EmbeddingModel, common.ModelTagInst), nil
+	}
+	if cache.EmbeddingModelRef == nil {
+		return "", nil
+	}
+
+	namespace := defaultString(cache.EmbeddingModelRef.Namespace, llm.Namespace)
+	model := &litellmv1alpha1.Model{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: cache.EmbeddingModelRef.Name, Namespace: namespace}, model); err != nil {
+		return "", fmt.Errorf("failed to get cache embedding model %s/%s: %w", namespace, cache.EmbeddingModelRef.Name, err)
+	}
+	return common.AppendModelSourceTag(model.Spec.ModelName, common.ModelTagCRD), nil
+}
+
+// buildCacheEnvironmentVariables builds the environment variables referenced from the cache_params.
+func buildCacheEnvironmentVariables(cache *litellmv1alpha1.Cache) []corev1.EnvVar {
+	if cache == nil {
+		return nil
+	}
+
+	var envVars []corev1.EnvVar
+	if cache.Qdrant != nil && cache.Qdrant.APIKeySecretRef != nil {
+		envVars = append(envVars, secretKeySelectorEnvVar(QdrantAPIKeyEnv, cache.Qdrant.APIKeySecretRef))
+	}
+	if cache.S3 != nil {
+		if cache.S3.AccessKeyIDSecretRef != nil {
+			envVars = append(envVars, secretKeySelectorEnvVar(S3CacheAccessKeyIDEnv, cache.S3.AccessKeyIDSecretRef))
+		}
+		if cache.S3.SecretAccessKeySecretRef != nil {
+			envVars = append(envVars, secretKeySelectorEnvVar(S3CacheSecretAccessKeyEnv, cache.S3.SecretAccessKeySecretRef))
+		}
+	}
+	return envVars
+}
+
+// cacheSecretNames returns the names of the secrets referenced by the cache.
+func cacheSecretNames(cache *litellmv1alpha1.Cache) []string {
+	var names []string
+	add := func(ref *corev1.SecretKeySelector) {
+		if ref != nil && ref.Name != "" {
+			names = append(names, ref.Name)
+		}
+	}
+	if cache == nil {
+		return names
+	}
+	if qdrant := cache.Qdrant; qdrant != nil {
+		add(qdrant.APIKeySecretRef)
+	}
+	if s3 := cache.S3; s3 != nil {
+		add(s3.AccessKeyIDSecretRef)
+		add(s3.SecretAccessKeySecretRef)
+	}
+	return names
+}
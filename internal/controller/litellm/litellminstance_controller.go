@@ -22,20 +22,33 @@ package litellm
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"gopkg.in/yaml.v2"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
 	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
@@ -44,9 +57,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
@@ -87,6 +104,54 @@ const (
 	ServicePort   = 4000                                    // Port that the Service exposes
 	ConfigPath    = "/etc/litellm/proxy_server_config.yaml" // Path to config file in container
 
+	// cert-manager annotations used to request Ingress certificates
+	CertManagerIssuerAnnotation        = "cert-manager.io/issuer"
+	CertManagerClusterIssuerAnnotation = "cert-manager.io/cluster-issuer"
+
+	// Redis connection modes
+	RedisModeStandalone = "standalone"
+	RedisModeSentinel   = "sentinel"
+	RedisModeCluster    = "cluster"
+
+	// Environment variables read by LiteLLM to connect to Redis
+	RedisHostEnv             = "REDIS_HOST"
+	RedisPortEnv             = "REDIS_PORT"
+	RedisPasswordEnv         = "REDIS_PASSWORD"
+	RedisUsernameEnv         = "REDIS_USERNAME"
+	RedisSSLEnv              = "REDIS_SSL"
+	RedisClusterNodesEnv     = "REDIS_CLUSTER_NODES"
+	RedisSentinelNodesEnv    = "REDIS_SENTINEL_NODES"
+	RedisServiceNameEnv      = "REDIS_SERVICE_NAME"
+	RedisSentinelPasswordEnv = "REDIS_SENTINEL_PASSWORD"
+
+	// Managed database providers and defaults
+	DatabaseProviderAuto          = "auto"
+	DatabaseProviderStatefulSet   = "statefulset"
+	DatabaseProviderCloudNativePG = "cloudnativepg"
+	DefaultPostgresImage          = "postgres:16-alpine"
+	PostgresPort                  = 5432
+
+	// Database migrations
+	MigrationContainerName = "migrate"
+	MigrationLabel         = "litellm.ai/migration"
+	DisableSchemaUpdateEnv = "DISABLE_SCHEMA_UPDATE" // Stops LiteLLM pods from migrating the schema on startup
+
+	// Checksum of the rendered config and referenced secrets, stamped on the pod template to roll the pods on change
+	ConfigChecksumAnnotation = "litellm.ai/config-checksum"
+
+	// Upgrade strategies and phases
+	UpgradeStrategyRollingUpdate = "RollingUpdate"
+	UpgradeStrategyCanary        = "Canary"
+	UpgradeStrategyBlueGreen     = "BlueGreen"
+	UpgradePhaseProgressing      = "Progressing"
+	UpgradePhasePromoting        = "Promoting"
+	UpgradePhaseSucceeded        = "Succeeded"
+	UpgradePhaseRolledBack       = "RolledBack"
+	UpgradePhaseAborted          = "Aborted"
+	UpgradeTrackLabel            = "litellm.ai/track"   // Distinguishes the pods of a new image during an upgrade
+	PromoteAnnotation            = "litellm.ai/promote" // Set to the target image to promote a blue/green upgrade manually
+	DefaultUpgradeHealthPath     = ReadinessPath        // Unlike /health, does not call every configured model
+
 	// Health check paths for container probes
 	LivenessPath  = "/health/liveness"  // Path for liveness probe
 	ReadinessPath = "/health/readiness" // Path for readiness probe
@@ -97,6 +162,12 @@ const (
 	CondTypeDeploymentReady = "DeploymentReady"
 	CondTypeServiceReady    = "ServiceReady"
 	CondTypeIngressReady    = "IngressReady"
+	CondTypeHPAReady        = "HPAReady"
+	CondTypePDBReady        = "PDBReady"
+	CondTypeHTTPRouteReady  = "HTTPRouteAccepted"
+	CondTypeDatabaseReady   = "DatabaseReady"
+	CondTypeMigrations      = "MigrationsApplied"
+	CondTypeUpgrading       = "Upgrading"
 	CondTypeReady           = "Ready"
 
 	ReasonConfigMapReady     = "ConfigMapReady"
@@ -109,6 +180,28 @@ const (
 	ReasonServiceNotReady    = "ServiceNotReady"
 	ReasonIngressReady       = "IngressReady"
 	ReasonIngressNotReady    = "IngressNotReady"
+	ReasonHPAReady           = "HPAReady"
+	ReasonHPANotReady        = "HPANotReady"
+	ReasonPDBReady           = "PDBReady"
+	ReasonPDBNotReady        = "PDBNotReady"
+	ReasonHTTPRouteAccepted  = "Accepted"
+	ReasonHTTPRoutePending   = "Pending"
+	ReasonHTTPRouteRejected  = "NotAccepted"
+	ReasonDatabaseReady      = "DatabaseReady"
+	ReasonDatabaseNotReady   = "DatabaseNotReady"
+	ReasonMigrationsApplied  = "MigrationsApplied"
+	ReasonMigrationRunning   = "MigrationRunning"
+	ReasonMigrationFailed    = "MigrationFailed"
+
+	ReasonUpgradeStarted           = "UpgradeStarted"
+	ReasonUpgradeNotReady          = "NewImageNotReady"
+	ReasonUpgradeUnhealthy         = "NewImageUnhealthy"
+	ReasonUpgradeHealthy           = "NewImageHealthy"
+	ReasonUpgradeAwaitingPromotion = "AwaitingPromotion"
+	ReasonUpgradePromoting         = "Promoting"
+	ReasonUpgradePromoted          = "Promoted"
+	ReasonUpgradeRolledBack        = "RolledBack"
+	ReasonUpgradeAborted           = "Aborted"
 
 	// Resource status constants for metrics
 	StatusCreated  = "created"
@@ -117,6 +210,13 @@ const (
 	StatusMissing  = "missing"
 )
 
+// httpRouteGVK identifies the Gateway API HTTPRoute kind. HTTPRoutes are handled as unstructured
+// objects so the operator does not depend on the Gateway API module and runs without its CRDs installed.
+var httpRouteGVK = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"}
+
+// cnpgClusterGVK identifies the CloudNativePG Cluster kind, handled as unstructured for the same reason.
+var cnpgClusterGVK = schema.GroupVersionKind{Group: "postgresql.cnpg.io", Version: "v1", Kind: "Cluster"}
+
 // LiteLLMInstanceReconciler reconciles a LiteLLMInstance object.
 // It is responsible for creating and managing the Kubernetes resources required
 // to run a LiteLLM proxy instance, including ConfigMaps, Secrets, Deployments,
@@ -124,6 +224,8 @@ const (
 type LiteLLMInstanceReconciler struct {
 	*base.BaseController[*litellmv1alpha1.LiteLLMInstance]
 	litellmResourceNaming *util.LitellmResourceNaming
+	// healthCheck probes the proxy health endpoint of a new image during canary and blue/green upgrades
+	healthCheck func(ctx context.Context, url, masterKey string, timeout time.Duration) error
 }
 
 type LiteLLMParamsYAML struct {
@@ -175,18 +277,62 @@ type ModelListItemYAML struct {
 }
 
 type RouterSettingsYAML struct {
-	Host     string `yaml:"host"`
-	Port     string `yaml:"port"`
-	Password string `yaml:"password,omitempty"`
+	RoutingStrategy        string                 `yaml:"routing_strategy,omitempty"`
+	NumRetries             *int                   `yaml:"num_retries,omitempty"`
+	Timeout                int                    `yaml:"timeout,omitempty"`
+	AllowedFails           *int                   `yaml:"allowed_fails,omitempty"`
+	CooldownTime           *int                   `yaml:"cooldown_time,omitempty"`
+	RetryAfter             int                    `yaml:"retry_after,omitempty"`
+	Fallbacks              []map[string][]string  `yaml:"fallbacks,omitempty"`
+	ContextWindowFallbacks []map[string][]string  `yaml:"context_window_fallbacks,omitempty"`
+	ContentPolicyFallbacks []map[string][]string  `yaml:"content_policy_fallbacks,omitempty"`
+	DefaultFallbacks       []string               `yaml:"default_fallbacks,omitempty"`
+	EnablePreCallChecks    bool                   `yaml:"enable_pre_call_checks,omitempty"`
+	ModelGroupAlias        map[string]string      `yaml:"model_group_alias,omitempty"`
+	RetryPolicy            map[string]int         `yaml:"retry_policy,omitempty"`
+	RedisHost              string                 `yaml:"redis_host,omitempty"`
+	RedisPort              string                 `yaml:"redis_port,omitempty"`
+	RedisPassword          string                 `yaml:"redis_password,omitempty"`
+	CacheKwargs            map[string]interface{} `yaml:"cache_kwargs,omitempty"`
+}
+
+type LitellmSettingsYAML struct {
+	Callbacks        []string               `yaml:"callbacks,omitempty"`
+	SuccessCallback  []string               `yaml:"success_callback,omitempty"`
+	FailureCallback  []string               `yaml:"failure_callback,omitempty"`
+	ServiceCallback  []string               `yaml:"service_callback,omitempty"`
+	DropParams       bool                   `yaml:"drop_params,omitempty"`
+	RequestTimeout   int                    `yaml:"request_timeout,omitempty"`
+	NumRetries       *int                   `yaml:"num_retries,omitempty"`
+	SetVerbose       bool                   `yaml:"set_verbose,omitempty"`
+	JSONLogs         bool                   `yaml:"json_logs,omitempty"`
+	Cache            bool                   `yaml:"cache,omitempty"`
+	CacheParams      map[string]interface{} `yaml:"cache_params,omitempty"`
+	S3CallbackParams map[string]string      `yaml:"s3_callback_params,omitempty"`
+}
+
+type GeneralSettingsYAML struct {
+	AllowRequestsOnDBUnavailable bool     `yaml:"allow_requests_on_db_unavailable"`
+	StoreModelInDB               bool     `yaml:"store_model_in_db"` //Needed to be able to store new models created via REST API
+	MaxParallelRequests          int      `yaml:"max_parallel_requests,omitempty"`
+	GlobalMaxParallelRequests    int      `yaml:"global_max_parallel_requests,omitempty"`
+	Alerting                     []string `yaml:"alerting,omitempty"`
+	AlertingThreshold            int      `yaml:"alerting_threshold,omitempty"`
+	AlertTypes                   []string `yaml:"alert_types,omitempty"`
+	BackgroundHealthChecks       bool     `yaml:"background_health_checks,omitempty"`
+	HealthCheckInterval          int      `yaml:"health_check_interval,omitempty"`
+	DisableSpendLogs             bool     `yaml:"disable_spend_logs,omitempty"`
+	MaxRequestSizeMB             int      `yaml:"max_request_size_mb,omitempty"`
+	MaxResponseSizeMB            int      `yaml:"max_response_size_mb,omitempty"`
+	DatabaseConnectionPoolLimit  int      `yaml:"database_connection_pool_limit,omitempty"`
+	ProxyBatchWriteAt            int      `yaml:"proxy_batch_write_at,omitempty"`
 }
 
 type ProxyConfig struct {
 	ModelList       []ModelListItemYAML `yaml:"model_list"`
+	LitellmSettings LitellmSettingsYAML `yaml:"litellm_settings,omitempty"`
 	RouterSettings  RouterSettingsYAML  `yaml:"router_settings,omitempty"`
-	GeneralSettings struct {
-		AllowRequestsOnDBUnavailable bool `yaml:"allow_requests_on_db_unavailable"`
-		StoreModelInDB               bool `yaml:"store_model_in_db"` //Needed to be able to store new models created via REST API
-	} `yaml:"general_settings"`
+	GeneralSettings GeneralSettingsYAML `yaml:"general_settings"`
 }
 
 func NewLiteLLMInstanceReconciler(c client.Client, scheme *runtime.Scheme) *LiteLLMInstanceReconciler {
@@ -197,12 +343,15 @@ func NewLiteLLMInstanceReconciler(c client.Client, scheme *runtime.Scheme) *Lite
 			DefaultTimeout: 20 * time.Second,
 			ControllerName: "litellminstance",
 		},
+		healthCheck: checkProxyHealth,
 	}
 }
 
 // +kubebuilder:rbac:groups=litellm.litellm.ai,resources=litellminstances,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=litellm.litellm.ai,resources=litellminstances/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=litellm.litellm.ai,resources=litellminstances/finalizers,verbs=update
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=models,verbs=get;list;watch
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=routingpolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
@@ -211,6 +360,12 @@ func NewLiteLLMInstanceReconciler(c client.Client, scheme *runtime.Scheme) *Lite
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=postgresql.cnpg.io,resources=clusters,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile moves the current state of the cluster closer to the desired state.
 // It creates or updates all required Kubernetes resources for a LiteLLMInstance:
@@ -273,6 +428,15 @@ func (r *LiteLLMInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	latest.Status.DeploymentCreated = llm.Status.DeploymentCreated
 	latest.Status.ServiceCreated = llm.Status.ServiceCreated
 	latest.Status.IngressCreated = llm.Status.IngressCreated
+	latest.Status.HPACreated = llm.Status.HPACreated
+	latest.Status.PDBCreated = llm.Status.PDBCreated
+	latest.Status.HTTPRouteCreated = llm.Status.HTTPRouteCreated
+	latest.Status.DatabaseCreated = llm.Status.DatabaseCreated
+	latest.Status.DatabaseProvider = llm.Status.DatabaseProvider
+	latest.Status.CurrentImage = llm.Status.CurrentImage
+	latest.Status.PreviousImage = llm.Status.PreviousImage
+	latest.Status.MigratedImage = llm.Status.MigratedImage
+	latest.Status.Upgrade = llm.Status.Upgrade
 
 	if err := r.updateStatus(ctx, latest); err != nil {
 		log.Error(err, "Failed to update status in Phase 7")
@@ -330,24 +494,46 @@ func (r *LiteLLMInstanceReconciler) ensureExternal(ctx context.Context, llm *lit
 		return r.HandleErrorFinal(ctx, llm, err, "LLM Instance Spec is invalid")
 	}
 
+	if managedDatabaseEnabled(llm) && llm.Spec.DatabaseSecretRef.NameRef != "" {
+		err := errors.New("databaseSecretRef and database.managed are mutually exclusive")
+		return r.HandleErrorFinal(ctx, llm, err, "LLM Instance Spec is invalid")
+	}
+
+	// The gateway splits the traffic between the instance and the canary by canary.weight
+	if upgradeStrategyType(llm) == UpgradeStrategyCanary && !llm.Spec.Gateway.Enabled {
+		err := errors.New("the Canary upgrade strategy requires gateway.enabled")
+		return r.HandleErrorFinal(ctx, llm, err, "LLM Instance Spec is invalid")
+	}
+
 	return ctrl.Result{}, nil
 }
 
 func (r *LiteLLMInstanceReconciler) ensureChildren(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
-	_, err := r.createConfigMap(ctx, llm)
+	configMap, err := r.createConfigMap(ctx, llm)
 	if err != nil {
 		log.Error(err, "Failed to create or update ConfigMap")
 		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
 	}
 
-	_, err = r.createMasterKeySecret(ctx, llm)
+	masterKeySecret, err := r.createMasterKeySecret(ctx, llm)
 	if err != nil {
 		log.Error(err, "Failed to create or update Secret")
 		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
 	}
 
+	checksum, err := r.configChecksum(ctx, llm, configMap, masterKeySecret)
+	if err != nil {
+		log.Error(err, "Failed to compute the config checksum")
+		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
+	}
+
+	if err := r.createManagedDatabase(ctx, llm); err != nil {
+		log.Error(err, "Failed to create or update managed database")
+		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
+	}
+
 	_, err = r.createServiceAccount(ctx, llm)
 	if err != nil {
 		log.Error(err, "Failed to create or update ServiceAccount")
@@ -359,7 +545,17 @@ func (r *LiteLLMInstanceReconciler) ensureChildren(ctx context.Context, llm *lit
 		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
 	}
 
-	_, err = r.createDeployment(ctx, llm)
+	if err := r.ensureMigrations(ctx, llm); err != nil {
+		log.Error(err, "Failed to run database migrations")
+		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
+	}
+
+	if err := r.ensureUpgrade(ctx, llm, checksum); err != nil {
+		log.Error(err, "Failed to progress the image upgrade")
+		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
+	}
+
+	_, err = r.createDeployment(ctx, llm, checksum)
 	if err != nil {
 		log.Error(err, "Failed to create or update Deployment")
 		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
@@ -371,11 +567,24 @@ func (r *LiteLLMInstanceReconciler) ensureChildren(ctx context.Context, llm *lit
 		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
 	}
 
-	if llm.Spec.Ingress.Enabled {
-		if err := r.createIngress(ctx, llm); err != nil {
-			log.Error(err, "Failed to create or update Ingress")
-			return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
-		}
+	if err := r.createHTTPRoute(ctx, llm); err != nil {
+		log.Error(err, "Failed to create or update HTTPRoute")
+		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
+	}
+
+	if err := r.createHorizontalPodAutoscaler(ctx, llm); err != nil {
+		log.Error(err, "Failed to create or update HorizontalPodAutoscaler")
+		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
+	}
+
+	if err := r.createPodDisruptionBudget(ctx, llm); err != nil {
+		log.Error(err, "Failed to create or update PodDisruptionBudget")
+		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
+	}
+
+	if err := r.createIngress(ctx, llm); err != nil {
+		log.Error(err, "Failed to create or update Ingress")
+		return r.HandleErrorRetryable(ctx, llm, err, base.ReasonReconcileError)
 	}
 
 	// Before updating status, get the latest version of the resource to avoid conflicts
@@ -393,6 +602,15 @@ func (r *LiteLLMInstanceReconciler) ensureChildren(ctx context.Context, llm *lit
 	latest.Status.DeploymentCreated = llm.Status.DeploymentCreated
 	latest.Status.ServiceCreated = llm.Status.ServiceCreated
 	latest.Status.IngressCreated = llm.Status.IngressCreated
+	latest.Status.HPACreated = llm.Status.HPACreated
+	latest.Status.PDBCreated = llm.Status.PDBCreated
+	latest.Status.HTTPRouteCreated = llm.Status.HTTPRouteCreated
+	latest.Status.DatabaseCreated = llm.Status.DatabaseCreated
+	latest.Status.DatabaseProvider = llm.Status.DatabaseProvider
+	latest.Status.CurrentImage = llm.Status.CurrentImage
+	latest.Status.PreviousImage = llm.Status.PreviousImage
+	latest.Status.MigratedImage = llm.Status.MigratedImage
+	latest.Status.Upgrade = llm.Status.Upgrade
 
 	// Patch only the status subresource for the updated boolean flags to avoid
 	// recalculating or setting the overall Ready condition here. Phase 7 (in Reconcile)
@@ -589,23 +807,346 @@ func renderProxyConfig(llm *litellmv1alpha1.LiteLLMInstance, ctx context.Context
 		}
 	}
 
-	var routerSettings RouterSettingsYAML
-	if llm.Spec.RedisSecretRef.NameRef != "" {
-		routerSettings = RouterSettingsYAML{
-			Host:     llm.Spec.RedisSecretRef.Keys.HostSecret,
-			Port:     llm.Spec.RedisSecretRef.Keys.PortSecret,
-			Password: llm.Spec.RedisSecretRef.Keys.PasswordSecret,
+	routerSettings, err := buildRedisRouterSettings(&llm.Spec.RedisSecretRef)
+	if err != nil {
+		log.Error(err, "Failed to render redis router settings")
+		return "", err
+	}
+
+	applyRouterSettings(&routerSettings, llm.Spec.RouterSettings)
+
+	policies, err := common.RoutingPoliciesForInstance(ctx, k8sClient, llm)
+	if err != nil {
+		log.Error(err, "Failed to list routing policies")
+		return "", err
+	}
+	conflicts := common.RoutingPolicyConflicts(policies)
+	for i := range policies {
+		policy := &policies[i]
+		if conflict, ok := conflicts[policy.Name]; ok {
+			// The policy reports the conflict in its own status
+			log.Info("Skipping routing policy conflicting with an earlier policy", "routingPolicy", client.ObjectKeyFromObject(policy),
+				"setting", conflict.Setting, "conflictsWith", conflict.Policy)
+			continue
+		}
+		modelNames, err := common.RoutingPolicyModelNames(ctx, k8sClient, policy)
+		if err != nil {
+			// The policy reports the missing models in its own status
+			log.Info("Skipping routing policy with unresolved models", "routingPolicy", client.ObjectKeyFromObject(policy), "error", err.Error())
+			continue
 		}
+		applyRoutingPolicy(&routerSettings, policy, modelNames)
+	}
+
+	litellmSettings, err := buildLitellmSettings(llm.Spec.LitellmSettings)
+	if err != nil {
+		log.Error(err, "Failed to render litellm settings")
+		return "", err
+	}
+	applyIntegrations(&litellmSettings, llm.Spec.Integrations)
+
+	embeddingModel, err := resolveCacheEmbeddingModel(ctx, k8sClient, llm)
+	if err != nil {
+		log.Error(err, "Failed to resolve cache embedding model")
+		return "", err
+	}
+	if err := applyCache(&litellmSettings, llm.Spec.Cache, &llm.Spec.RedisSecretRef, embeddingModel); err != nil {
+		log.Error(err, "Failed to render cache settings")
+		return "", err
 	}
 
-	cfg := ProxyConfig{ModelList: modelListYAML, RouterSettings: routerSettings}
-	cfg.GeneralSettings.AllowRequestsOnDBUnavailable = true
-	cfg.GeneralSettings.StoreModelInDB = true
+	cfg := ProxyConfig{
+		ModelList:       modelListYAML,
+		LitellmSettings: litellmSettings,
+		RouterSettings:  routerSettings,
+		GeneralSettings: buildGeneralSettings(llm.Spec.GeneralSettings),
+	}
 
 	b, _ := yaml.Marshal(cfg)
+	if llm.Spec.ConfigOverrides == "" {
+		return string(b), nil
+	}
+
+	merged, err := mergeConfigOverrides(b, llm.Spec.ConfigOverrides)
+	if err != nil {
+		log.Error(err, "Failed to merge config overrides")
+		return "", err
+	}
+	return merged, nil
+}
+
+// buildGeneralSettings renders the general_settings section. Requests are allowed while the database
+// is unavailable and models are stored in the database unless the instance turns them off.
+func buildGeneralSettings(settings *litellmv1alpha1.GeneralSettings) GeneralSettingsYAML {
+	general := GeneralSettingsYAML{
+		AllowRequestsOnDBUnavailable: true,
+		StoreModelInDB:               true,
+	}
+	if settings == nil {
+		return general
+	}
+
+	if settings.AllowRequestsOnDBUnavailable != nil {
+		general.AllowRequestsOnDBUnavailable = *settings.AllowRequestsOnDBUnavailable
+	}
+	if settings.StoreModelInDB != nil {
+		general.StoreModelInDB = *settings.StoreModelInDB
+	}
+	general.MaxParallelRequests = util.DerefInt(settings.MaxParallelRequests)
+	general.GlobalMaxParallelRequests = util.DerefInt(settings.GlobalMaxParallelRequests)
+	general.Alerting = settings.Alerting
+	general.AlertingThreshold = util.DerefInt(settings.AlertingThreshold)
+	general.AlertTypes = settings.AlertTypes
+	general.BackgroundHealthChecks = util.DerefBool(settings.BackgroundHealthChecks)
+	general.HealthCheckInterval = util.DerefInt(settings.HealthCheckInterval)
+	general.DisableSpendLogs = util.DerefBool(settings.DisableSpendLogs)
+	general.MaxRequestSizeMB = util.DerefInt(settings.MaxRequestSizeMB)
+	general.MaxResponseSizeMB = util.DerefInt(settings.MaxResponseSizeMB)
+	general.DatabaseConnectionPoolLimit = util.DerefInt(settings.DatabaseConnectionPoolLimit)
+	general.ProxyBatchWriteAt = util.DerefInt(settings.ProxyBatchWriteAt)
+
+	return general
+}
+
+// buildLitellmSettings renders the litellm_settings section.
+func buildLitellmSettings(settings *litellmv1alpha1.LitellmSettings) (LitellmSettingsYAML, error) {
+	var litellmSettings LitellmSettingsYAML
+	if settings == nil {
+		return litellmSettings, nil
+	}
+
+	litellmSettings.Callbacks = settings.Callbacks
+	litellmSettings.SuccessCallback = settings.SuccessCallback
+	litellmSettings.FailureCallback = settings.FailureCallback
+	litellmSettings.ServiceCallback = settings.ServiceCallback
+	litellmSettings.DropParams = util.DerefBool(settings.DropParams)
+	litellmSettings.RequestTimeout = util.DerefInt(settings.RequestTimeout)
+	litellmSettings.NumRetries = settings.NumRetries
+	litellmSettings.SetVerbose = util.DerefBool(settings.SetVerbose)
+	litellmSettings.JSONLogs = util.DerefBool(settings.JSONLogs)
+	litellmSettings.Cache = util.DerefBool(settings.Cache)
+
+	if settings.CacheParams != nil && len(settings.CacheParams.Raw) > 0 {
+		if err := json.Unmarshal(settings.CacheParams.Raw, &litellmSettings.CacheParams); err != nil {
+			return litellmSettings, fmt.Errorf("invalid litellmSettings.cacheParams: %w", err)
+		}
+	}
+
+	return litellmSettings, nil
+}
+
+// applyRouterSettings renders the routing settings over the router_settings section, next to the Redis connection.
+func applyRouterSettings(routerSettings *RouterSettingsYAML, settings *litellmv1alpha1.RouterSettings) {
+	if settings == nil {
+		return
+	}
+
+	routerSettings.RoutingStrategy = settings.RoutingStrategy
+	routerSettings.NumRetries = settings.NumRetries
+	routerSettings.Timeout = util.DerefInt(settings.Timeout)
+	routerSettings.AllowedFails = settings.AllowedFails
+	routerSettings.CooldownTime = settings.CooldownTime
+	routerSettings.RetryAfter = util.DerefInt(settings.RetryAfter)
+	routerSettings.Fallbacks = buildFallbacks(settings.Fallbacks)
+	routerSettings.ContextWindowFallbacks = buildFallbacks(settings.ContextWindowFallbacks)
+	routerSettings.ContentPolicyFallbacks = buildFallbacks(settings.ContentPolicyFallbacks)
+	routerSettings.DefaultFallbacks = settings.DefaultFallbacks
+	routerSettings.EnablePreCallChecks = util.DerefBool(settings.EnablePreCallChecks)
+	routerSettings.ModelGroupAlias = settings.ModelGroupAlias
+}
+
+// buildFallbacks converts the fallbacks into the list of single key maps expected by LiteLLM.
+func buildFallbacks(fallbacks []litellmv1alpha1.ModelFallback) []map[string][]string {
+	if len(fallbacks) == 0 {
+		return nil
+	}
+	result := make([]map[string][]string, 0, len(fallbacks))
+	for _, f := range fallbacks {
+		result = append(result, map[string][]string{f.Model: f.Fallbacks})
+	}
+	return result
+}
+
+// applyRoutingPolicy renders a RoutingPolicy over the router_settings section. Its fallbacks are added after
+// the fallbacks already rendered and its other settings replace those of the instance; policies setting the
+// same settings are left out by RoutingPolicyConflicts. modelNames maps the
+// Model resources referenced by the policy to their model names.
+func applyRoutingPolicy(routerSettings *RouterSettingsYAML, policy *litellmv1alpha1.RoutingPolicy, modelNames map[string]string) {
+	resolve := func(refs []string) []string {
+		names := make([]string, 0, len(refs))
+		for _, ref := range refs {
+			names = append(names, modelNames[ref])
+		}
+		return names
+	}
+	fallbacks := func(fallbacks []litellmv1alpha1.RoutingFallback) []map[string][]string {
+		result := make([]map[string][]string, 0, len(fallbacks))
+		for _, f := range fallbacks {
+			result = append(result, map[string][]string{modelNames[f.ModelRef]: resolve(f.FallbackRefs)})
+		}
+		return result
+	}
+
+	spec := policy.Spec
+	routerSettings.Fallbacks = append(routerSettings.Fallbacks, fallbacks(spec.Fallbacks)...)
+	routerSettings.ContextWindowFallbacks = append(routerSettings.ContextWindowFallbacks, fallbacks(spec.ContextWindowFallbacks)...)
+	routerSettings.ContentPolicyFallbacks = append(routerSettings.ContentPolicyFallbacks, fallbacks(spec.ContentPolicyFallbacks)...)
+	routerSettings.DefaultFallbacks = append(routerSettings.DefaultFallbacks, resolve(spec.DefaultFallbackRefs)...)
+	if spec.NumRetries != nil {
+		routerSettings.NumRetries = spec.NumRetries
+	}
+	if spec.AllowedFails != nil {
+		routerSettings.AllowedFails = spec.AllowedFails
+	}
+	if spec.CooldownTime != nil {
+		routerSettings.CooldownTime = spec.CooldownTime
+	}
+	if retryPolicy := spec.RetryPolicy; retryPolicy != nil {
+		if routerSettings.RetryPolicy == nil {
+			routerSettings.RetryPolicy = map[string]int{}
+		}
+		for name, retries := range map[string]*int{
+			"BadRequestErrorRetries":             retryPolicy.BadRequestErrorRetries,
+			"AuthenticationErrorRetries":         retryPolicy.AuthenticationErrorRetries,
+			"TimeoutErrorRetries":                retryPolicy.TimeoutErrorRetries,
+			"RateLimitErrorRetries":              retryPolicy.RateLimitErrorRetries,
+			"ContentPolicyViolationErrorRetries": retryPolicy.ContentPolicyViolationErrorRetries,
+			"InternalServerErrorRetries":         retryPolicy.InternalServerErrorRetries,
+		} {
+			if retries != nil {
+				routerSettings.RetryPolicy[name] = *retries
+			}
+		}
+	}
+}
+
+// mergeConfigOverrides deep-merges a YAML document over the rendered proxy config.
+func mergeConfigOverrides(rendered []byte, overrides string) (string, error) {
+	base := map[interface{}]interface{}{}
+	if err := yaml.Unmarshal(rendered, &base); err != nil {
+		return "", fmt.Errorf("failed to parse rendered config: %w", err)
+	}
+
+	override := map[interface{}]interface{}{}
+	if err := yaml.Unmarshal([]byte(overrides), &override); err != nil {
+		return "", fmt.Errorf("invalid configOverrides: %w", err)
+	}
+
+	b, err := yaml.Marshal(deepMerge(base, override))
+	if err != nil {
+		return "", err
+	}
 	return string(b), nil
 }
 
+// deepMerge merges the override map into the base map. Nested maps are merged recursively and
+// any other override value replaces the base value.
+func deepMerge(base, override map[interface{}]interface{}) map[interface{}]interface{} {
+	for key, value := range override {
+		overrideMap, ok := value.(map[interface{}]interface{})
+		if !ok {
+			base[key] = value
+			continue
+		}
+		if baseMap, ok := base[key].(map[interface{}]interface{}); ok {
+			base[key] = deepMerge(baseMap, overrideMap)
+			continue
+		}
+		base[key] = overrideMap
+	}
+	return base
+}
+
+// buildRedisRouterSettings renders the router settings that point LiteLLM at Redis.
+// Connection details are referenced through the REDIS_* environment variables injected by
+// buildRedisEnvironmentVariables, so no secret values end up in the ConfigMap.
+func buildRedisRouterSettings(redis *litellmv1alpha1.RedisSecretRef) (RouterSettingsYAML, error) {
+	var routerSettings RouterSettingsYAML
+	if redis.NameRef == "" {
+		return routerSettings, nil
+	}
+
+	routerSettings.RedisHost = envReference(RedisHostEnv)
+	routerSettings.RedisPort = envReference(RedisPortEnv)
+	if redis.Keys.PasswordSecret != "" {
+		routerSettings.RedisPassword = envReference(RedisPasswordEnv)
+	}
+
+	switch redis.Mode {
+	case RedisModeCluster:
+		nodes, err := redisClusterNodes(redis)
+		if err != nil {
+			return routerSettings, err
+		}
+		if len(nodes) == 0 {
+			return routerSettings, fmt.Errorf("redis cluster nodes are required when mode is %s", RedisModeCluster)
+		}
+		startupNodes := make([]interface{}, 0, len(nodes))
+		for _, n := range nodes {
+			startupNodes = append(startupNodes, map[string]interface{}{"host": n.host, "port": n.port})
+		}
+		routerSettings.CacheKwargs = map[string]interface{}{"startup_nodes": startupNodes}
+	case RedisModeSentinel:
+		if redis.Sentinel == nil {
+			return routerSettings, fmt.Errorf("redis sentinel configuration is required when mode is %s", RedisModeSentinel)
+		}
+		sentinelNodes := make([]interface{}, 0, len(redis.Sentinel.Nodes))
+		for _, node := range redis.Sentinel.Nodes {
+			n, err := parseRedisNode(node)
+			if err != nil {
+				return routerSettings, err
+			}
+			sentinelNodes = append(sentinelNodes, []interface{}{n.host, n.port})
+		}
+		routerSettings.CacheKwargs = map[string]interface{}{
+			"sentinel_nodes": sentinelNodes,
+			"service_name":   redis.Sentinel.ServiceName,
+		}
+		if redis.Sentinel.PasswordSecret != "" {
+			routerSettings.CacheKwargs["sentinel_password"] = envReference(RedisSentinelPasswordEnv)
+		}
+	}
+
+	return routerSettings, nil
+}
+
+// redisNode is a parsed host:port Redis node address.
+type redisNode struct {
+	host string
+	port int
+}
+
+// parseRedisNode parses a host:port Redis node address.
+func parseRedisNode(node string) (redisNode, error) {
+	host, portStr, err := net.SplitHostPort(node)
+	if err != nil {
+		return redisNode{}, fmt.Errorf("invalid redis node %q, expected host:port: %w", node, err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return redisNode{}, fmt.Errorf("invalid port in redis node %q: %w", node, err)
+	}
+	return redisNode{host: host, port: port}, nil
+}
+
+// redisClusterNodes parses the configured Redis Cluster startup nodes.
+func redisClusterNodes(redis *litellmv1alpha1.RedisSecretRef) ([]redisNode, error) {
+	nodes := make([]redisNode, 0, len(redis.ClusterNodes))
+	for _, node := range redis.ClusterNodes {
+		n, err := parseRedisNode(node)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
+
+// envReference returns the LiteLLM config reference to an environment variable.
+func envReference(name string) string {
+	return "os.environ/" + name
+}
+
 // buildSecretData builds the secret data map for the LiteLLM instance.
 // It handles the master key, either using the provided key or preserving an existing one.
 func buildSecretData(masterKey string, existingSecret *corev1.Secret) map[string][]byte {
@@ -675,6 +1216,22 @@ func (r *LiteLLMInstanceReconciler) childResourcesAreReady(llm *litellmv1alpha1.
 		return false
 	}
 
+	if autoscalingEnabled(llm) && !conditionIsTrue(llm, CondTypeHPAReady) {
+		return false
+	}
+
+	if podDisruptionBudgetEnabled(llm) && !conditionIsTrue(llm, CondTypePDBReady) {
+		return false
+	}
+
+	if managedDatabaseEnabled(llm) && !conditionIsTrue(llm, CondTypeDatabaseReady) {
+		return false
+	}
+
+	if migrationsEnabled(llm) && !conditionIsTrue(llm, CondTypeMigrations) {
+		return false
+	}
+
 	return true
 }
 
@@ -806,9 +1363,90 @@ func (r *LiteLLMInstanceReconciler) updateConditions(ctx context.Context, llm *l
 			ingressReady.Status = metav1.ConditionTrue
 			ingressReady.Reason = "IngressReady"
 			ingressReady.Message = "Ingress has been created"
+
+			// Report the load balancer address once the ingress controller has assigned one
+			ingress := &networkingv1.Ingress{}
+			err := r.Client.Get(ctx, client.ObjectKey{Name: r.litellmResourceNaming.GetIngressName(), Namespace: llm.Namespace}, ingress)
+			if err == nil {
+				llm.Status.IngressAddress = ingressAddress(ingress)
+			}
+			if llm.Status.IngressAddress != "" {
+				ingressReady.Message = fmt.Sprintf("Ingress has been created with address: %s", llm.Status.IngressAddress)
+			}
 		}
 
 		r.setCondition(llm, ingressReady)
+	} else {
+		llm.Status.IngressAddress = ""
+		meta.RemoveStatusCondition(&llm.Status.Conditions, CondTypeIngressReady)
+	}
+
+	// Managed database ready condition (only if the managed database is enabled)
+	if managedDatabaseEnabled(llm) {
+		r.setCondition(llm, r.databaseReadyCondition(ctx, llm))
+	} else {
+		meta.RemoveStatusCondition(&llm.Status.Conditions, CondTypeDatabaseReady)
+	}
+
+	// Migrations applied condition (only if migrations are enabled)
+	if migrationsEnabled(llm) {
+		r.setCondition(llm, r.migrationsCondition(ctx, llm))
+	} else {
+		meta.RemoveStatusCondition(&llm.Status.Conditions, CondTypeMigrations)
+	}
+
+	// Upgrading condition (only for canary and blue/green upgrades)
+	if progressiveUpgradeEnabled(llm) && llm.Status.Upgrade != nil {
+		r.setCondition(llm, r.upgradeCondition(llm))
+	} else {
+		meta.RemoveStatusCondition(&llm.Status.Conditions, CondTypeUpgrading)
+	}
+
+	// HTTPRoute accepted condition (only if the gateway is enabled)
+	if llm.Spec.Gateway.Enabled {
+		r.setCondition(llm, r.httpRouteAcceptedCondition(ctx, llm))
+	} else {
+		meta.RemoveStatusCondition(&llm.Status.Conditions, CondTypeHTTPRouteReady)
+	}
+
+	// HorizontalPodAutoscaler ready condition (only if autoscaling is enabled)
+	if autoscalingEnabled(llm) {
+		hpaReady := r.createCondition(
+			CondTypeHPAReady,
+			llm.Generation,
+			ReasonHPANotReady,
+			"HorizontalPodAutoscaler is not ready",
+		)
+
+		if llm.Status.HPACreated {
+			hpaReady.Status = metav1.ConditionTrue
+			hpaReady.Reason = ReasonHPAReady
+			hpaReady.Message = "HorizontalPodAutoscaler has been created"
+		}
+
+		r.setCondition(llm, hpaReady)
+	} else {
+		meta.RemoveStatusCondition(&llm.Status.Conditions, CondTypeHPAReady)
+	}
+
+	// PodDisruptionBudget ready condition (only if the PDB is enabled)
+	if podDisruptionBudgetEnabled(llm) {
+		pdbReady := r.createCondition(
+			CondTypePDBReady,
+			llm.Generation,
+			ReasonPDBNotReady,
+			"PodDisruptionBudget is not ready",
+		)
+
+		if llm.Status.PDBCreated {
+			pdbReady.Status = metav1.ConditionTrue
+			pdbReady.Reason = ReasonPDBReady
+			pdbReady.Message = "PodDisruptionBudget has been created"
+		}
+
+		r.setCondition(llm, pdbReady)
+	} else {
+		meta.RemoveStatusCondition(&llm.Status.Conditions, CondTypePDBReady)
 	}
 
 	// Overall Ready condition
@@ -908,6 +1546,48 @@ func (r *LiteLLMInstanceReconciler) updateResourceMetrics(llm *litellmv1alpha1.L
 		setMetrics("ingress", llm.Status.IngressCreated, ingStatus)
 	}
 
+	// Managed database (if enabled)
+	if managedDatabaseEnabled(llm) {
+		dbActive := conditionIsTrue(llm, CondTypeDatabaseReady)
+		dbStatus := StatusNotReady
+		if dbActive {
+			dbStatus = StatusReady
+		} else if llm.Status.DatabaseCreated {
+			dbStatus = StatusCreated
+		}
+		setMetrics("database", llm.Status.DatabaseCreated, dbStatus)
+	}
+
+	// HTTPRoute (if the gateway is enabled)
+	if llm.Spec.Gateway.Enabled {
+		routeActive := conditionIsTrue(llm, CondTypeHTTPRouteReady)
+		routeStatus := StatusNotReady
+		if routeActive {
+			routeStatus = StatusReady
+		} else if llm.Status.HTTPRouteCreated {
+			routeStatus = StatusCreated
+		}
+		setMetrics("httproute", llm.Status.HTTPRouteCreated, routeStatus)
+	}
+
+	// HorizontalPodAutoscaler (if enabled)
+	if autoscalingEnabled(llm) {
+		hpaStatus := StatusMissing
+		if llm.Status.HPACreated {
+			hpaStatus = StatusCreated
+		}
+		setMetrics("hpa", llm.Status.HPACreated, hpaStatus)
+	}
+
+	// PodDisruptionBudget (if enabled)
+	if podDisruptionBudgetEnabled(llm) {
+		pdbStatus := StatusMissing
+		if llm.Status.PDBCreated {
+			pdbStatus = StatusCreated
+		}
+		setMetrics("pdb", llm.Status.PDBCreated, pdbStatus)
+	}
+
 	// Overall instance readiness
 	readyActive := conditionIsTrue(llm, "Ready")
 	readyStatus := StatusNotReady
@@ -945,30 +1625,82 @@ func (r *LiteLLMInstanceReconciler) createConfigMap(ctx context.Context, llm *li
 		Data: map[string]string{"proxy_server_config.yaml": configYAML},
 	}
 
-	_, restart, err := r.createOrUpdateResource(ctx, llm, configMap, "ConfigMap")
-	if err != nil {
+	// Config changes roll the Deployment through the checksum annotation on its pod template
+	if _, _, err := r.createOrUpdateResource(ctx, llm, configMap, "ConfigMap"); err != nil {
 		return nil, err
 	}
 
-	// Check if we need to restart the deployment due to config changes
-	if restart {
-		// Get the deployment
-		deployment := &appsv1.Deployment{}
-		err := r.Get(ctx, client.ObjectKey{Name: r.litellmResourceNaming.GetDeploymentName(), Namespace: llm.Namespace}, deployment)
-		if err != nil {
-			return nil, err
+	// set ConfigMapCreated status
+	llm.Status.ConfigMapCreated = true
+
+	return configMap, nil
+}
+
+// configChecksum computes the checksum of the rendered config, the master key secret and the secrets
+// referenced by the instance. Secrets that do not exist yet are left out until they are created.
+func (r *LiteLLMInstanceReconciler) configChecksum(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance, configMap *corev1.ConfigMap, masterKeySecret *corev1.Secret) (string, error) {
+	secrets := []*corev1.Secret{masterKeySecret}
+	for _, name := range referencedSecretNames(llm) {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: llm.Namespace}, secret); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return "", err
 		}
-		log.Info("Restarting deployment", "deployment", deployment.Name)
-		if err := util.RestartDeployment(ctx, r.Client, deployment.Name, deployment.Namespace); err != nil {
-			log.Error(err, "Failed to restart deployment", "deployment", deployment.Name)
-			return nil, err
+		secrets = append(secrets, secret)
+	}
+
+	return computeConfigChecksum(configMap.Data, secrets), nil
+}
+
+// referencedSecretNames returns the sorted names of the secrets consumed by the LiteLLM pods: the model
+// credentials, the database and Redis secrets, the logging integration and cache secrets, and the secrets
+// referenced by the extra environment variables.
+func referencedSecretNames(llm *litellmv1alpha1.LiteLLMInstance) []string {
+	names := map[string]bool{}
+	for _, model := range llm.Spec.Models {
+		if model.RequiresAuth && model.ModelCredentials.NameRef != "" {
+			names[model.ModelCredentials.NameRef] = true
+		}
+	}
+	if name := effectiveDatabaseSecretRef(llm).NameRef; name != "" {
+		names[name] = true
+	}
+	if llm.Spec.RedisSecretRef.NameRef != "" {
+		names[llm.Spec.RedisSecretRef.NameRef] = true
+	}
+	for _, name := range integrationSecretNames(llm.Spec.Integrations) {
+		names[name] = true
+	}
+	for _, name := range cacheSecretNames(llm.Spec.Cache) {
+		names[name] = true
+	}
+	for _, env := range llm.Spec.ExtraEnvVars {
+		if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name != "" {
+			names[env.ValueFrom.SecretKeyRef.Name] = true
 		}
 	}
 
-	// set ConfigMapCreated status
-	llm.Status.ConfigMapCreated = true
+	return slices.Sorted(maps.Keys(names))
+}
 
-	return configMap, nil
+// computeConfigChecksum hashes the config data and the secrets in a stable order.
+func computeConfigChecksum(configData map[string]string, secrets []*corev1.Secret) string {
+	hash := sha256.New()
+	for _, key := range slices.Sorted(maps.Keys(configData)) {
+		fmt.Fprintf(hash, "config/%s=%s\n", key, configData[key])
+	}
+
+	sorted := slices.Clone(secrets)
+	slices.SortFunc(sorted, func(a, b *corev1.Secret) int { return strings.Compare(a.Name, b.Name) })
+	for _, secret := range sorted {
+		for _, key := range slices.Sorted(maps.Keys(secret.Data)) {
+			fmt.Fprintf(hash, "secret/%s/%s=%x\n", secret.Name, key, secret.Data[key])
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
 }
 
 // sanitizes a key to a string that conforms with Kubernetes secret naming conventions
@@ -1075,23 +1807,59 @@ func createSecret(ctx context.Context, k8sClient client.Client, scheme *runtime.
 
 // createDeployment creates or updates the Deployment for the LiteLLM instance.
 // It creates a Deployment that runs the LiteLLM proxy container with appropriate configuration.
-func (r *LiteLLMInstanceReconciler) createDeployment(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) (*appsv1.Deployment, error) {
+func (r *LiteLLMInstanceReconciler) createDeployment(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance, checksum string) (*appsv1.Deployment, error) {
 	log := logf.FromContext(ctx)
 
+	// Keep the previous image until the migrations of the new image have been applied
+	image := deploymentImage(llm)
+	if image == "" {
+		log.Info("Waiting for database migrations before creating the deployment", "image", llm.Spec.Image)
+		return nil, nil
+	}
+
+	deployment := r.buildDeployment(ctx, llm, r.litellmResourceNaming.GetDeploymentName(), r.litellmResourceNaming.GetAppLabels(), image, checksum)
+	deployment.Spec.Replicas = util.Int32Ptr(llm.Spec.Replicas)
+
+	// Leave the replica count to the HorizontalPodAutoscaler while autoscaling is enabled
+	if autoscalingEnabled(llm) {
+		deployment.Spec.Replicas = nil
+	}
+	log.V(1).Info("Creating or updating deployment", "deployment", deployment.Name)
+
+	resource, _, err := r.createOrUpdateResource(ctx, llm, deployment, "Deployment")
+	if err != nil {
+		return nil, err
+	}
+
+	// set deployment status
+	llm.Status.DeploymentCreated = true
+	if llm.Status.CurrentImage != image {
+		if llm.Status.CurrentImage != "" {
+			llm.Status.PreviousImage = llm.Status.CurrentImage
+		}
+		llm.Status.CurrentImage = image
+	}
+
+	return resource.(*appsv1.Deployment), nil
+}
+
+// buildDeployment builds a Deployment running the LiteLLM proxy with the given image. The selector and
+// pod labels are set to the given labels, and the replica count is left to the caller. The config checksum
+// is stamped on the pod template so that the pods are rolled whenever the config or a referenced secret changes.
+func (r *LiteLLMInstanceReconciler) buildDeployment(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance, name string, labels map[string]string, image, checksum string) *appsv1.Deployment {
 	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      r.litellmResourceNaming.GetDeploymentName(),
+			Name:      name,
 			Namespace: llm.Namespace,
-			Labels:    r.litellmResourceNaming.GetAppLabels(),
+			Labels:    maps.Clone(labels),
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: util.Int32Ptr(llm.Spec.Replicas),
 			Selector: &metav1.LabelSelector{
-				MatchLabels: r.litellmResourceNaming.GetAppLabels(),
+				MatchLabels: maps.Clone(labels),
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: r.litellmResourceNaming.GetAppLabels(),
+					Labels: maps.Clone(labels),
 				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName: r.litellmResourceNaming.GetServiceAccountName(),
@@ -1114,17 +1882,110 @@ func (r *LiteLLMInstanceReconciler) createDeployment(ctx context.Context, llm *l
 			},
 		},
 	}
-	log.V(1).Info("Creating or updating deployment", "deployment", deployment.Name)
-
-	resource, _, err := r.createOrUpdateResource(ctx, llm, deployment, "Deployment")
-	if err != nil {
-		return nil, err
+	deployment.Spec.Template.Spec.Containers[0].Image = image
+	integrationVolumes, integrationMounts := buildIntegrationVolumes(llm.Spec.Integrations)
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, integrationVolumes...)
+	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, integrationMounts...)
+	if migrationsEnabled(llm) {
+		deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env,
+			corev1.EnvVar{Name: DisableSchemaUpdateEnv, Value: "True"})
 	}
 
-	// set deployment status
-	llm.Status.DeploymentCreated = true
+	applyPodTemplateOverrides(&deployment.Spec.Template, llm.Spec.PodTemplate, labels)
 
-	return resource.(*appsv1.Deployment), nil
+	if deployment.Spec.Template.Annotations == nil {
+		deployment.Spec.Template.Annotations = map[string]string{}
+	}
+	deployment.Spec.Template.Annotations[ConfigChecksumAnnotation] = checksum
+
+	return deployment
+}
+
+// applyPodTemplateOverrides merges the user supplied pod template overrides over the generated pod template.
+// Maps are merged with the selector labels taking precedence, scheduling and security fields replace the
+// defaults, and sidecars and volumes are appended unless their names clash with the operator managed ones.
+func applyPodTemplateOverrides(template *corev1.PodTemplateSpec, overrides *litellmv1alpha1.PodTemplate, selectorLabels map[string]string) {
+	if overrides == nil {
+		return
+	}
+
+	if len(overrides.Annotations) > 0 {
+		if template.Annotations == nil {
+			template.Annotations = make(map[string]string, len(overrides.Annotations))
+		}
+		for k, v := range overrides.Annotations {
+			template.Annotations[k] = v
+		}
+	}
+
+	if len(overrides.Labels) > 0 {
+		if template.Labels == nil {
+			template.Labels = make(map[string]string, len(overrides.Labels))
+		}
+		for k, v := range overrides.Labels {
+			if _, reserved := selectorLabels[k]; reserved {
+				continue
+			}
+			template.Labels[k] = v
+		}
+	}
+
+	podSpec := &template.Spec
+	if len(overrides.NodeSelector) > 0 {
+		podSpec.NodeSelector = overrides.NodeSelector
+	}
+	if len(overrides.Tolerations) > 0 {
+		podSpec.Tolerations = overrides.Tolerations
+	}
+	if overrides.Affinity != nil {
+		podSpec.Affinity = overrides.Affinity
+	}
+	if len(overrides.TopologySpreadConstraints) > 0 {
+		podSpec.TopologySpreadConstraints = overrides.TopologySpreadConstraints
+	}
+	if overrides.PriorityClassName != "" {
+		podSpec.PriorityClassName = overrides.PriorityClassName
+	}
+	if overrides.SecurityContext != nil {
+		podSpec.SecurityContext = overrides.SecurityContext
+	}
+	if len(overrides.ImagePullSecrets) > 0 {
+		podSpec.ImagePullSecrets = overrides.ImagePullSecrets
+	}
+
+	// The LiteLLM container is always the first container in the pod
+	if len(podSpec.Containers) > 0 {
+		if overrides.Resources != nil {
+			podSpec.Containers[0].Resources = *overrides.Resources
+		}
+		if overrides.ContainerSecurityContext != nil {
+			podSpec.Containers[0].SecurityContext = overrides.ContainerSecurityContext
+		}
+	}
+
+	containerNames := make(map[string]bool, len(podSpec.Containers))
+	for _, c := range podSpec.Containers {
+		containerNames[c.Name] = true
+	}
+	for _, sidecar := range overrides.Sidecars {
+		if containerNames[sidecar.Name] {
+			continue
+		}
+		containerNames[sidecar.Name] = true
+		podSpec.Containers = append(podSpec.Containers, sidecar)
+	}
+
+	volumeNames := make(map[string]bool, len(podSpec.Volumes))
+	for _, v := range podSpec.Volumes {
+		volumeNames[v.Name] = true
+	}
+	for _, volume := range overrides.Volumes {
+		if volumeNames[volume.Name] {
+			continue
+		}
+		volumeNames[volume.Name] = true
+		podSpec.Volumes = append(podSpec.Volumes, volume)
+	}
 }
 
 // createService creates or updates the Service for the LiteLLM instance.
@@ -1132,12 +1993,1329 @@ func (r *LiteLLMInstanceReconciler) createDeployment(ctx context.Context, llm *l
 func (r *LiteLLMInstanceReconciler) createService(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) (*corev1.Service, error) {
 	service := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      r.litellmResourceNaming.GetServiceName(),
+			Name:      r.litellmResourceNaming.GetServiceName(),
+			Namespace: llm.Namespace,
+			Labels:    r.litellmResourceNaming.GetAppLabels(),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: serviceSelector(llm, r.litellmResourceNaming),
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       ServicePort,
+					TargetPort: intstr.FromInt(ContainerPort),
+				},
+			},
+			Type: corev1.ServiceTypeClusterIP,
+		},
+	}
+
+	resource, _, err := r.createOrUpdateResource(ctx, llm, service, "Service")
+	if err != nil {
+		return nil, err
+	}
+
+	llm.Status.ServiceCreated = true
+
+	return resource.(*corev1.Service), nil
+}
+
+// createServiceAccount creates or updates the ServiceAccount for the LiteLLM instance.
+// It creates a ServiceAccount that the Deployment will use.
+func (r *LiteLLMInstanceReconciler) createServiceAccount(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) (*corev1.ServiceAccount, error) {
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.litellmResourceNaming.GetServiceAccountName(),
+			Namespace: llm.Namespace,
+			Labels:    r.litellmResourceNaming.GetAppLabels(),
+		},
+	}
+
+	resource, _, err := r.createOrUpdateResource(ctx, llm, serviceAccount, "ServiceAccount")
+	if err != nil {
+		return nil, err
+	}
+
+	return resource.(*corev1.ServiceAccount), nil
+}
+
+// createRBAC creates or updates the Role and RoleBinding for the LiteLLM instance ServiceAccount.
+// It creates a Role with minimal permissions and a RoleBinding to bind it to the ServiceAccount.
+func (r *LiteLLMInstanceReconciler) createRBAC(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) error {
+	// Create Role with minimal permissions for the LiteLLM instance
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.litellmResourceNaming.GetRoleName(),
+			Namespace: llm.Namespace,
+			Labels:    r.litellmResourceNaming.GetAppLabels(),
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"configmaps", "secrets"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+		},
+	}
+
+	_, _, err := r.createOrUpdateResource(ctx, llm, role, "Role")
+	if err != nil {
+		return err
+	}
+
+	// Create RoleBinding to bind the Role to the ServiceAccount
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.litellmResourceNaming.GetRoleBindingName(),
+			Namespace: llm.Namespace,
+			Labels:    r.litellmResourceNaming.GetAppLabels(),
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      r.litellmResourceNaming.GetServiceAccountName(),
+				Namespace: llm.Namespace,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "Role",
+			Name:     role.Name,
+		},
+	}
+
+	_, _, err = r.createOrUpdateResource(ctx, llm, roleBinding, "RoleBinding")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// createIngress creates or updates the Ingress for the LiteLLM instance.
+// It creates an Ingress resource to expose the LiteLLM proxy externally when enabled,
+// and removes a previously created Ingress when it is disabled.
+func (r *LiteLLMInstanceReconciler) createIngress(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) error {
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.litellmResourceNaming.GetIngressName(),
+			Namespace: llm.Namespace,
+			Labels:    r.litellmResourceNaming.GetAppLabels(),
+		},
+	}
+
+	if !llm.Spec.Ingress.Enabled {
+		llm.Status.IngressCreated = false
+		llm.Status.IngressAddress = ""
+		return r.deleteIfExists(ctx, ingress)
+	}
+
+	ingress.Annotations = buildIngressAnnotations(&llm.Spec.Ingress)
+	ingress.Spec = buildIngressSpec(&llm.Spec.Ingress, r.litellmResourceNaming.GetServiceName(), r.litellmResourceNaming.GetIngressName())
+
+	_, _, err := r.createOrUpdateResource(ctx, llm, ingress, "Ingress")
+	if err != nil {
+		return err
+	}
+
+	llm.Status.IngressCreated = true
+
+	return nil
+}
+
+// buildIngressAnnotations returns the user supplied Ingress annotations together with
+// the cert-manager issuer annotation when a certificate should be requested.
+func buildIngressAnnotations(spec *litellmv1alpha1.Ingress) map[string]string {
+	annotations := make(map[string]string, len(spec.Annotations)+1)
+	for k, v := range spec.Annotations {
+		annotations[k] = v
+	}
+
+	if spec.TLS != nil && spec.TLS.CertManagerIssuer != nil && spec.TLS.CertManagerIssuer.Name != "" {
+		if spec.TLS.CertManagerIssuer.Kind == "Issuer" {
+			annotations[CertManagerIssuerAnnotation] = spec.TLS.CertManagerIssuer.Name
+		} else {
+			annotations[CertManagerClusterIssuerAnnotation] = spec.TLS.CertManagerIssuer.Name
+		}
+	}
+
+	if len(annotations) == 0 {
+		return nil
+	}
+	return annotations
+}
+
+// buildIngressSpec builds an Ingress spec routing the configured host and paths to the LiteLLM service.
+func buildIngressSpec(spec *litellmv1alpha1.Ingress, serviceName, ingressName string) networkingv1.IngressSpec {
+	paths := spec.Paths
+	if len(paths) == 0 {
+		paths = []litellmv1alpha1.IngressPath{{Path: "/", PathType: networkingv1.PathTypePrefix}}
+	}
+
+	httpPaths := make([]networkingv1.HTTPIngressPath, 0, len(paths))
+	for _, p := range paths {
+		pathType := p.PathType
+		if pathType == "" {
+			pathType = networkingv1.PathTypePrefix
+		}
+		path := p.Path
+		if path == "" {
+			path = "/"
+		}
+		httpPaths = append(httpPaths, networkingv1.HTTPIngressPath{
+			Path:     path,
+			PathType: &pathType,
+			Backend: networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{
+					Name: serviceName,
+					Port: networkingv1.ServiceBackendPort{Number: ServicePort},
+				},
+			},
+		})
+	}
+
+	ingressSpec := networkingv1.IngressSpec{
+		Rules: []networkingv1.IngressRule{
+			{
+				Host: spec.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{Paths: httpPaths},
+				},
+			},
+		},
+	}
+
+	if spec.ClassName != "" {
+		className := spec.ClassName
+		ingressSpec.IngressClassName = &className
+	}
+
+	if spec.TLS != nil {
+		secretName := spec.TLS.SecretName
+		if secretName == "" {
+			secretName = ingressName + "-tls"
+		}
+		ingressSpec.TLS = []networkingv1.IngressTLS{
+			{
+				Hosts:      []string{spec.Host},
+				SecretName: secretName,
+			},
+		}
+	}
+
+	return ingressSpec
+}
+
+// ingressAddress returns the first load balancer IP or hostname reported on the Ingress.
+func ingressAddress(ingress *networkingv1.Ingress) string {
+	for _, lb := range ingress.Status.LoadBalancer.Ingress {
+		if lb.IP != "" {
+			return lb.IP
+		}
+		if lb.Hostname != "" {
+			return lb.Hostname
+		}
+	}
+	return ""
+}
+
+// createHTTPRoute creates or updates the Gateway API HTTPRoute for the LiteLLM instance.
+// It attaches the route to the referenced parent Gateway when the gateway is enabled,
+// and removes a previously created route when it is disabled.
+func (r *LiteLLMInstanceReconciler) createHTTPRoute(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) error {
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(httpRouteGVK)
+	route.SetName(r.litellmResourceNaming.GetHTTPRouteName())
+	route.SetNamespace(llm.Namespace)
+	route.SetLabels(r.litellmResourceNaming.GetAppLabels())
+
+	if !llm.Spec.Gateway.Enabled {
+		llm.Status.HTTPRouteCreated = false
+		// Nothing to clean up when the Gateway API CRDs are not installed
+		if err := r.Delete(ctx, route); client.IgnoreNotFound(err) != nil && !meta.IsNoMatchError(err) {
+			return fmt.Errorf("failed to delete %s: %w", route.GetName(), err)
+		}
+		return nil
+	}
+
+	if llm.Spec.Gateway.ParentRef.Name == "" {
+		return fmt.Errorf("gateway.parentRef.name is required when the gateway is enabled")
+	}
+
+	canaryService, weight := canaryBackend(llm, r.litellmResourceNaming)
+	route.Object["spec"] = buildHTTPRouteSpec(&llm.Spec.Gateway, llm.Namespace, r.litellmResourceNaming.GetServiceName(), canaryService, weight)
+
+	if _, _, err := r.createOrUpdateResource(ctx, llm, route, "HTTPRoute"); err != nil {
+		if meta.IsNoMatchError(err) {
+			return fmt.Errorf("the Gateway API HTTPRoute CRD is not installed in the cluster: %w", err)
+		}
+		return err
+	}
+
+	llm.Status.HTTPRouteCreated = true
+
+	return nil
+}
+
+// buildHTTPRouteSpec builds the unstructured HTTPRoute spec routing the configured hostnames and paths to the LiteLLM service.
+// When a canary Service is given, the canary weight of the traffic is sent to it and the rest to the LiteLLM service.
+// Numeric values are int64 so the object can be deep copied as JSON.
+func buildHTTPRouteSpec(gw *litellmv1alpha1.Gateway, namespace, serviceName, canaryServiceName string, canaryWeight int32) map[string]interface{} {
+	parentRef := map[string]interface{}{
+		"group": httpRouteGVK.Group,
+		"kind":  "Gateway",
+		"name":  gw.ParentRef.Name,
+	}
+	parentNamespace := gw.ParentRef.Namespace
+	if parentNamespace == "" {
+		parentNamespace = namespace
+	}
+	parentRef["namespace"] = parentNamespace
+	if gw.ParentRef.SectionName != "" {
+		parentRef["sectionName"] = gw.ParentRef.SectionName
+	}
+
+	var hostnames []interface{}
+	seen := map[string]bool{}
+	for _, h := range append([]string{gw.Host}, gw.Hostnames...) {
+		if h == "" || seen[h] {
+			continue
+		}
+		seen[h] = true
+		hostnames = append(hostnames, h)
+	}
+
+	paths := gw.Paths
+	if len(paths) == 0 {
+		paths = []litellmv1alpha1.GatewayPathMatch{{Type: "PathPrefix", Value: "/"}}
+	}
+	matches := make([]interface{}, 0, len(paths))
+	for _, p := range paths {
+		matchType := p.Type
+		if matchType == "" {
+			matchType = "PathPrefix"
+		}
+		value := p.Value
+		if value == "" {
+			value = "/"
+		}
+		matches = append(matches, map[string]interface{}{
+			"path": map[string]interface{}{"type": matchType, "value": value},
+		})
+	}
+
+	backendRef := map[string]interface{}{
+		"kind": "Service",
+		"name": serviceName,
+		"port": int64(ServicePort),
+	}
+	backendRefs := []interface{}{backendRef}
+	if canaryServiceName != "" {
+		backendRef["weight"] = int64(100 - canaryWeight)
+		backendRefs = append(backendRefs, map[string]interface{}{
+			"kind":   "Service",
+			"name":   canaryServiceName,
+			"port":   int64(ServicePort),
+			"weight": int64(canaryWeight),
+		})
+	}
+
+	rule := map[string]interface{}{
+		"matches":     matches,
+		"backendRefs": backendRefs,
+	}
+
+	var filters []interface{}
+	if gw.RequestHeaders != nil {
+		filters = append(filters, map[string]interface{}{
+			"type":                  "RequestHeaderModifier",
+			"requestHeaderModifier": buildHeaderModifier(gw.RequestHeaders),
+		})
+	}
+	if gw.ResponseHeaders != nil {
+		filters = append(filters, map[string]interface{}{
+			"type":                   "ResponseHeaderModifier",
+			"responseHeaderModifier": buildHeaderModifier(gw.ResponseHeaders),
+		})
+	}
+	if len(filters) > 0 {
+		rule["filters"] = filters
+	}
+
+	if gw.Timeouts != nil {
+		timeouts := map[string]interface{}{}
+		if gw.Timeouts.Request != "" {
+			timeouts["request"] = gw.Timeouts.Request
+		}
+		if gw.Timeouts.BackendRequest != "" {
+			timeouts["backendRequest"] = gw.Timeouts.BackendRequest
+		}
+		if len(timeouts) > 0 {
+			rule["timeouts"] = timeouts
+		}
+	}
+
+	spec := map[string]interface{}{
+		"parentRefs": []interface{}{parentRef},
+		"rules":      []interface{}{rule},
+	}
+	if len(hostnames) > 0 {
+		spec["hostnames"] = hostnames
+	}
+
+	return spec
+}
+
+// buildHeaderModifier converts a header modifier into its unstructured HTTPRoute filter form.
+func buildHeaderModifier(modifier *litellmv1alpha1.HTTPHeaderModifier) map[string]interface{} {
+	toList := func(headers []litellmv1alpha1.HTTPHeader) []interface{} {
+		list := make([]interface{}, 0, len(headers))
+		for _, h := range headers {
+			list = append(list, map[string]interface{}{"name": h.Name, "value": h.Value})
+		}
+		return list
+	}
+
+	result := map[string]interface{}{}
+	if len(modifier.Set) > 0 {
+		result["set"] = toList(modifier.Set)
+	}
+	if len(modifier.Add) > 0 {
+		result["add"] = toList(modifier.Add)
+	}
+	if len(modifier.Remove) > 0 {
+		remove := make([]interface{}, 0, len(modifier.Remove))
+		for _, name := range modifier.Remove {
+			remove = append(remove, name)
+		}
+		result["remove"] = remove
+	}
+	return result
+}
+
+// httpRouteAcceptedCondition builds the HTTPRouteAccepted condition from the Accepted
+// conditions the Gateway controllers report on the HTTPRoute parents.
+func (r *LiteLLMInstanceReconciler) httpRouteAcceptedCondition(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) metav1.Condition {
+	condition := r.createCondition(
+		CondTypeHTTPRouteReady,
+		llm.Generation,
+		ReasonHTTPRoutePending,
+		"HTTPRoute has not been accepted by a Gateway yet",
+	)
+
+	if !llm.Status.HTTPRouteCreated {
+		condition.Message = "HTTPRoute has not been created"
+		return condition
+	}
+
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(httpRouteGVK)
+	err := r.Client.Get(ctx, client.ObjectKey{Name: r.litellmResourceNaming.GetHTTPRouteName(), Namespace: llm.Namespace}, route)
+	if err != nil {
+		condition.Message = fmt.Sprintf("Failed to get HTTPRoute: %v", err)
+		return condition
+	}
+
+	accepted, message, found := httpRouteAccepted(route)
+	switch {
+	case accepted:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonHTTPRouteAccepted
+		condition.Message = "HTTPRoute has been accepted by the parent Gateway"
+	case found:
+		condition.Reason = ReasonHTTPRouteRejected
+		condition.Message = fmt.Sprintf("HTTPRoute was not accepted by the parent Gateway: %s", message)
+	}
+
+	return condition
+}
+
+// httpRouteAccepted inspects status.parents[].conditions of an HTTPRoute. It returns whether every parent
+// that reported an Accepted condition accepted the route, the first rejection message, and whether any
+// parent reported an Accepted condition at all.
+func httpRouteAccepted(route *unstructured.Unstructured) (accepted bool, message string, found bool) {
+	parents, _, _ := unstructured.NestedSlice(route.Object, "status", "parents")
+	accepted = true
+	for _, p := range parents {
+		parent, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditions, _, _ := unstructured.NestedSlice(parent, "conditions")
+		for _, c := range conditions {
+			cond, ok := c.(map[string]interface{})
+			if !ok || cond["type"] != "Accepted" {
+				continue
+			}
+			found = true
+			if cond["status"] != string(metav1.ConditionTrue) {
+				accepted = false
+				if message == "" {
+					message, _ = cond["message"].(string)
+				}
+			}
+		}
+	}
+	return found && accepted, message, found
+}
+
+// managedDatabaseEnabled returns true if the operator should provision the database of the instance.
+func managedDatabaseEnabled(llm *litellmv1alpha1.LiteLLMInstance) bool {
+	return llm.Spec.Database != nil && llm.Spec.Database.Managed != nil && llm.Spec.Database.Managed.Enabled
+}
+
+// effectiveDatabaseSecretRef returns the database secret reference used to configure LiteLLM,
+// pointing at the generated credentials Secret when the database is managed by the operator.
+func effectiveDatabaseSecretRef(llm *litellmv1alpha1.LiteLLMInstance) litellmv1alpha1.DatabaseSecretRef {
+	if !managedDatabaseEnabled(llm) {
+		return llm.Spec.DatabaseSecretRef
+	}
+	return litellmv1alpha1.DatabaseSecretRef{
+		NameRef: util.NewLitellmResourceNaming(llm.Name).GetDatabaseSecretName(),
+		Keys: litellmv1alpha1.DatabaseSecretKeys{
+			HostSecret:     "host",
+			PasswordSecret: "password",
+			UsernameSecret: "username",
+			DbnameSecret:   "dbname",
+		},
+	}
+}
+
+// resolveDatabaseProvider returns the provider used for the managed database. The auto provider
+// selects CloudNativePG when its Cluster CRD is installed and falls back to a StatefulSet otherwise.
+func (r *LiteLLMInstanceReconciler) resolveDatabaseProvider(managed *litellmv1alpha1.ManagedDatabase) string {
+	switch managed.Provider {
+	case DatabaseProviderStatefulSet, DatabaseProviderCloudNativePG:
+		return managed.Provider
+	}
+	if _, err := r.RESTMapper().RESTMapping(cnpgClusterGVK.GroupKind(), cnpgClusterGVK.Version); err == nil {
+		return DatabaseProviderCloudNativePG
+	}
+	return DatabaseProviderStatefulSet
+}
+
+// createManagedDatabase provisions the PostgreSQL database of the instance when the managed database is enabled.
+// Disabling the managed database leaves existing resources in place so that no data is lost; they are
+// garbage collected together with the instance.
+func (r *LiteLLMInstanceReconciler) createManagedDatabase(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) error {
+	if !managedDatabaseEnabled(llm) {
+		llm.Status.DatabaseCreated = false
+		llm.Status.DatabaseProvider = ""
+		return nil
+	}
+
+	managed := llm.Spec.Database.Managed
+	provider := r.resolveDatabaseProvider(managed)
+
+	host := r.litellmResourceNaming.GetDatabaseName()
+	if provider == DatabaseProviderCloudNativePG {
+		// CloudNativePG exposes the primary through the <cluster>-rw service
+		host += "-rw"
+	}
+
+	if err := r.createDatabaseSecret(ctx, llm, host); err != nil {
+		return err
+	}
+
+	switch provider {
+	case DatabaseProviderCloudNativePG:
+		if err := r.createCloudNativePGCluster(ctx, llm); err != nil {
+			return err
+		}
+	default:
+		if err := r.createDatabaseService(ctx, llm); err != nil {
+			return err
+		}
+		if err := r.createDatabaseStatefulSet(ctx, llm); err != nil {
+			return err
+		}
+	}
+
+	llm.Status.DatabaseCreated = true
+	llm.Status.DatabaseProvider = provider
+
+	return nil
+}
+
+// createDatabaseSecret creates or updates the credentials Secret of the managed database.
+// The password is generated once and preserved on subsequent reconciles.
+func (r *LiteLLMInstanceReconciler) createDatabaseSecret(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance, host string) error {
+	secretName := r.litellmResourceNaming.GetDatabaseSecretName()
+	existingSecret := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKey{Name: secretName, Namespace: llm.Namespace}, existingSecret)
+	if err != nil && client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	managed := llm.Spec.Database.Managed
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: llm.Namespace,
+			Labels:    r.litellmResourceNaming.GetDatabaseLabels(),
+		},
+		// basic-auth is required by CloudNativePG for the initdb owner secret
+		Type: corev1.SecretTypeBasicAuth,
+		Data: buildDatabaseSecretData(managed, host, existingSecret),
+	}
+
+	_, _, err = r.createOrUpdateResource(ctx, llm, secret, "DatabaseSecret")
+	return err
+}
+
+// buildDatabaseSecretData builds the credentials of the managed database, preserving an existing password.
+func buildDatabaseSecretData(managed *litellmv1alpha1.ManagedDatabase, host string, existingSecret *corev1.Secret) map[string][]byte {
+	password := []byte(strings.ReplaceAll(uuid.New().String(), "-", ""))
+	if existingSecret != nil && len(existingSecret.Data[corev1.BasicAuthPasswordKey]) > 0 {
+		password = existingSecret.Data[corev1.BasicAuthPasswordKey]
+	}
+
+	return map[string][]byte{
+		corev1.BasicAuthUsernameKey: []byte(defaultString(managed.Username, "litellm")),
+		corev1.BasicAuthPasswordKey: password,
+		"host":                      []byte(host),
+		"port":                      []byte(strconv.Itoa(PostgresPort)),
+		"dbname":                    []byte(defaultString(managed.DatabaseName, "litellm")),
+	}
+}
+
+// createDatabaseService creates or updates the Service in front of the managed PostgreSQL StatefulSet.
+func (r *LiteLLMInstanceReconciler) createDatabaseService(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) error {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.litellmResourceNaming.GetDatabaseName(),
+			Namespace: llm.Namespace,
+			Labels:    r.litellmResourceNaming.GetDatabaseLabels(),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: r.litellmResourceNaming.GetDatabaseLabels(),
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "postgres",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       PostgresPort,
+					TargetPort: intstr.FromInt(PostgresPort),
+				},
+			},
+			Type: corev1.ServiceTypeClusterIP,
+		},
+	}
+
+	_, _, err := r.createOrUpdateResource(ctx, llm, service, "DatabaseService")
+	return err
+}
+
+// createDatabaseStatefulSet creates or updates the single replica PostgreSQL StatefulSet of the managed database.
+func (r *LiteLLMInstanceReconciler) createDatabaseStatefulSet(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) error {
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.litellmResourceNaming.GetDatabaseName(),
+			Namespace: llm.Namespace,
+			Labels:    r.litellmResourceNaming.GetDatabaseLabels(),
+		},
+		Spec: buildDatabaseStatefulSetSpec(llm.Spec.Database.Managed, r.litellmResourceNaming),
+	}
+
+	_, _, err := r.createOrUpdateResource(ctx, llm, statefulSet, "DatabaseStatefulSet")
+	return err
+}
+
+// buildDatabaseStatefulSetSpec builds the PostgreSQL StatefulSet spec with a persistent volume claim for the data directory.
+func buildDatabaseStatefulSetSpec(managed *litellmv1alpha1.ManagedDatabase, naming *util.LitellmResourceNaming) appsv1.StatefulSetSpec {
+	secretName := naming.GetDatabaseSecretName()
+	storageSize := managed.StorageSize
+	if storageSize.IsZero() {
+		storageSize = resource.MustParse("10Gi")
+	}
+
+	container := corev1.Container{
+		Name:  "postgres",
+		Image: defaultString(managed.Image, DefaultPostgresImage),
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "postgres",
+				ContainerPort: PostgresPort,
+			},
+		},
+		Env: []corev1.EnvVar{
+			secretKeyEnvVar("POSTGRES_USER", secretName, corev1.BasicAuthUsernameKey),
+			secretKeyEnvVar("POSTGRES_PASSWORD", secretName, corev1.BasicAuthPasswordKey),
+			secretKeyEnvVar("POSTGRES_DB", secretName, "dbname"),
+			{Name: "PGDATA", Value: "/var/lib/postgresql/data/pgdata"},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "data",
+				MountPath: "/var/lib/postgresql/data",
+			},
+		},
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				Exec: &corev1.ExecAction{
+					Command: []string{"sh", "-c", "pg_isready -U \"$POSTGRES_USER\" -d \"$POSTGRES_DB\""},
+				},
+			},
+			InitialDelaySeconds: 5,
+			PeriodSeconds:       10,
+			TimeoutSeconds:      5,
+			FailureThreshold:    6,
+		},
+	}
+	if managed.Resources != nil {
+		container.Resources = *managed.Resources
+	}
+
+	return appsv1.StatefulSetSpec{
+		Replicas:    util.Int32Ptr(1),
+		ServiceName: naming.GetDatabaseName(),
+		Selector: &metav1.LabelSelector{
+			MatchLabels: naming.GetDatabaseLabels(),
+		},
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: naming.GetDatabaseLabels(),
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{container},
+			},
+		},
+		VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "data",
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					StorageClassName: managed.StorageClassName,
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: storageSize},
+					},
+				},
+			},
+		},
+	}
+}
+
+// createCloudNativePGCluster creates or updates a CloudNativePG Cluster bootstrapped with the generated credentials.
+func (r *LiteLLMInstanceReconciler) createCloudNativePGCluster(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) error {
+	cluster := &unstructured.Unstructured{}
+	cluster.SetGroupVersionKind(cnpgClusterGVK)
+	cluster.SetName(r.litellmResourceNaming.GetDatabaseName())
+	cluster.SetNamespace(llm.Namespace)
+	cluster.SetLabels(r.litellmResourceNaming.GetDatabaseLabels())
+	cluster.Object["spec"] = buildCloudNativePGClusterSpec(llm.Spec.Database.Managed, r.litellmResourceNaming.GetDatabaseSecretName())
+
+	if _, _, err := r.createOrUpdateResource(ctx, llm, cluster, "CloudNativePGCluster"); err != nil {
+		if meta.IsNoMatchError(err) {
+			return fmt.Errorf("the CloudNativePG Cluster CRD is not installed in the cluster: %w", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// buildCloudNativePGClusterSpec builds the unstructured CloudNativePG Cluster spec.
+// Numeric values are int64 so the object can be deep copied as JSON.
+func buildCloudNativePGClusterSpec(managed *litellmv1alpha1.ManagedDatabase, secretName string) map[string]interface{} {
+	instances := int64(managed.Instances)
+	if instances < 1 {
+		instances = 1
+	}
+	storageSize := managed.StorageSize
+	if storageSize.IsZero() {
+		storageSize = resource.MustParse("10Gi")
+	}
+
+	storage := map[string]interface{}{"size": storageSize.String()}
+	if managed.StorageClassName != nil {
+		storage["storageClass"] = *managed.StorageClassName
+	}
+
+	spec := map[string]interface{}{
+		"instances": instances,
+		"storage":   storage,
+		"bootstrap": map[string]interface{}{
+			"initdb": map[string]interface{}{
+				"database": defaultString(managed.DatabaseName, "litellm"),
+				"owner":    defaultString(managed.Username, "litellm"),
+				"secret":   map[string]interface{}{"name": secretName},
+			},
+		},
+	}
+	if managed.Image != "" {
+		spec["imageName"] = managed.Image
+	}
+	if managed.Resources != nil {
+		resources := map[string]interface{}{}
+		for key, list := range map[string]corev1.ResourceList{"requests": managed.Resources.Requests, "limits": managed.Resources.Limits} {
+			if len(list) == 0 {
+				continue
+			}
+			values := map[string]interface{}{}
+			for name, quantity := range list {
+				values[string(name)] = quantity.String()
+			}
+			resources[key] = values
+		}
+		if len(resources) > 0 {
+			spec["resources"] = resources
+		}
+	}
+
+	return spec
+}
+
+// databaseReadyCondition builds the DatabaseReady condition from the readiness of the managed database.
+func (r *LiteLLMInstanceReconciler) databaseReadyCondition(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) metav1.Condition {
+	condition := r.createCondition(
+		CondTypeDatabaseReady,
+		llm.Generation,
+		ReasonDatabaseNotReady,
+		"Managed database is not ready",
+	)
+
+	if !llm.Status.DatabaseCreated {
+		condition.Message = "Managed database has not been created"
+		return condition
+	}
+
+	key := client.ObjectKey{Name: r.litellmResourceNaming.GetDatabaseName(), Namespace: llm.Namespace}
+	var readyInstances int64
+	switch llm.Status.DatabaseProvider {
+	case DatabaseProviderCloudNativePG:
+		cluster := &unstructured.Unstructured{}
+		cluster.SetGroupVersionKind(cnpgClusterGVK)
+		if err := r.Client.Get(ctx, key, cluster); err != nil {
+			condition.Message = fmt.Sprintf("Failed to get CloudNativePG Cluster: %v", err)
+			return condition
+		}
+		readyInstances, _, _ = unstructured.NestedInt64(cluster.Object, "status", "readyInstances")
+	default:
+		statefulSet := &appsv1.StatefulSet{}
+		if err := r.Client.Get(ctx, key, statefulSet); err != nil {
+			condition.Message = fmt.Sprintf("Failed to get database StatefulSet: %v", err)
+			return condition
+		}
+		readyInstances = int64(statefulSet.Status.ReadyReplicas)
+	}
+
+	if readyInstances > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonDatabaseReady
+		condition.Message = fmt.Sprintf("Managed %s database has %d ready instance(s)", llm.Status.DatabaseProvider, readyInstances)
+	}
+
+	return condition
+}
+
+// defaultString returns value, or fallback when value is empty.
+func defaultString(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+// migrationsEnabled returns true if database migrations run in a Job before the Deployment is rolled.
+// Migrations are only run when the instance has a database.
+func migrationsEnabled(llm *litellmv1alpha1.LiteLLMInstance) bool {
+	return llm.Spec.Migrations != nil && llm.Spec.Migrations.Enabled && effectiveDatabaseSecretRef(llm).NameRef != ""
+}
+
+// deploymentImage returns the image the Deployment should run. While the migrations of a new image
+// are pending the current image is kept; an empty result means no image has been migrated yet.
+// A canary or blue/green upgrade also keeps the current image until the new image is promoted.
+func deploymentImage(llm *litellmv1alpha1.LiteLLMInstance) string {
+	image := llm.Spec.Image
+	if migrationsEnabled(llm) && llm.Status.MigratedImage != llm.Spec.Image {
+		image = llm.Status.CurrentImage
+	}
+
+	if progressiveUpgradeEnabled(llm) && llm.Status.CurrentImage != "" && image != llm.Status.CurrentImage && !upgradePromoting(llm, image) {
+		return llm.Status.CurrentImage
+	}
+	return image
+}
+
+// ensureMigrations runs the migration Job for the desired image and records the image as migrated once
+// the Job has succeeded. Jobs are immutable, so an existing Job is only observed and never updated.
+func (r *LiteLLMInstanceReconciler) ensureMigrations(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) error {
+	log := logf.FromContext(ctx)
+
+	if !migrationsEnabled(llm) || llm.Status.MigratedImage == llm.Spec.Image {
+		return nil
+	}
+
+	existing := &batchv1.Job{}
+	jobName := r.litellmResourceNaming.GetMigrationJobName(llm.Spec.Image)
+	err := r.Get(ctx, client.ObjectKey{Name: jobName, Namespace: llm.Namespace}, existing)
+	if client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	if err != nil {
+		job := buildMigrationJob(llm, jobName, r.litellmResourceNaming, ctx, r.Client)
+		if err := controllerutil.SetControllerReference(llm, job, r.Scheme); err != nil {
+			return fmt.Errorf("failed to set controller reference on migration Job: %w", err)
+		}
+		if err := r.Create(ctx, job); err != nil {
+			return fmt.Errorf("failed to create migration Job: %w", err)
+		}
+		log.Info("Created database migration job", "job", jobName, "image", llm.Spec.Image)
+		return nil
+	}
+
+	if jobSucceeded(existing) {
+		log.Info("Database migrations applied", "job", jobName, "image", llm.Spec.Image)
+		llm.Status.MigratedImage = llm.Spec.Image
+		return r.deleteStaleMigrationJobs(ctx, llm, jobName)
+	}
+
+	return nil
+}
+
+// deleteStaleMigrationJobs removes the migration Jobs of earlier images once a newer image has been migrated.
+func (r *LiteLLMInstanceReconciler) deleteStaleMigrationJobs(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance, currentJob string) error {
+	labels := r.litellmResourceNaming.GetAppLabels()
+	labels[MigrationLabel] = "true"
+
+	jobs := &batchv1.JobList{}
+	if err := r.List(ctx, jobs, client.InNamespace(llm.Namespace), client.MatchingLabels(labels)); err != nil {
+		return err
+	}
+
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if job.Name == currentJob || !metav1.IsControlledBy(job, llm) {
+			continue
+		}
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete stale migration Job %s: %w", job.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// buildMigrationJob builds the Job that applies the database migrations of the desired image.
+func buildMigrationJob(llm *litellmv1alpha1.LiteLLMInstance, name string, naming *util.LitellmResourceNaming, ctx context.Context, k8sClient client.Client) *batchv1.Job {
+	migrations := llm.Spec.Migrations
+	command := migrations.Command
+	if len(command) == 0 {
+		command = []string{"python", "litellm/proxy/prisma_migration.py"}
+	}
+	backoffLimit := migrations.BackoffLimit
+	if backoffLimit == nil {
+		backoffLimit = util.Int32Ptr(3)
+	}
+
+	// The Job is listed for clean-up by the application labels, while its pods are labelled apart from the
+	// LiteLLM pods so that no selector of the instance matches them
+	labels := naming.GetAppLabels()
+	labels[MigrationLabel] = "true"
+
+	podSpec := corev1.PodSpec{
+		RestartPolicy:      corev1.RestartPolicyNever,
+		ServiceAccountName: naming.GetServiceAccountName(),
+		Containers: []corev1.Container{
+			{
+				Name:    MigrationContainerName,
+				Image:   llm.Spec.Image,
+				Command: command,
+				Env:     buildEnvironmentVariables(llm, naming.GetSecretName(), ctx, k8sClient),
+			},
+		},
+	}
+	if llm.Spec.PodTemplate != nil {
+		podSpec.ImagePullSecrets = llm.Spec.PodTemplate.ImagePullSecrets
+		podSpec.NodeSelector = llm.Spec.PodTemplate.NodeSelector
+		podSpec.Tolerations = llm.Spec.PodTemplate.Tolerations
+		podSpec.SecurityContext = llm.Spec.PodTemplate.SecurityContext
+		podSpec.Containers[0].SecurityContext = llm.Spec.PodTemplate.ContainerSecurityContext
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: llm.Namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				"litellm.ai/image": llm.Spec.Image,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          backoffLimit,
+			ActiveDeadlineSeconds: migrations.ActiveDeadlineSeconds,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: naming.GetMigrationLabels(),
+				},
+				Spec: podSpec,
+			},
+		},
+	}
+}
+
+// jobSucceeded returns true if the Job has completed successfully.
+func jobSucceeded(job *batchv1.Job) bool {
+	return jobHasCondition(job, batchv1.JobComplete) || (job.Status.Succeeded > 0 && !jobHasCondition(job, batchv1.JobFailed))
+}
+
+// jobFailed returns true if the Job has failed permanently.
+func jobFailed(job *batchv1.Job) bool {
+	return jobHasCondition(job, batchv1.JobFailed)
+}
+
+// jobHasCondition returns true if the Job has a true condition of the given type.
+func jobHasCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == conditionType && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// migrationsCondition builds the MigrationsApplied condition for the desired image.
+func (r *LiteLLMInstanceReconciler) migrationsCondition(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) metav1.Condition {
+	condition := r.createCondition(
+		CondTypeMigrations,
+		llm.Generation,
+		ReasonMigrationRunning,
+		fmt.Sprintf("Database migrations for image %s are running", llm.Spec.Image),
+	)
+
+	if llm.Status.MigratedImage == llm.Spec.Image {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonMigrationsApplied
+		condition.Message = fmt.Sprintf("Database migrations applied for image %s", llm.Spec.Image)
+		return condition
+	}
+
+	job := &batchv1.Job{}
+	jobName := r.litellmResourceNaming.GetMigrationJobName(llm.Spec.Image)
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: jobName, Namespace: llm.Namespace}, job); err == nil && jobFailed(job) {
+		condition.Reason = ReasonMigrationFailed
+		condition.Message = fmt.Sprintf("Migration job %s failed; the deployment keeps running image %s. Delete the job to retry", jobName, llm.Status.CurrentImage)
+	}
+
+	return condition
+}
+
+// upgradeStrategyType returns the configured upgrade strategy, RollingUpdate when none is set.
+func upgradeStrategyType(llm *litellmv1alpha1.LiteLLMInstance) string {
+	if llm.Spec.UpgradeStrategy == nil || llm.Spec.UpgradeStrategy.Type == "" {
+		return UpgradeStrategyRollingUpdate
+	}
+	return llm.Spec.UpgradeStrategy.Type
+}
+
+// progressiveUpgradeEnabled returns true if a new image is rolled out through a canary or blue/green Deployment.
+func progressiveUpgradeEnabled(llm *litellmv1alpha1.LiteLLMInstance) bool {
+	strategy := upgradeStrategyType(llm)
+	return strategy == UpgradeStrategyCanary || strategy == UpgradeStrategyBlueGreen
+}
+
+// activeUpgrade returns the canary or blue/green upgrade in progress, or nil when there is none.
+func activeUpgrade(llm *litellmv1alpha1.LiteLLMInstance) *litellmv1alpha1.UpgradeStatus {
+	upgrade := llm.Status.Upgrade
+	if upgrade != nil && (upgrade.Phase == UpgradePhaseProgressing || upgrade.Phase == UpgradePhasePromoting) {
+		return upgrade
+	}
+	return nil
+}
+
+// upgradePromoting returns true if the given image is being promoted to the instance Deployment.
+func upgradePromoting(llm *litellmv1alpha1.LiteLLMInstance, image string) bool {
+	upgrade := activeUpgrade(llm)
+	return upgrade != nil && upgrade.Phase == UpgradePhasePromoting && upgrade.TargetImage == image
+}
+
+// upgradeSuffix returns the name suffix of the resources running the new image for an upgrade strategy.
+func upgradeSuffix(strategy string) string {
+	if strategy == UpgradeStrategyBlueGreen {
+		return util.PreviewSuffix
+	}
+	return util.CanarySuffix
+}
+
+// upgradeLabels returns the pod labels of the new image. They are disjoint from the app labels, so that
+// the pods are not selected by the instance Deployment, Service, PodDisruptionBudget or autoscaler.
+func upgradeLabels(naming *util.LitellmResourceNaming, strategy string) map[string]string {
+	suffix := upgradeSuffix(strategy)
+	labels := naming.GetUpgradeLabels(suffix)
+	labels[UpgradeTrackLabel] = strings.TrimPrefix(suffix, "-")
+	return labels
+}
+
+// upgradeReplicas returns the number of pods running the new image. A blue/green preview is sized like
+// the instance Deployment so that it can take over all of the traffic.
+func upgradeReplicas(llm *litellmv1alpha1.LiteLLMInstance, strategy string) int32 {
+	if strategy == UpgradeStrategyCanary {
+		if canary := llm.Spec.UpgradeStrategy.Canary; canary != nil && canary.Replicas != nil {
+			return *canary.Replicas
+		}
+		return 1
+	}
+	if autoscalingEnabled(llm) && llm.Spec.Autoscaling.MinReplicas != nil {
+		return *llm.Spec.Autoscaling.MinReplicas
+	}
+	return max(llm.Spec.Replicas, 1)
+}
+
+// canaryWeight returns the percentage of the HTTPRoute traffic sent to the canary.
+func canaryWeight(llm *litellmv1alpha1.LiteLLMInstance) int32 {
+	if canary := llm.Spec.UpgradeStrategy.Canary; canary != nil && canary.Weight != nil {
+		return *canary.Weight
+	}
+	return 10
+}
+
+// upgradeDelay returns how long the new image must stay healthy before it is promoted.
+func upgradeDelay(llm *litellmv1alpha1.LiteLLMInstance, strategy string) time.Duration {
+	seconds := int32(300)
+	if strategy == UpgradeStrategyBlueGreen {
+		seconds = 60
+		if blueGreen := llm.Spec.UpgradeStrategy.BlueGreen; blueGreen != nil && blueGreen.PromotionDelaySeconds != nil {
+			seconds = *blueGreen.PromotionDelaySeconds
+		}
+	} else if canary := llm.Spec.UpgradeStrategy.Canary; canary != nil && canary.AnalysisSeconds != nil {
+		seconds = *canary.AnalysisSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// upgradeProgressDeadline returns how long the new image may take to become ready and healthy.
+func upgradeProgressDeadline(llm *litellmv1alpha1.LiteLLMInstance) time.Duration {
+	seconds := int32(600)
+	if llm.Spec.UpgradeStrategy.ProgressDeadlineSeconds != nil {
+		seconds = *llm.Spec.UpgradeStrategy.ProgressDeadlineSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// upgradeAutoPromote returns true if the new image is promoted without the promote annotation.
+func upgradeAutoPromote(llm *litellmv1alpha1.LiteLLMInstance, strategy string) bool {
+	if strategy != UpgradeStrategyBlueGreen {
+		return true
+	}
+	blueGreen := llm.Spec.UpgradeStrategy.BlueGreen
+	return blueGreen == nil || blueGreen.AutoPromote == nil || *blueGreen.AutoPromote
+}
+
+// setUpgradeStep records a step of the upgrade in the instance status.
+func setUpgradeStep(upgrade *litellmv1alpha1.UpgradeStatus, phase, step, message string) {
+	upgrade.Phase = phase
+	upgrade.Step = step
+	upgrade.Message = message
+}
+
+// deploymentRolledOut returns true if all pods of the Deployment run the given image and are available.
+func deploymentRolledOut(deployment *appsv1.Deployment, image string) bool {
+	containers := deployment.Spec.Template.Spec.Containers
+	if len(containers) == 0 || containers[0].Image != image {
+		return false
+	}
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return deployment.Status.UpdatedReplicas >= replicas &&
+		deployment.Status.ReadyReplicas >= replicas &&
+		deployment.Status.AvailableReplicas >= replicas
+}
+
+// ensureUpgrade drives a canary or blue/green upgrade to the desired image. The new image runs in a
+// second Deployment until its pods are ready and the proxy health check has passed for the configured
+// delay, after which it is promoted to the instance Deployment. A new image that does not become healthy
+// within the progress deadline, or that turns unhealthy after passing the health check, is rolled back.
+func (r *LiteLLMInstanceReconciler) ensureUpgrade(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance, checksum string) error {
+	log := logf.FromContext(ctx)
+	upgrade := activeUpgrade(llm)
+
+	if !progressiveUpgradeEnabled(llm) {
+		if upgrade == nil {
+			return nil
+		}
+		setUpgradeStep(upgrade, UpgradePhaseAborted, ReasonUpgradeAborted,
+			fmt.Sprintf("Upgrade to image %s aborted after the upgrade strategy changed", upgrade.TargetImage))
+		return r.deleteUpgradeResources(ctx, llm, "")
+	}
+
+	strategy := upgradeStrategyType(llm)
+	target := llm.Spec.Image
+
+	// The new image is only started once its database migrations have been applied
+	if migrationsEnabled(llm) && llm.Status.MigratedImage != target {
+		return nil
+	}
+
+	if llm.Status.CurrentImage == "" || llm.Status.CurrentImage == target {
+		if upgrade == nil {
+			return nil
+		}
+		if upgrade.Phase == UpgradePhasePromoting && upgrade.TargetImage == target {
+			// Wait for the instance Deployment to roll out the promoted image before removing the new image's Deployment
+			deployment := &appsv1.Deployment{}
+			err := r.Get(ctx, client.ObjectKey{Name: r.litellmResourceNaming.GetDeploymentName(), Namespace: llm.Namespace}, deployment)
+			if err != nil {
+				return client.IgnoreNotFound(err)
+			}
+			if !deploymentRolledOut(deployment, target) {
+				return nil
+			}
+			log.Info("Upgrade promoted", "strategy", strategy, "image", target)
+			setUpgradeStep(upgrade, UpgradePhaseSucceeded, ReasonUpgradePromoted, fmt.Sprintf("Image %s has been promoted", target))
+		} else {
+			setUpgradeStep(upgrade, UpgradePhaseAborted, ReasonUpgradeAborted,
+				fmt.Sprintf("Upgrade to image %s aborted, the instance image was changed back to %s", upgrade.TargetImage, target))
+		}
+		return r.deleteUpgradeResources(ctx, llm, "")
+	}
+
+	// Do not retry an image that has been rolled back until the instance image changes again
+	if llm.Status.Upgrade != nil && llm.Status.Upgrade.Phase == UpgradePhaseRolledBack && llm.Status.Upgrade.TargetImage == target {
+		return nil
+	}
+
+	if upgrade == nil || upgrade.TargetImage != target || upgrade.Strategy != strategy {
+		if err := r.deleteUpgradeResources(ctx, llm, strategy); err != nil {
+			return err
+		}
+		now := metav1.Now()
+		upgrade = &litellmv1alpha1.UpgradeStatus{Strategy: strategy, TargetImage: target, StartedAt: &now}
+		setUpgradeStep(upgrade, UpgradePhaseProgressing, ReasonUpgradeStarted,
+			fmt.Sprintf("Started %s upgrade from image %s to %s", strategy, llm.Status.CurrentImage, target))
+		llm.Status.Upgrade = upgrade
+		log.Info("Started upgrade", "strategy", strategy, "from", llm.Status.CurrentImage, "to", target)
+	}
+
+	// The instance Deployment rolls out the target image while the upgrade is being promoted
+	if upgrade.Phase == UpgradePhasePromoting {
+		return nil
+	}
+
+	deployment, err := r.createUpgradeDeployment(ctx, llm, strategy, target, checksum)
+	if err != nil {
+		return err
+	}
+	if err := r.createUpgradeService(ctx, llm, strategy); err != nil {
+		return err
+	}
+
+	pastDeadline := time.Since(upgrade.StartedAt.Time) > upgradeProgressDeadline(llm)
+	if !deploymentRolledOut(deployment, target) {
+		if pastDeadline {
+			return r.rollbackUpgrade(ctx, llm, fmt.Sprintf("pods of image %s did not become ready within the progress deadline", target))
+		}
+		upgrade.HealthySince = nil
+		setUpgradeStep(upgrade, UpgradePhaseProgressing, ReasonUpgradeNotReady,
+			fmt.Sprintf("Waiting for the pods of image %s to become ready", target))
+		return nil
+	}
+
+	if err := r.checkUpgradeHealth(ctx, llm, strategy); err != nil {
+		// A new image that turns unhealthy after passing the health check is rolled back straight away
+		if pastDeadline || upgrade.HealthySince != nil {
+			return r.rollbackUpgrade(ctx, llm, fmt.Sprintf("health check of image %s failed: %v", target, err))
+		}
+		setUpgradeStep(upgrade, UpgradePhaseProgressing, ReasonUpgradeUnhealthy,
+			fmt.Sprintf("Health check of image %s failed: %v", target, err))
+		return nil
+	}
+
+	if upgrade.HealthySince == nil {
+		now := metav1.Now()
+		upgrade.HealthySince = &now
+	}
+	delay := upgradeDelay(llm, strategy)
+	if time.Since(upgrade.HealthySince.Time) < delay {
+		setUpgradeStep(upgrade, UpgradePhaseProgressing, ReasonUpgradeHealthy,
+			fmt.Sprintf("Image %s is healthy, promoting once it has been healthy for %s", target, delay))
+		return nil
+	}
+
+	if !upgradeAutoPromote(llm, strategy) && llm.Annotations[PromoteAnnotation] != target {
+		setUpgradeStep(upgrade, UpgradePhaseProgressing, ReasonUpgradeAwaitingPromotion,
+			fmt.Sprintf("Image %s is healthy, set the %s annotation to %s to promote it", target, PromoteAnnotation, target))
+		return nil
+	}
+
+	log.Info("Promoting upgrade", "strategy", strategy, "image", target)
+	setUpgradeStep(upgrade, UpgradePhasePromoting, ReasonUpgradePromoting, fmt.Sprintf("Promoting image %s", target))
+
+	return nil
+}
+
+// rollbackUpgrade removes the Deployment of the new image and records the rollback. The instance
+// Deployment keeps running the current image until the instance image is changed again.
+func (r *LiteLLMInstanceReconciler) rollbackUpgrade(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance, reason string) error {
+	logf.FromContext(ctx).Info("Rolling back upgrade", "image", llm.Status.Upgrade.TargetImage, "reason", reason)
+	setUpgradeStep(llm.Status.Upgrade, UpgradePhaseRolledBack, ReasonUpgradeRolledBack,
+		fmt.Sprintf("Rolled back to image %s: %s", llm.Status.CurrentImage, reason))
+	return r.deleteUpgradeResources(ctx, llm, "")
+}
+
+// deleteUpgradeResources removes the Deployments and Services running a new image, except those of the kept strategy.
+func (r *LiteLLMInstanceReconciler) deleteUpgradeResources(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance, keep string) error {
+	for _, strategy := range []string{UpgradeStrategyCanary, UpgradeStrategyBlueGreen} {
+		if strategy == keep {
+			continue
+		}
+		suffix := upgradeSuffix(strategy)
+		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Name:      r.litellmResourceNaming.GetUpgradeDeploymentName(suffix),
+			Namespace: llm.Namespace,
+		}}
+		if err := r.deleteIfExists(ctx, deployment); err != nil {
+			return err
+		}
+		service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+			Name:      r.litellmResourceNaming.GetUpgradeServiceName(suffix),
+			Namespace: llm.Namespace,
+		}}
+		if err := r.deleteIfExists(ctx, service); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createUpgradeDeployment creates or updates the Deployment running the new image and returns its current state.
+func (r *LiteLLMInstanceReconciler) createUpgradeDeployment(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance, strategy, image, checksum string) (*appsv1.Deployment, error) {
+	name := r.litellmResourceNaming.GetUpgradeDeploymentName(upgradeSuffix(strategy))
+	deployment := r.buildDeployment(ctx, llm, name, upgradeLabels(r.litellmResourceNaming, strategy), image, checksum)
+	deployment.Spec.Replicas = util.Int32Ptr(upgradeReplicas(llm, strategy))
+
+	if _, _, err := r.createOrUpdateResource(ctx, llm, deployment, "Deployment"); err != nil {
+		return nil, err
+	}
+
+	current := &appsv1.Deployment{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(deployment), current); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+// createUpgradeService creates or updates the Service selecting only the pods of the new image.
+// It is used for the health check, for the canary backend of the HTTPRoute and to preview a blue/green upgrade.
+func (r *LiteLLMInstanceReconciler) createUpgradeService(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance, strategy string) error {
+	labels := upgradeLabels(r.litellmResourceNaming, strategy)
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.litellmResourceNaming.GetUpgradeServiceName(upgradeSuffix(strategy)),
 			Namespace: llm.Namespace,
-			Labels:    r.litellmResourceNaming.GetAppLabels(),
+			Labels:    labels,
 		},
 		Spec: corev1.ServiceSpec{
-			Selector: r.litellmResourceNaming.GetAppLabels(),
+			Selector: labels,
 			Ports: []corev1.ServicePort{
 				{
 					Name:       "http",
@@ -1150,130 +3328,290 @@ func (r *LiteLLMInstanceReconciler) createService(ctx context.Context, llm *lite
 		},
 	}
 
-	resource, _, err := r.createOrUpdateResource(ctx, llm, service, "Service")
+	_, _, err := r.createOrUpdateResource(ctx, llm, service, "Service")
+	return err
+}
+
+// serviceSelector returns the pod selector of the instance Service. While a blue/green upgrade is
+// being promoted the Service switches to the preview pods until the instance Deployment has rolled out.
+func serviceSelector(llm *litellmv1alpha1.LiteLLMInstance, naming *util.LitellmResourceNaming) map[string]string {
+	if upgrade := activeUpgrade(llm); upgrade != nil && upgrade.Phase == UpgradePhasePromoting &&
+		upgrade.Strategy == UpgradeStrategyBlueGreen && upgradeStrategyType(llm) == UpgradeStrategyBlueGreen {
+		return upgradeLabels(naming, UpgradeStrategyBlueGreen)
+	}
+	return naming.GetAppLabels()
+}
+
+// canaryBackend returns the canary Service and its traffic weight for the HTTPRoute, or an empty name when no canary is running.
+func canaryBackend(llm *litellmv1alpha1.LiteLLMInstance, naming *util.LitellmResourceNaming) (string, int32) {
+	upgrade := activeUpgrade(llm)
+	if upgrade == nil || upgrade.Strategy != UpgradeStrategyCanary || upgradeStrategyType(llm) != UpgradeStrategyCanary {
+		return "", 0
+	}
+	return naming.GetUpgradeServiceName(util.CanarySuffix), canaryWeight(llm)
+}
+
+// checkUpgradeHealth calls the proxy health endpoint through the Service of the new image, authenticated with the master key.
+func (r *LiteLLMInstanceReconciler) checkUpgradeHealth(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance, strategy string) error {
+	secret, err := util.GetMapFromSecret(ctx, r.Client, client.ObjectKey{Name: r.litellmResourceNaming.GetSecretName(), Namespace: llm.Namespace})
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to read the master key: %w", err)
 	}
 
-	llm.Status.ServiceCreated = true
+	path := DefaultUpgradeHealthPath
+	timeout := 30 * time.Second
+	if healthCheck := llm.Spec.UpgradeStrategy.HealthCheck; healthCheck != nil {
+		path = defaultString(healthCheck.Path, path)
+		if healthCheck.TimeoutSeconds != nil {
+			timeout = time.Duration(*healthCheck.TimeoutSeconds) * time.Second
+		}
+	}
 
-	return resource.(*corev1.Service), nil
+	url := fmt.Sprintf("http://%s.%s.svc:%d%s",
+		r.litellmResourceNaming.GetUpgradeServiceName(upgradeSuffix(strategy)), llm.Namespace, ServicePort, path)
+
+	check := r.healthCheck
+	if check == nil {
+		check = checkProxyHealth
+	}
+	return check(ctx, url, secret["masterkey"], timeout)
 }
 
-// createServiceAccount creates or updates the ServiceAccount for the LiteLLM instance.
-// It creates a ServiceAccount that the Deployment will use.
-func (r *LiteLLMInstanceReconciler) createServiceAccount(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) (*corev1.ServiceAccount, error) {
-	serviceAccount := &corev1.ServiceAccount{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      r.litellmResourceNaming.GetServiceAccountName(),
-			Namespace: llm.Namespace,
-			Labels:    r.litellmResourceNaming.GetAppLabels(),
-		},
+// checkProxyHealth sends a GET request to a LiteLLM health endpoint and fails on a non 200 response
+// or when the response reports unhealthy model deployments.
+func checkProxyHealth(ctx context.Context, url, masterKey string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	if masterKey != "" {
+		req.Header.Set("Authorization", "Bearer "+masterKey)
 	}
 
-	resource, _, err := r.createOrUpdateResource(ctx, llm, serviceAccount, "ServiceAccount")
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	defer func() { _ = resp.Body.Close() }()
 
-	return resource.(*corev1.ServiceAccount), nil
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("health endpoint returned %s", resp.Status)
+	}
+	return proxyHealthError(body)
 }
 
-// createRBAC creates or updates the Role and RoleBinding for the LiteLLM instance ServiceAccount.
-// It creates a Role with minimal permissions and a RoleBinding to bind it to the ServiceAccount.
-func (r *LiteLLMInstanceReconciler) createRBAC(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) error {
-	// Create Role with minimal permissions for the LiteLLM instance
-	role := &rbacv1.Role{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      r.litellmResourceNaming.GetRoleName(),
-			Namespace: llm.Namespace,
-			Labels:    r.litellmResourceNaming.GetAppLabels(),
-		},
-		Rules: []rbacv1.PolicyRule{
-			{
-				APIGroups: []string{""},
-				Resources: []string{"configmaps", "secrets"},
-				Verbs:     []string{"get", "list", "watch"},
-			},
-		},
+// proxyHealthError returns an error if a /health response reports unhealthy model deployments.
+// Responses of the other health endpoints carry no counts and are accepted.
+func proxyHealthError(body []byte) error {
+	var health struct {
+		HealthyCount   int `json:"healthy_count"`
+		UnhealthyCount int `json:"unhealthy_count"`
+	}
+	if err := json.Unmarshal(body, &health); err != nil {
+		return nil
+	}
+	if health.UnhealthyCount > 0 {
+		return fmt.Errorf("%d of %d model deployments are unhealthy", health.UnhealthyCount, health.HealthyCount+health.UnhealthyCount)
 	}
+	return nil
+}
 
-	_, _, err := r.createOrUpdateResource(ctx, llm, role, "Role")
-	if err != nil {
-		return err
+// upgradeCondition builds the Upgrading condition from the recorded upgrade step.
+func (r *LiteLLMInstanceReconciler) upgradeCondition(llm *litellmv1alpha1.LiteLLMInstance) metav1.Condition {
+	upgrade := llm.Status.Upgrade
+	condition := r.createCondition(CondTypeUpgrading, llm.Generation, upgrade.Step, upgrade.Message)
+	if activeUpgrade(llm) != nil {
+		condition.Status = metav1.ConditionTrue
 	}
+	return condition
+}
 
-	// Create RoleBinding to bind the Role to the ServiceAccount
-	roleBinding := &rbacv1.RoleBinding{
+// autoscalingEnabled returns true if a HorizontalPodAutoscaler should manage the LiteLLM Deployment.
+func autoscalingEnabled(llm *litellmv1alpha1.LiteLLMInstance) bool {
+	return llm.Spec.Autoscaling != nil && llm.Spec.Autoscaling.Enabled
+}
+
+// podDisruptionBudgetEnabled returns true if a PodDisruptionBudget should protect the LiteLLM pods.
+func podDisruptionBudgetEnabled(llm *litellmv1alpha1.LiteLLMInstance) bool {
+	return llm.Spec.PodDisruptionBudget != nil && llm.Spec.PodDisruptionBudget.Enabled
+}
+
+// createHorizontalPodAutoscaler creates or updates the HorizontalPodAutoscaler for the LiteLLM Deployment.
+// When autoscaling is disabled any previously created HorizontalPodAutoscaler is removed.
+func (r *LiteLLMInstanceReconciler) createHorizontalPodAutoscaler(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) error {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      r.litellmResourceNaming.GetRoleBindingName(),
+			Name:      r.litellmResourceNaming.GetHPAName(),
 			Namespace: llm.Namespace,
 			Labels:    r.litellmResourceNaming.GetAppLabels(),
 		},
-		Subjects: []rbacv1.Subject{
-			{
-				Kind:      "ServiceAccount",
-				Name:      r.litellmResourceNaming.GetServiceAccountName(),
-				Namespace: llm.Namespace,
-			},
-		},
-		RoleRef: rbacv1.RoleRef{
-			APIGroup: "rbac.authorization.k8s.io",
-			Kind:     "Role",
-			Name:     role.Name,
-		},
 	}
 
-	_, _, err = r.createOrUpdateResource(ctx, llm, roleBinding, "RoleBinding")
-	if err != nil {
+	if !autoscalingEnabled(llm) {
+		llm.Status.HPACreated = false
+		return r.deleteIfExists(ctx, hpa)
+	}
+
+	hpa.Spec = buildHPASpec(llm.Spec.Autoscaling, r.litellmResourceNaming.GetDeploymentName())
+
+	if _, _, err := r.createOrUpdateResource(ctx, llm, hpa, "HorizontalPodAutoscaler"); err != nil {
 		return err
 	}
 
+	llm.Status.HPACreated = true
+
 	return nil
 }
 
-// createIngress creates or updates the Ingress for the LiteLLM instance.
-// It creates an Ingress resource to expose the LiteLLM proxy externally when enabled.
-func (r *LiteLLMInstanceReconciler) createIngress(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) error {
-	ingress := &networkingv1.Ingress{
+// buildHPASpec builds the HorizontalPodAutoscaler spec targeting the LiteLLM Deployment.
+// CPU and memory utilisation targets are rendered as resource metrics ahead of any additional metrics.
+func buildHPASpec(autoscaling *litellmv1alpha1.Autoscaling, deploymentName string) autoscalingv2.HorizontalPodAutoscalerSpec {
+	var metrics []autoscalingv2.MetricSpec
+	resourceTargets := []struct {
+		name   corev1.ResourceName
+		target *int32
+	}{
+		{corev1.ResourceCPU, autoscaling.TargetCPUUtilizationPercentage},
+		{corev1.ResourceMemory, autoscaling.TargetMemoryUtilizationPercentage},
+	}
+	for _, rt := range resourceTargets {
+		if rt.target == nil {
+			continue
+		}
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: rt.name,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: rt.target,
+				},
+			},
+		})
+	}
+	metrics = append(metrics, autoscaling.Metrics...)
+
+	return autoscalingv2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       deploymentName,
+		},
+		MinReplicas: autoscaling.MinReplicas,
+		MaxReplicas: autoscaling.MaxReplicas,
+		Metrics:     metrics,
+		Behavior:    autoscaling.Behavior,
+	}
+}
+
+// createPodDisruptionBudget creates or updates the PodDisruptionBudget for the LiteLLM pods.
+// When the PodDisruptionBudget is disabled any previously created one is removed.
+func (r *LiteLLMInstanceReconciler) createPodDisruptionBudget(ctx context.Context, llm *litellmv1alpha1.LiteLLMInstance) error {
+	pdb := &policyv1.PodDisruptionBudget{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      r.litellmResourceNaming.GetIngressName(),
+			Name:      r.litellmResourceNaming.GetPDBName(),
 			Namespace: llm.Namespace,
+			Labels:    r.litellmResourceNaming.GetAppLabels(),
 		},
-		Spec: networkingv1.IngressSpec{
-			Rules: []networkingv1.IngressRule{
-				{
-					Host: llm.Spec.Ingress.Host,
-				},
-			},
+	}
+
+	if !podDisruptionBudgetEnabled(llm) {
+		llm.Status.PDBCreated = false
+		return r.deleteIfExists(ctx, pdb)
+	}
+
+	pdb.Spec = policyv1.PodDisruptionBudgetSpec{
+		Selector: &metav1.LabelSelector{
+			MatchLabels: r.litellmResourceNaming.GetAppLabels(),
 		},
+		MinAvailable:   llm.Spec.PodDisruptionBudget.MinAvailable,
+		MaxUnavailable: llm.Spec.PodDisruptionBudget.MaxUnavailable,
+	}
+	// Default to keeping at least one pod available when no budget is given
+	if pdb.Spec.MinAvailable == nil && pdb.Spec.MaxUnavailable == nil {
+		minAvailable := intstr.FromInt(1)
+		pdb.Spec.MinAvailable = &minAvailable
 	}
 
-	_, _, err := r.createOrUpdateResource(ctx, llm, ingress, "Ingress")
-	if err != nil {
+	if _, _, err := r.createOrUpdateResource(ctx, llm, pdb, "PodDisruptionBudget"); err != nil {
 		return err
 	}
 
-	llm.Status.IngressCreated = true
+	llm.Status.PDBCreated = true
+
+	return nil
+}
 
+// deleteIfExists deletes a child resource that is no longer desired, ignoring resources that do not exist.
+func (r *LiteLLMInstanceReconciler) deleteIfExists(ctx context.Context, obj client.Object) error {
+	if err := r.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete %s: %w", obj.GetName(), err)
+	}
 	return nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 // It registers the controller with the controller-runtime manager and configures the watch.
 func (r *LiteLLMInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	// Index the Secrets feeding the config so that rotating one re-renders the config and rolls the pods
+	if err := common.IndexSecretRefs(mgr, &litellmv1alpha1.LiteLLMInstance{}, func(obj client.Object) []string {
+		llm := obj.(*litellmv1alpha1.LiteLLMInstance)
+		var values []string
+		for _, name := range referencedSecretNames(llm) {
+			values = append(values, common.SecretIndexValue(llm.Namespace, name))
+		}
+		return values
+	}); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&litellmv1alpha1.LiteLLMInstance{}).
 		Named("litellm-litellminstance").
 		Owns(&corev1.ConfigMap{}).
 		Owns(&corev1.Secret{}).
+		Watches(&corev1.Secret{},
+			common.EnqueueForSecret(mgr.GetClient(), func() client.ObjectList { return &litellmv1alpha1.LiteLLMInstanceList{} }),
+			builder.WithPredicates(common.SecretDataChangedPredicate())).
+		Watches(&litellmv1alpha1.RoutingPolicy{},
+			common.EnqueueForRoutingPolicy(),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&litellmv1alpha1.Model{},
+			common.EnqueueForRoutingPolicyModel(mgr.GetClient()),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
 		Owns(&networkingv1.Ingress{}).
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&rbacv1.Role{}).
 		Owns(&rbacv1.RoleBinding{}).
-		Complete(r)
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&appsv1.StatefulSet{}).
+		Owns(&batchv1.Job{})
+
+	// Only watch HTTPRoutes when the Gateway API CRDs are installed, otherwise the manager fails to start
+	if _, err := mgr.GetRESTMapper().RESTMapping(httpRouteGVK.GroupKind(), httpRouteGVK.Version); err == nil {
+		route := &unstructured.Unstructured{}
+		route.SetGroupVersionKind(httpRouteGVK)
+		b = b.Owns(route)
+	}
+
+	// Likewise only watch CloudNativePG Clusters when the CloudNativePG CRDs are installed
+	if _, err := mgr.GetRESTMapper().RESTMapping(cnpgClusterGVK.GroupKind(), cnpgClusterGVK.Version); err == nil {
+		cluster := &unstructured.Unstructured{}
+		cluster.SetGroupVersionKind(cnpgClusterGVK)
+		b = b.Owns(cluster)
+	}
+
+	return b.Complete(r)
 }
 
 // buildContainerSpec builds the container specification for the LiteLLM deployment.
@@ -1321,16 +3659,17 @@ func buildEnvironmentVariables(llm *litellmv1alpha1.LiteLLMInstance, secretName
 	}
 
 	// Add database environment variables if database secret reference is provided
-	if llm.Spec.DatabaseSecretRef.NameRef != "" {
+	dbSecretRef := effectiveDatabaseSecretRef(llm)
+	if dbSecretRef.NameRef != "" {
 		dbEnvVars := []corev1.EnvVar{
 			{
 				Name: "DATABASE_NAME",
 				ValueFrom: &corev1.EnvVarSource{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
-							Name: llm.Spec.DatabaseSecretRef.NameRef,
+							Name: dbSecretRef.NameRef,
 						},
-						Key: llm.Spec.DatabaseSecretRef.Keys.DbnameSecret,
+						Key: dbSecretRef.Keys.DbnameSecret,
 					},
 				},
 			},
@@ -1339,9 +3678,9 @@ func buildEnvironmentVariables(llm *litellmv1alpha1.LiteLLMInstance, secretName
 				ValueFrom: &corev1.EnvVarSource{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
-							Name: llm.Spec.DatabaseSecretRef.NameRef,
+							Name: dbSecretRef.NameRef,
 						},
-						Key: llm.Spec.DatabaseSecretRef.Keys.HostSecret,
+						Key: dbSecretRef.Keys.HostSecret,
 					},
 				},
 			},
@@ -1350,9 +3689,9 @@ func buildEnvironmentVariables(llm *litellmv1alpha1.LiteLLMInstance, secretName
 				ValueFrom: &corev1.EnvVarSource{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
-							Name: llm.Spec.DatabaseSecretRef.NameRef,
+							Name: dbSecretRef.NameRef,
 						},
-						Key: llm.Spec.DatabaseSecretRef.Keys.PasswordSecret,
+						Key: dbSecretRef.Keys.PasswordSecret,
 					},
 				},
 			},
@@ -1361,9 +3700,9 @@ func buildEnvironmentVariables(llm *litellmv1alpha1.LiteLLMInstance, secretName
 				ValueFrom: &corev1.EnvVarSource{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
-							Name: llm.Spec.DatabaseSecretRef.NameRef,
+							Name: dbSecretRef.NameRef,
 						},
-						Key: llm.Spec.DatabaseSecretRef.Keys.UsernameSecret,
+						Key: dbSecretRef.Keys.UsernameSecret,
 					},
 				},
 			},
@@ -1408,12 +3747,86 @@ func buildEnvironmentVariables(llm *litellmv1alpha1.LiteLLMInstance, secretName
 		}
 	}
 
+	envVars = append(envVars, buildRedisEnvironmentVariables(&llm.Spec.RedisSecretRef)...)
+	envVars = append(envVars, buildIntegrationEnvironmentVariables(llm.Spec.Integrations)...)
+	envVars = append(envVars, buildCacheEnvironmentVariables(llm.Spec.Cache)...)
+
 	// Add extra environment variables
 	envVars = append(envVars, llm.Spec.ExtraEnvVars...)
 
 	return envVars
 }
 
+// buildRedisEnvironmentVariables builds the REDIS_* environment variables from the Redis secret.
+// LiteLLM reads these variables when creating its Redis client, which is how TLS, Sentinel
+// and Cluster settings reach the proxy in addition to the rendered router settings.
+func buildRedisEnvironmentVariables(redis *litellmv1alpha1.RedisSecretRef) []corev1.EnvVar {
+	if redis.NameRef == "" {
+		return nil
+	}
+
+	envVars := []corev1.EnvVar{
+		secretKeyEnvVar(RedisHostEnv, redis.NameRef, redis.Keys.HostSecret),
+		secretKeyEnvVar(RedisPortEnv, redis.NameRef, redis.Keys.PortSecret),
+	}
+	if redis.Keys.PasswordSecret != "" {
+		envVars = append(envVars, secretKeyEnvVar(RedisPasswordEnv, redis.NameRef, redis.Keys.PasswordSecret))
+	}
+	if redis.Keys.UsernameSecret != "" {
+		envVars = append(envVars, secretKeyEnvVar(RedisUsernameEnv, redis.NameRef, redis.Keys.UsernameSecret))
+	}
+	if redis.TLS {
+		envVars = append(envVars, corev1.EnvVar{Name: RedisSSLEnv, Value: "True"})
+	}
+
+	switch redis.Mode {
+	case RedisModeCluster:
+		// Invalid nodes are reported when rendering the config
+		if nodes, err := redisClusterNodes(redis); err == nil && len(nodes) > 0 {
+			startupNodes := make([]map[string]string, 0, len(nodes))
+			for _, n := range nodes {
+				startupNodes = append(startupNodes, map[string]string{"host": n.host, "port": strconv.Itoa(n.port)})
+			}
+			b, _ := json.Marshal(startupNodes)
+			envVars = append(envVars, corev1.EnvVar{Name: RedisClusterNodesEnv, Value: string(b)})
+		}
+	case RedisModeSentinel:
+		if redis.Sentinel != nil {
+			sentinelNodes := make([][]interface{}, 0, len(redis.Sentinel.Nodes))
+			for _, node := range redis.Sentinel.Nodes {
+				if n, err := parseRedisNode(node); err == nil {
+					sentinelNodes = append(sentinelNodes, []interface{}{n.host, n.port})
+				}
+			}
+			b, _ := json.Marshal(sentinelNodes)
+			envVars = append(envVars,
+				corev1.EnvVar{Name: RedisSentinelNodesEnv, Value: string(b)},
+				corev1.EnvVar{Name: RedisServiceNameEnv, Value: redis.Sentinel.ServiceName},
+			)
+			if redis.Sentinel.PasswordSecret != "" {
+				envVars = append(envVars, secretKeyEnvVar(RedisSentinelPasswordEnv, redis.NameRef, redis.Sentinel.PasswordSecret))
+			}
+		}
+	}
+
+	return envVars
+}
+
+// secretKeyEnvVar builds an environment variable sourced from a key of a Secret.
+func secretKeyEnvVar(name, secretName, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secretName,
+				},
+				Key: key,
+			},
+		},
+	}
+}
+
 func getAllSecretsByPrefix(ctx context.Context, k8sClient client.Client, namespace string, prefix string) ([]corev1.Secret, error) {
 	var allSecrets corev1.SecretList
 	err := k8sClient.List(ctx, &allSecrets, client.InNamespace(namespace))
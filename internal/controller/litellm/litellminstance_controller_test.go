@@ -18,15 +18,30 @@ package litellm
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
 	util "github.com/bbdsoftware/litellm-operator/internal/util"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -291,6 +306,758 @@ var _ = Describe("LiteLLMInstance helpers and rendering", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("applyPodTemplateOverrides merges overrides without clobbering operator managed fields", func() {
+		selector := map[string]string{"app": "litellm-x"}
+		template := &corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "litellm-x"}},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "litellm", Image: "litellm:latest"}},
+				Volumes:    []corev1.Volume{{Name: "config-volume"}},
+			},
+		}
+		overrides := &litellmv1alpha1.PodTemplate{
+			Annotations:       map[string]string{"example.com/team": "ai"},
+			Labels:            map[string]string{"app": "other", "tier": "proxy"},
+			NodeSelector:      map[string]string{"pool": "cpu"},
+			PriorityClassName: "high",
+			Resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "regcred"}},
+			Sidecars: []corev1.Container{
+				{Name: "litellm", Image: "ignored"},
+				{Name: "log-shipper", Image: "fluent-bit:latest"},
+			},
+			Volumes: []corev1.Volume{{Name: "config-volume"}, {Name: "shared"}},
+		}
+
+		applyPodTemplateOverrides(template, overrides, selector)
+
+		Expect(template.Annotations).To(HaveKeyWithValue("example.com/team", "ai"))
+		Expect(template.Labels).To(HaveKeyWithValue("app", "litellm-x"))
+		Expect(template.Labels).To(HaveKeyWithValue("tier", "proxy"))
+		Expect(template.Spec.NodeSelector).To(HaveKeyWithValue("pool", "cpu"))
+		Expect(template.Spec.PriorityClassName).To(Equal("high"))
+		Expect(template.Spec.ImagePullSecrets).To(HaveLen(1))
+		Expect(template.Spec.Containers).To(HaveLen(2))
+		Expect(template.Spec.Containers[0].Image).To(Equal("litellm:latest"))
+		Expect(template.Spec.Containers[0].Resources.Limits.Memory().String()).To(Equal("1Gi"))
+		Expect(template.Spec.Containers[1].Name).To(Equal("log-shipper"))
+		Expect(template.Spec.Volumes).To(HaveLen(2))
+	})
+
+	It("buildHPASpec renders resource targets ahead of custom metrics", func() {
+		autoscaling := &litellmv1alpha1.Autoscaling{
+			Enabled:                        true,
+			MinReplicas:                    util.Int32Ptr(2),
+			MaxReplicas:                    6,
+			TargetCPUUtilizationPercentage: util.Int32Ptr(75),
+			Metrics: []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.PodsMetricSourceType,
+				Pods: &autoscalingv2.PodsMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{Name: "litellm_in_flight_requests"},
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: resource.NewQuantity(20, resource.DecimalSI)},
+				},
+			}},
+		}
+
+		spec := buildHPASpec(autoscaling, "x-deployment")
+		Expect(spec.ScaleTargetRef.Kind).To(Equal("Deployment"))
+		Expect(spec.ScaleTargetRef.Name).To(Equal("x-deployment"))
+		Expect(*spec.MinReplicas).To(Equal(int32(2)))
+		Expect(spec.MaxReplicas).To(Equal(int32(6)))
+		Expect(spec.Metrics).To(HaveLen(2))
+		Expect(spec.Metrics[0].Resource.Name).To(Equal(corev1.ResourceCPU))
+		Expect(*spec.Metrics[0].Resource.Target.AverageUtilization).To(Equal(int32(75)))
+		Expect(spec.Metrics[1].Type).To(Equal(autoscalingv2.PodsMetricSourceType))
+	})
+
+	It("buildIngressSpec routes paths to the instance service and configures TLS", func() {
+		spec := &litellmv1alpha1.Ingress{
+			Enabled:   true,
+			Host:      "api.example.com",
+			ClassName: "nginx",
+			Paths:     []litellmv1alpha1.IngressPath{{Path: "/v1"}},
+			TLS: &litellmv1alpha1.IngressTLS{
+				CertManagerIssuer: &litellmv1alpha1.CertManagerIssuerRef{Name: "letsencrypt"},
+			},
+		}
+
+		ingressSpec := buildIngressSpec(spec, "x-service", "x-ingress")
+		Expect(*ingressSpec.IngressClassName).To(Equal("nginx"))
+		Expect(ingressSpec.Rules).To(HaveLen(1))
+		Expect(ingressSpec.Rules[0].Host).To(Equal("api.example.com"))
+		paths := ingressSpec.Rules[0].HTTP.Paths
+		Expect(paths).To(HaveLen(1))
+		Expect(paths[0].Path).To(Equal("/v1"))
+		Expect(*paths[0].PathType).To(Equal(networkingv1.PathTypePrefix))
+		Expect(paths[0].Backend.Service.Name).To(Equal("x-service"))
+		Expect(paths[0].Backend.Service.Port.Number).To(Equal(int32(ServicePort)))
+		Expect(ingressSpec.TLS).To(HaveLen(1))
+		Expect(ingressSpec.TLS[0].SecretName).To(Equal("x-ingress-tls"))
+		Expect(ingressSpec.TLS[0].Hosts).To(ConsistOf("api.example.com"))
+
+		annotations := buildIngressAnnotations(spec)
+		Expect(annotations).To(HaveKeyWithValue(CertManagerClusterIssuerAnnotation, "letsencrypt"))
+	})
+
+	It("buildHTTPRouteSpec attaches to the parent gateway and routes to the instance service", func() {
+		gw := &litellmv1alpha1.Gateway{
+			Enabled:   true,
+			Host:      "llm.example.com",
+			Hostnames: []string{"llm.example.com", "ai.example.com"},
+			ParentRef: litellmv1alpha1.GatewayParentRef{Name: "public", Namespace: "gateways", SectionName: "https"},
+			Timeouts:  &litellmv1alpha1.HTTPRouteTimeouts{Request: "300s"},
+			RequestHeaders: &litellmv1alpha1.HTTPHeaderModifier{
+				Set: []litellmv1alpha1.HTTPHeader{{Name: "X-Forwarded-Proto", Value: "https"}},
+			},
+		}
+
+		route := &unstructured.Unstructured{}
+		route.SetGroupVersionKind(httpRouteGVK)
+		route.Object["spec"] = buildHTTPRouteSpec(gw, "default", "x-service", "", 0)
+		// the object must survive a JSON deep copy as done by the client
+		route = route.DeepCopy()
+
+		hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+		Expect(hostnames).To(Equal([]string{"llm.example.com", "ai.example.com"}))
+
+		parents, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+		Expect(parents).To(HaveLen(1))
+		parent := parents[0].(map[string]interface{})
+		Expect(parent["name"]).To(Equal("public"))
+		Expect(parent["namespace"]).To(Equal("gateways"))
+		Expect(parent["sectionName"]).To(Equal("https"))
+
+		rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+		Expect(rules).To(HaveLen(1))
+		rule := rules[0].(map[string]interface{})
+		backend := rule["backendRefs"].([]interface{})[0].(map[string]interface{})
+		Expect(backend["name"]).To(Equal("x-service"))
+		Expect(backend["port"]).To(Equal(int64(ServicePort)))
+		path, _, _ := unstructured.NestedString(rule["matches"].([]interface{})[0].(map[string]interface{}), "path", "value")
+		Expect(path).To(Equal("/"))
+		request, _, _ := unstructured.NestedString(rule, "timeouts", "request")
+		Expect(request).To(Equal("300s"))
+		Expect(rule["filters"]).To(HaveLen(1))
+	})
+
+	It("buildHTTPRouteSpec splits the traffic with a canary service by weight", func() {
+		gw := &litellmv1alpha1.Gateway{Enabled: true, ParentRef: litellmv1alpha1.GatewayParentRef{Name: "public"}}
+
+		route := &unstructured.Unstructured{}
+		route.SetGroupVersionKind(httpRouteGVK)
+		route.Object["spec"] = buildHTTPRouteSpec(gw, "default", "x-service", "x-canary-service", 20)
+		route = route.DeepCopy()
+
+		rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+		backends := rules[0].(map[string]interface{})["backendRefs"].([]interface{})
+		Expect(backends).To(HaveLen(2))
+		Expect(backends[0].(map[string]interface{})["weight"]).To(Equal(int64(80)))
+		Expect(backends[1].(map[string]interface{})["name"]).To(Equal("x-canary-service"))
+		Expect(backends[1].(map[string]interface{})["weight"]).To(Equal(int64(20)))
+	})
+
+	It("httpRouteAccepted reads the Accepted condition from the route parents", func() {
+		route := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		accepted, _, found := httpRouteAccepted(route)
+		Expect(accepted).To(BeFalse())
+		Expect(found).To(BeFalse())
+
+		Expect(unstructured.SetNestedSlice(route.Object, []interface{}{
+			map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Accepted", "status": "False", "message": "no matching listener"},
+				},
+			},
+		}, "status", "parents")).To(Succeed())
+		accepted, message, found := httpRouteAccepted(route)
+		Expect(accepted).To(BeFalse())
+		Expect(found).To(BeTrue())
+		Expect(message).To(Equal("no matching listener"))
+
+		Expect(unstructured.SetNestedSlice(route.Object, []interface{}{
+			map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Accepted", "status": "True"},
+				},
+			},
+		}, "status", "parents")).To(Succeed())
+		accepted, _, _ = httpRouteAccepted(route)
+		Expect(accepted).To(BeTrue())
+	})
+
+	It("buildRedisEnvironmentVariables injects REDIS_* variables from the redis secret", func() {
+		redis := &litellmv1alpha1.RedisSecretRef{
+			NameRef: "redis-secret",
+			Keys:    litellmv1alpha1.RedisSecretKeys{HostSecret: "host", PortSecret: "port", PasswordSecret: "password"},
+			TLS:     true,
+			Mode:    RedisModeSentinel,
+			Sentinel: &litellmv1alpha1.RedisSentinel{
+				Nodes:          []string{"sentinel-0:26379", "sentinel-1:26379"},
+				ServiceName:    "mymaster",
+				PasswordSecret: "sentinel-password",
+			},
+		}
+
+		envVars := buildRedisEnvironmentVariables(redis)
+		byName := map[string]corev1.EnvVar{}
+		for _, e := range envVars {
+			byName[e.Name] = e
+		}
+		Expect(byName[RedisHostEnv].ValueFrom.SecretKeyRef.Name).To(Equal("redis-secret"))
+		Expect(byName[RedisHostEnv].ValueFrom.SecretKeyRef.Key).To(Equal("host"))
+		Expect(byName[RedisPortEnv].ValueFrom.SecretKeyRef.Key).To(Equal("port"))
+		Expect(byName[RedisPasswordEnv].ValueFrom.SecretKeyRef.Key).To(Equal("password"))
+		Expect(byName[RedisSSLEnv].Value).To(Equal("True"))
+		Expect(byName[RedisSentinelNodesEnv].Value).To(Equal(`[["sentinel-0",26379],["sentinel-1",26379]]`))
+		Expect(byName[RedisServiceNameEnv].Value).To(Equal("mymaster"))
+		Expect(byName[RedisSentinelPasswordEnv].ValueFrom.SecretKeyRef.Key).To(Equal("sentinel-password"))
+		Expect(byName).NotTo(HaveKey(RedisUsernameEnv))
+	})
+
+	It("buildRedisRouterSettings renders cluster startup nodes and rejects invalid nodes", func() {
+		redis := &litellmv1alpha1.RedisSecretRef{
+			NameRef:      "redis-secret",
+			Keys:         litellmv1alpha1.RedisSecretKeys{HostSecret: "host", PortSecret: "port"},
+			Mode:         RedisModeCluster,
+			ClusterNodes: []string{"redis-0:6379"},
+		}
+
+		settings, err := buildRedisRouterSettings(redis)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings.RedisHost).To(Equal("os.environ/REDIS_HOST"))
+		Expect(settings.RedisPassword).To(BeEmpty())
+		Expect(settings.CacheKwargs["startup_nodes"]).To(Equal([]interface{}{map[string]interface{}{"host": "redis-0", "port": 6379}}))
+
+		redis.ClusterNodes = []string{"redis-0"}
+		_, err = buildRedisRouterSettings(redis)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("effectiveDatabaseSecretRef points at the generated credentials when the database is managed", func() {
+		llm := &litellmv1alpha1.LiteLLMInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "default"},
+			Spec: litellmv1alpha1.LiteLLMInstanceSpec{
+				DatabaseSecretRef: litellmv1alpha1.DatabaseSecretRef{NameRef: "external-db"},
+			},
+		}
+		Expect(effectiveDatabaseSecretRef(llm).NameRef).To(Equal("external-db"))
+
+		llm.Spec.DatabaseSecretRef = litellmv1alpha1.DatabaseSecretRef{}
+		llm.Spec.Database = &litellmv1alpha1.Database{Managed: &litellmv1alpha1.ManagedDatabase{Enabled: true}}
+		ref := effectiveDatabaseSecretRef(llm)
+		Expect(ref.NameRef).To(Equal("x-postgres-credentials"))
+		Expect(ref.Keys.HostSecret).To(Equal("host"))
+		Expect(ref.Keys.DbnameSecret).To(Equal("dbname"))
+	})
+
+	It("buildDatabaseSecretData generates a password once and preserves it", func() {
+		managed := &litellmv1alpha1.ManagedDatabase{Enabled: true}
+		data := buildDatabaseSecretData(managed, "x-postgres", nil)
+		Expect(string(data[corev1.BasicAuthUsernameKey])).To(Equal("litellm"))
+		Expect(string(data["dbname"])).To(Equal("litellm"))
+		Expect(string(data["host"])).To(Equal("x-postgres"))
+		Expect(string(data["port"])).To(Equal("5432"))
+		Expect(data[corev1.BasicAuthPasswordKey]).NotTo(BeEmpty())
+
+		existing := &corev1.Secret{Data: data}
+		again := buildDatabaseSecretData(managed, "x-postgres", existing)
+		Expect(again[corev1.BasicAuthPasswordKey]).To(Equal(data[corev1.BasicAuthPasswordKey]))
+	})
+
+	It("buildDatabaseStatefulSetSpec wires the generated credentials and a persistent volume", func() {
+		managed := &litellmv1alpha1.ManagedDatabase{Enabled: true, StorageSize: resource.MustParse("5Gi")}
+		spec := buildDatabaseStatefulSetSpec(managed, util.NewLitellmResourceNaming("x"))
+		Expect(spec.ServiceName).To(Equal("x-postgres"))
+		Expect(spec.Template.Spec.Containers[0].Image).To(Equal(DefaultPostgresImage))
+		Expect(spec.Template.Spec.Containers[0].Env[1].ValueFrom.SecretKeyRef.Name).To(Equal("x-postgres-credentials"))
+		Expect(spec.VolumeClaimTemplates).To(HaveLen(1))
+		Expect(spec.VolumeClaimTemplates[0].Spec.Resources.Requests.Storage().String()).To(Equal("5Gi"))
+	})
+
+	It("buildCloudNativePGClusterSpec bootstraps the database from the credentials secret", func() {
+		managed := &litellmv1alpha1.ManagedDatabase{Enabled: true, Instances: 3, DatabaseName: "proxy"}
+		cluster := &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": buildCloudNativePGClusterSpec(managed, "x-postgres-credentials"),
+		}}
+		cluster = cluster.DeepCopy()
+		instances, _, _ := unstructured.NestedInt64(cluster.Object, "spec", "instances")
+		Expect(instances).To(Equal(int64(3)))
+		size, _, _ := unstructured.NestedString(cluster.Object, "spec", "storage", "size")
+		Expect(size).To(Equal("10Gi"))
+		database, _, _ := unstructured.NestedString(cluster.Object, "spec", "bootstrap", "initdb", "database")
+		Expect(database).To(Equal("proxy"))
+		secret, _, _ := unstructured.NestedString(cluster.Object, "spec", "bootstrap", "initdb", "secret", "name")
+		Expect(secret).To(Equal("x-postgres-credentials"))
+	})
+
+	It("deploymentImage keeps the current image until the new image has been migrated", func() {
+		llm := &litellmv1alpha1.LiteLLMInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "default"},
+			Spec: litellmv1alpha1.LiteLLMInstanceSpec{
+				Image:             "litellm:v2",
+				DatabaseSecretRef: litellmv1alpha1.DatabaseSecretRef{NameRef: "db"},
+			},
+			Status: litellmv1alpha1.LiteLLMInstanceStatus{CurrentImage: "litellm:v1", MigratedImage: "litellm:v1"},
+		}
+		// without migrations the new image is rolled out straight away
+		Expect(deploymentImage(llm)).To(Equal("litellm:v2"))
+
+		llm.Spec.Migrations = &litellmv1alpha1.Migrations{Enabled: true}
+		Expect(deploymentImage(llm)).To(Equal("litellm:v1"))
+
+		llm.Status.MigratedImage = "litellm:v2"
+		Expect(deploymentImage(llm)).To(Equal("litellm:v2"))
+
+		// a fresh instance waits for the first migration
+		llm.Status = litellmv1alpha1.LiteLLMInstanceStatus{}
+		Expect(deploymentImage(llm)).To(BeEmpty())
+	})
+
+	It("deploymentImage keeps the current image until a canary upgrade is promoted", func() {
+		llm := &litellmv1alpha1.LiteLLMInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "default"},
+			Spec: litellmv1alpha1.LiteLLMInstanceSpec{
+				Image:           "litellm:v2",
+				UpgradeStrategy: &litellmv1alpha1.UpgradeStrategy{Type: UpgradeStrategyCanary},
+			},
+			Status: litellmv1alpha1.LiteLLMInstanceStatus{CurrentImage: "litellm:v1"},
+		}
+		Expect(deploymentImage(llm)).To(Equal("litellm:v1"))
+
+		llm.Status.Upgrade = &litellmv1alpha1.UpgradeStatus{Strategy: UpgradeStrategyCanary, TargetImage: "litellm:v2", Phase: UpgradePhaseProgressing}
+		Expect(deploymentImage(llm)).To(Equal("litellm:v1"))
+
+		llm.Status.Upgrade.Phase = UpgradePhasePromoting
+		Expect(deploymentImage(llm)).To(Equal("litellm:v2"))
+
+		// a rolled back image is not rolled out again
+		llm.Status.Upgrade.Phase = UpgradePhaseRolledBack
+		Expect(deploymentImage(llm)).To(Equal("litellm:v1"))
+
+		// a fresh instance starts with the desired image
+		llm.Status = litellmv1alpha1.LiteLLMInstanceStatus{}
+		Expect(deploymentImage(llm)).To(Equal("litellm:v2"))
+	})
+
+	It("upgradeLabels keeps the pods of the new image out of the instance selectors", func() {
+		naming := util.NewLitellmResourceNaming("x")
+
+		Expect(upgradeLabels(naming, UpgradeStrategyCanary)).To(Equal(map[string]string{"app": "litellm-x-canary", UpgradeTrackLabel: "canary"}))
+		Expect(upgradeLabels(naming, UpgradeStrategyBlueGreen)).To(Equal(map[string]string{"app": "litellm-x-preview", UpgradeTrackLabel: "preview"}))
+	})
+
+	It("serviceSelector switches to the preview pods while a blue/green upgrade is promoted", func() {
+		naming := util.NewLitellmResourceNaming("x")
+		llm := &litellmv1alpha1.LiteLLMInstance{
+			Spec: litellmv1alpha1.LiteLLMInstanceSpec{UpgradeStrategy: &litellmv1alpha1.UpgradeStrategy{Type: UpgradeStrategyBlueGreen}},
+			Status: litellmv1alpha1.LiteLLMInstanceStatus{
+				Upgrade: &litellmv1alpha1.UpgradeStatus{Strategy: UpgradeStrategyBlueGreen, Phase: UpgradePhaseProgressing},
+			},
+		}
+		Expect(serviceSelector(llm, naming)).To(Equal(naming.GetAppLabels()))
+
+		llm.Status.Upgrade.Phase = UpgradePhasePromoting
+		Expect(serviceSelector(llm, naming)).To(HaveKeyWithValue("app", "litellm-x-preview"))
+
+		llm.Status.Upgrade.Phase = UpgradePhaseSucceeded
+		Expect(serviceSelector(llm, naming)).To(Equal(naming.GetAppLabels()))
+	})
+
+	It("deploymentRolledOut requires every replica to run the image and be available", func() {
+		deployment := &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Replicas: util.Int32Ptr(2),
+				Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Image: "litellm:v2"}}}},
+			},
+			Status: appsv1.DeploymentStatus{UpdatedReplicas: 2, ReadyReplicas: 1, AvailableReplicas: 1},
+		}
+		Expect(deploymentRolledOut(deployment, "litellm:v2")).To(BeFalse())
+
+		deployment.Status.ReadyReplicas = 2
+		deployment.Status.AvailableReplicas = 2
+		Expect(deploymentRolledOut(deployment, "litellm:v2")).To(BeTrue())
+		Expect(deploymentRolledOut(deployment, "litellm:v1")).To(BeFalse())
+	})
+
+	It("proxyHealthError fails when the proxy reports unhealthy model deployments", func() {
+		Expect(proxyHealthError([]byte(`{"healthy_count": 2, "unhealthy_count": 0}`))).To(Succeed())
+		Expect(proxyHealthError([]byte(`{"healthy_count": 1, "unhealthy_count": 1}`))).To(MatchError(ContainSubstring("1 of 2")))
+		Expect(proxyHealthError([]byte(`{"status": "healthy"}`))).To(Succeed())
+	})
+
+	It("checkUpgradeHealth calls the readiness endpoint of the new image's Service", func() {
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		llm := &litellmv1alpha1.LiteLLMInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "litellm"},
+			Spec:       litellmv1alpha1.LiteLLMInstanceSpec{UpgradeStrategy: &litellmv1alpha1.UpgradeStrategy{Type: UpgradeStrategyCanary}},
+		}
+		naming := util.NewLitellmResourceNaming("x")
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: naming.GetSecretName(), Namespace: "litellm"},
+			Data:       map[string][]byte{"masterkey": []byte("sk-master")},
+		}
+		var checkedURL string
+		r := &LiteLLMInstanceReconciler{
+			BaseController: &base.BaseController[*litellmv1alpha1.LiteLLMInstance]{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build(),
+			},
+			litellmResourceNaming: naming,
+			healthCheck: func(ctx context.Context, url, masterKey string, timeout time.Duration) error {
+				checkedURL = url
+				return nil
+			},
+		}
+
+		Expect(r.checkUpgradeHealth(context.Background(), llm, UpgradeStrategyCanary)).To(Succeed())
+		Expect(checkedURL).To(Equal(fmt.Sprintf("http://%s.litellm.svc:%d/health/readiness", naming.GetUpgradeServiceName(util.CanarySuffix), ServicePort)))
+	})
+
+	It("jobSucceeded and jobFailed read the job conditions", func() {
+		job := &batchv1.Job{}
+		Expect(jobSucceeded(job)).To(BeFalse())
+		Expect(jobFailed(job)).To(BeFalse())
+
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+		Expect(jobSucceeded(job)).To(BeFalse())
+		Expect(jobFailed(job)).To(BeTrue())
+
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+		Expect(jobSucceeded(job)).To(BeTrue())
+	})
+
+	It("buildMigrationJob labels the migration pods apart from the LiteLLM pods", func() {
+		naming := util.NewLitellmResourceNaming("x")
+		llm := &litellmv1alpha1.LiteLLMInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "default"},
+			Spec: litellmv1alpha1.LiteLLMInstanceSpec{
+				Image:      "litellm:v2",
+				Migrations: &litellmv1alpha1.Migrations{Enabled: true},
+			},
+		}
+		job := buildMigrationJob(llm, "x-migrate", naming, context.Background(), fake.NewClientBuilder().Build())
+
+		// the Job is found by the application labels for clean-up
+		Expect(job.Labels).To(HaveKeyWithValue("app", "litellm-x"))
+		Expect(job.Labels).To(HaveKeyWithValue(MigrationLabel, "true"))
+
+		// no selector of the instance matches the migration pods
+		podLabels := labels.Set(job.Spec.Template.Labels)
+		Expect(podLabels).To(HaveKeyWithValue("app.kubernetes.io/component", "migration"))
+		Expect(labels.SelectorFromSet(naming.GetAppLabels()).Matches(podLabels)).To(BeFalse())
+	})
+
+	It("CreateOrUpdateWithRetry updates a Deployment on container and volume drift but not on server defaults", func() {
+		scheme := runtime.NewScheme()
+		Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+		Expect(litellmv1alpha1.AddToScheme(scheme)).To(Succeed())
+		llm := &litellmv1alpha1.LiteLLMInstance{ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "default", UID: "uid"}}
+
+		desired := func() *appsv1.Deployment {
+			return &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "x-deployment", Namespace: "default"},
+				Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:         "litellm",
+						Image:        "litellm:v1",
+						Env:          []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "INFO"}},
+						Ports:        []corev1.ContainerPort{{ContainerPort: 4000}},
+						VolumeMounts: []corev1.VolumeMount{{Name: "config-volume", MountPath: "/app"}},
+						ReadinessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+							HTTPGet: &corev1.HTTPGetAction{Path: "/health", Port: intstr.FromInt32(4000)},
+						}},
+					}},
+					Volumes: []corev1.Volume{{Name: "config-volume", VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{SecretName: "x-config"},
+					}}},
+				}}},
+			}
+		}
+
+		// the stored Deployment carries the defaults set by the API server
+		existing := desired()
+		container := &existing.Spec.Template.Spec.Containers[0]
+		container.TerminationMessagePath = corev1.TerminationMessagePathDefault
+		container.TerminationMessagePolicy = corev1.TerminationMessageReadFile
+		container.ImagePullPolicy = corev1.PullIfNotPresent
+		container.Ports[0].Protocol = corev1.ProtocolTCP
+		container.ReadinessProbe.TimeoutSeconds = 1
+		container.ReadinessProbe.PeriodSeconds = 10
+		container.ReadinessProbe.SuccessThreshold = 1
+		container.ReadinessProbe.FailureThreshold = 3
+		container.ReadinessProbe.HTTPGet.Scheme = corev1.URISchemeHTTP
+		existing.Spec.Template.Spec.Volumes[0].Secret.DefaultMode = ptr.To(corev1.SecretVolumeSourceDefaultMode)
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+		ctx := context.Background()
+
+		updated, err := util.CreateOrUpdateWithRetry(ctx, c, scheme, desired(), llm)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).To(BeFalse())
+
+		changed := desired()
+		changed.Spec.Template.Spec.Containers[0].Env[0].Value = "DEBUG"
+		updated, err = util.CreateOrUpdateWithRetry(ctx, c, scheme, changed, llm)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).To(BeTrue())
+
+		changed = desired()
+		changed.Spec.Template.Spec.Containers[0].Env[0].Value = "DEBUG"
+		changed.Spec.Template.Spec.Volumes[0].Secret.SecretName = "x-other-config"
+		updated, err = util.CreateOrUpdateWithRetry(ctx, c, scheme, changed, llm)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).To(BeTrue())
+	})
+
+	It("buildGeneralSettings keeps the database defaults unless they are overridden", func() {
+		general := buildGeneralSettings(nil)
+		Expect(general.AllowRequestsOnDBUnavailable).To(BeTrue())
+		Expect(general.StoreModelInDB).To(BeTrue())
+
+		disabled := false
+		parallel := 50
+		general = buildGeneralSettings(&litellmv1alpha1.GeneralSettings{
+			AllowRequestsOnDBUnavailable: &disabled,
+			MaxParallelRequests:          &parallel,
+			Alerting:                     []string{"slack"},
+		})
+		Expect(general.AllowRequestsOnDBUnavailable).To(BeFalse())
+		Expect(general.StoreModelInDB).To(BeTrue())
+		Expect(general.MaxParallelRequests).To(Equal(50))
+		Expect(general.Alerting).To(Equal([]string{"slack"}))
+	})
+
+	It("buildLitellmSettings renders callbacks and the cache params", func() {
+		cache := true
+		settings, err := buildLitellmSettings(&litellmv1alpha1.LitellmSettings{
+			SuccessCallback: []string{"langfuse"},
+			Cache:           &cache,
+			CacheParams:     &runtime.RawExtension{Raw: []byte(`{"type": "redis", "ttl": 600}`)},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings.SuccessCallback).To(Equal([]string{"langfuse"}))
+		Expect(settings.Cache).To(BeTrue())
+		Expect(settings.CacheParams).To(HaveKeyWithValue("type", "redis"))
+
+		_, err = buildLitellmSettings(&litellmv1alpha1.LitellmSettings{CacheParams: &runtime.RawExtension{Raw: []byte(`[`)}})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("applyRouterSettings renders the routing strategy and fallbacks next to the redis settings", func() {
+		retries, allowedFails := 3, 0
+		routerSettings := RouterSettingsYAML{RedisHost: "os.environ/REDIS_HOST"}
+		applyRouterSettings(&routerSettings, &litellmv1alpha1.RouterSettings{
+			RoutingStrategy:        "least-busy",
+			NumRetries:             &retries,
+			AllowedFails:           &allowedFails,
+			Fallbacks:              []litellmv1alpha1.ModelFallback{{Model: "gpt-4o", Fallbacks: []string{"claude-sonnet", "gpt-4o-mini"}}},
+			ContextWindowFallbacks: []litellmv1alpha1.ModelFallback{{Model: "gpt-4o-mini", Fallbacks: []string{"gpt-4o"}}},
+		})
+
+		out, err := yaml.Marshal(routerSettings)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("routing_strategy: least-busy"))
+		Expect(string(out)).To(ContainSubstring("num_retries: 3"))
+		Expect(string(out)).To(ContainSubstring("allowed_fails: 0"))
+		Expect(string(out)).NotTo(ContainSubstring("cooldown_time"))
+		Expect(string(out)).To(ContainSubstring("- gpt-4o:\n  - claude-sonnet\n  - gpt-4o-mini"))
+		Expect(string(out)).To(ContainSubstring("context_window_fallbacks:"))
+		Expect(string(out)).To(ContainSubstring("redis_host: os.environ/REDIS_HOST"))
+	})
+
+	It("applyRoutingPolicy adds the policy fallbacks by model name and overrides the retry settings", func() {
+		retries, cooldown, numRetries, allowedFails := 1, 60, 3, 0
+		routerSettings := RouterSettingsYAML{
+			NumRetries: &numRetries,
+			Fallbacks:  []map[string][]string{{"gpt-4o-mini": {"gpt-4o"}}},
+		}
+		applyRoutingPolicy(&routerSettings, &litellmv1alpha1.RoutingPolicy{Spec: litellmv1alpha1.RoutingPolicySpec{
+			Fallbacks:              []litellmv1alpha1.RoutingFallback{{ModelRef: "gpt-4o", FallbackRefs: []string{"claude-sonnet", "gemini"}}},
+			ContextWindowFallbacks: []litellmv1alpha1.RoutingFallback{{ModelRef: "claude-sonnet", FallbackRefs: []string{"gemini"}}},
+			DefaultFallbackRefs:    []string{"gemini"},
+			AllowedFails:           &allowedFails,
+			CooldownTime:           &cooldown,
+			RetryPolicy:            &litellmv1alpha1.RetryPolicy{TimeoutErrorRetries: &retries},
+		}}, map[string]string{"gpt-4o": "gpt-4o-[crd]", "claude-sonnet": "claude-sonnet-[crd]", "gemini": "gemini-pro-[crd]"})
+
+		Expect(routerSettings.Fallbacks).To(Equal([]map[string][]string{
+			{"gpt-4o-mini": {"gpt-4o"}},
+			{"gpt-4o-[crd]": {"claude-sonnet-[crd]", "gemini-pro-[crd]"}},
+		}))
+		Expect(routerSettings.ContextWindowFallbacks).To(Equal([]map[string][]string{{"claude-sonnet-[crd]": {"gemini-pro-[crd]"}}}))
+		Expect(routerSettings.DefaultFallbacks).To(Equal([]string{"gemini-pro-[crd]"}))
+		Expect(routerSettings.NumRetries).To(HaveValue(Equal(3)))
+		Expect(routerSettings.AllowedFails).To(HaveValue(Equal(0)))
+		Expect(routerSettings.CooldownTime).To(HaveValue(Equal(60)))
+		Expect(routerSettings.RetryPolicy).To(Equal(map[string]int{"TimeoutErrorRetries": 1}))
+	})
+
+	It("applyIntegrations adds the integration callbacks and renders the s3 callback params", func() {
+		settings := LitellmSettingsYAML{SuccessCallback: []string{"langfuse"}}
+		applyIntegrations(&settings, []litellmv1alpha1.LoggingIntegration{
+			{Type: IntegrationLangfuse},
+			{Type: IntegrationGCS, Events: IntegrationEventsFailure},
+			{Type: IntegrationS3, Events: IntegrationEventsSuccess, S3: &litellmv1alpha1.S3Integration{
+				BucketName:           "litellm-logs",
+				Region:               "eu-west-1",
+				AccessKeyIDSecretRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "s3"}, Key: "id"},
+			}},
+		})
+
+		Expect(settings.SuccessCallback).To(Equal([]string{"langfuse", "s3"}))
+		Expect(settings.FailureCallback).To(Equal([]string{"langfuse", "gcs_bucket"}))
+		Expect(settings.S3CallbackParams).To(Equal(map[string]string{
+			"s3_bucket_name":       "litellm-logs",
+			"s3_region_name":       "eu-west-1",
+			"s3_aws_access_key_id": "os.environ/" + S3AccessKeyIDEnv,
+		}))
+	})
+
+	It("buildIntegrationEnvironmentVariables and buildIntegrationVolumes wire the integration secrets", func() {
+		integrations := []litellmv1alpha1.LoggingIntegration{
+			{Type: IntegrationLangfuse, Langfuse: &litellmv1alpha1.LangfuseIntegration{
+				PublicKeySecretRef: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "langfuse"}, Key: "public"},
+				SecretKeySecretRef: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "langfuse"}, Key: "secret"},
+			}},
+			{Type: IntegrationGCS, GCS: &litellmv1alpha1.GCSIntegration{
+				BucketName:              "litellm-logs",
+				ServiceAccountSecretRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "gcs"}, Key: "key.json"},
+			}},
+		}
+
+		envVars := buildIntegrationEnvironmentVariables(integrations)
+		Expect(envVars).To(HaveLen(4))
+		Expect(envVars[0].Name).To(Equal(LangfusePublicKeyEnv))
+		Expect(envVars[0].ValueFrom.SecretKeyRef.Key).To(Equal("public"))
+		Expect(envVars[2]).To(Equal(corev1.EnvVar{Name: GCSBucketNameEnv, Value: "litellm-logs"}))
+		Expect(envVars[3]).To(Equal(corev1.EnvVar{Name: GCSPathServiceAccountEnv, Value: "/etc/litellm-gcs/service_account.json"}))
+
+		volumes, mounts := buildIntegrationVolumes(integrations)
+		Expect(volumes).To(HaveLen(1))
+		Expect(volumes[0].Secret.SecretName).To(Equal("gcs"))
+		Expect(volumes[0].Secret.Items).To(Equal([]corev1.KeyToPath{{Key: "key.json", Path: GCSServiceAccountFile}}))
+		Expect(mounts).To(Equal([]corev1.VolumeMount{{Name: GCSServiceAccountVolume, MountPath: GCSServiceAccountDir, ReadOnly: true}}))
+
+		Expect(integrationSecretNames(integrations)).To(Equal([]string{"langfuse", "langfuse", "gcs"}))
+	})
+
+	It("applyCache renders the redis semantic cache over the redis secret and keeps the explicit cache params", func() {
+		ttl := 600
+		redis := &litellmv1alpha1.RedisSecretRef{NameRef: "redis", Keys: litellmv1alpha1.RedisSecretKeys{PasswordSecret: "password"}}
+		settings := LitellmSettingsYAML{CacheParams: map[string]interface{}{"namespace": "override"}}
+		Expect(applyCache(&settings, &litellmv1alpha1.Cache{
+			Type:                CacheTypeRedisSemantic,
+			TTL:                 &ttl,
+			Namespace:           "litellm",
+			SupportedCallTypes:  []string{"acompletion"},
+			SimilarityThreshold: "0.8",
+		}, redis, "text-embedding-3-small-[crd]")).To(Succeed())
+
+		Expect(settings.Cache).To(BeTrue())
+		Expect(settings.CacheParams).To(Equal(map[string]interface{}{
+			"type":                                 CacheTypeRedisSemantic,
+			"ttl":                                  600,
+			"namespace":                            "override",
+			"supported_call_types":                 []string{"acompletion"},
+			"similarity_threshold":                 0.8,
+			"host":                                 "os.environ/" + RedisHostEnv,
+			"port":                                 "os.environ/" + RedisPortEnv,
+			"password":                             "os.environ/" + RedisPasswordEnv,
+			"redis_semantic_cache_embedding_model": "text-embedding-3-small-[crd]",
+		}))
+
+		Expect(applyCache(&LitellmSettingsYAML{}, &litellmv1alpha1.Cache{Type: CacheTypeRedis}, &litellmv1alpha1.RedisSecretRef{}, "")).NotTo(Succeed())
+	})
+
+	It("buildCacheParams and buildCacheEnvironmentVariables wire the qdrant and s3 credentials", func() {
+		qdrant := &litellmv1alpha1.Cache{
+			Type:                CacheTypeQdrantSemantic,
+			SimilarityThreshold: "1",
+			Qdrant: &litellmv1alpha1.QdrantCache{
+				APIBase:         "http://qdrant:6333",
+				CollectionName:  "litellm",
+				APIKeySecretRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "qdrant"}, Key: "api-key"},
+			},
+		}
+		params, err := buildCacheParams(qdrant, &litellmv1alpha1.RedisSecretRef{}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(params).To(HaveKeyWithValue("qdrant_api_key", "os.environ/"+QdrantAPIKeyEnv))
+		Expect(params).To(HaveKeyWithValue("qdrant_quantization_config", "binary"))
+		Expect(params).NotTo(HaveKey("qdrant_semantic_cache_embedding_model"))
+
+		s3 := &litellmv1alpha1.Cache{Type: CacheTypeS3, S3: &litellmv1alpha1.S3Cache{
+			BucketName:               "litellm-cache",
+			SecretAccessKeySecretRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "s3"}, Key: "secret"},
+		}}
+		params, err = buildCacheParams(s3, &litellmv1alpha1.RedisSecretRef{}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(params).To(Equal(map[string]interface{}{
+			"type":                     CacheTypeS3,
+			"s3_bucket_name":           "litellm-cache",
+			"s3_aws_secret_access_key": "os.environ/" + S3CacheSecretAccessKeyEnv,
+		}))
+
+		envVars := buildCacheEnvironmentVariables(s3)
+		Expect(envVars).To(HaveLen(1))
+		Expect(envVars[0].Name).To(Equal(S3CacheSecretAccessKeyEnv))
+		Expect(envVars[0].ValueFrom.SecretKeyRef.Name).To(Equal("s3"))
+		Expect(cacheSecretNames(qdrant)).To(Equal([]string{"qdrant"}))
+	})
+
+	It("mergeConfigOverrides deep-merges maps and replaces other values", func() {
+		rendered := []byte("general_settings:\n  store_model_in_db: true\n  alerting:\n  - slack\nmodel_list: []\n")
+		merged, err := mergeConfigOverrides(rendered, "general_settings:\n  alerting:\n  - email\n  master_key: os.environ/PROXY_MASTER_KEY\nenvironment_variables:\n  FOO: bar\n")
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := map[string]interface{}{}
+		Expect(yaml.Unmarshal([]byte(merged), &cfg)).To(Succeed())
+		general := cfg["general_settings"].(map[interface{}]interface{})
+		Expect(general["store_model_in_db"]).To(BeTrue())
+		Expect(general["alerting"]).To(Equal([]interface{}{"email"}))
+		Expect(general["master_key"]).To(Equal("os.environ/PROXY_MASTER_KEY"))
+		Expect(cfg).To(HaveKey("environment_variables"))
+		Expect(cfg).To(HaveKey("model_list"))
+
+		_, err = mergeConfigOverrides(rendered, "- not a map")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("referencedSecretNames lists the secrets consumed by the pods once", func() {
+		llm := &litellmv1alpha1.LiteLLMInstance{
+			Spec: litellmv1alpha1.LiteLLMInstanceSpec{
+				DatabaseSecretRef: litellmv1alpha1.DatabaseSecretRef{NameRef: "db"},
+				RedisSecretRef:    litellmv1alpha1.RedisSecretRef{NameRef: "redis"},
+				Models: []litellmv1alpha1.InitModelInstance{
+					{RequiresAuth: true, ModelCredentials: litellmv1alpha1.ModelCredentialSecretRef{NameRef: "openai"}},
+					{RequiresAuth: false, ModelCredentials: litellmv1alpha1.ModelCredentialSecretRef{NameRef: "unused"}},
+				},
+				ExtraEnvVars: []corev1.EnvVar{{
+					Name: "TOKEN",
+					ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "openai"}, Key: "token",
+					}},
+				}},
+			},
+		}
+		Expect(referencedSecretNames(llm)).To(Equal([]string{"db", "openai", "redis"}))
+	})
+
+	It("computeConfigChecksum changes with the config and secret data but not their order", func() {
+		config := map[string]string{"proxy_server_config.yaml": "model_list: []"}
+		masterKey := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "x-secrets"}, Data: map[string][]byte{"masterkey": []byte("sk-1")}}
+		db := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "db"}, Data: map[string][]byte{"password": []byte("a"), "host": []byte("pg")}}
+
+		checksum := computeConfigChecksum(config, []*corev1.Secret{masterKey, db})
+		Expect(computeConfigChecksum(config, []*corev1.Secret{db, masterKey})).To(Equal(checksum))
+
+		db.Data["password"] = []byte("b")
+		rotated := computeConfigChecksum(config, []*corev1.Secret{masterKey, db})
+		Expect(rotated).NotTo(Equal(checksum))
+
+		Expect(computeConfigChecksum(map[string]string{"proxy_server_config.yaml": "model_list: [x]"}, []*corev1.Secret{masterKey, db})).NotTo(Equal(rotated))
+	})
+
 	It("renderProxyConfig returns error when model missing required model name", func() {
 		llm := &litellmv1alpha1.LiteLLMInstance{
 			ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "default"},
@@ -354,7 +1121,9 @@ var _ = Describe("LiteLLMInstance helpers and rendering", func() {
 		yamlStr, err := renderProxyConfig(llm, ctx, k8sClient, k8sClient.Scheme())
 		Expect(err).NotTo(HaveOccurred())
 		Expect(yamlStr).To(ContainSubstring("router_settings"))
-		Expect(yamlStr).To(ContainSubstring("host: host"))
+		Expect(yamlStr).To(ContainSubstring("redis_host: os.environ/REDIS_HOST"))
+		Expect(yamlStr).To(ContainSubstring("redis_port: os.environ/REDIS_PORT"))
+		Expect(yamlStr).To(ContainSubstring("redis_password: os.environ/REDIS_PASSWORD"))
 		Expect(yamlStr).To(ContainSubstring("model_name: gpt-3.5-[inst]"))
 		// created secret names are sanitized; ensure the os.environ/ prefix is present
 		Expect(yamlStr).To(ContainSubstring("os.environ/"))
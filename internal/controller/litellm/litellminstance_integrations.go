@@ -0,0 +1,230 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package litellm
+
+import (
+	"path"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+)
+
+const (
+	// Logging integration types
+	IntegrationLangfuse   = "langfuse"
+	IntegrationOTel       = "otel"
+	IntegrationS3         = "s3"
+	IntegrationGCS        = "gcs"
+	IntegrationDatadog    = "datadog"
+	IntegrationPrometheus = "prometheus"
+	IntegrationWebhook    = "webhook"
+
+	// Events logged by an integration
+	IntegrationEventsSuccess           = "success"
+	IntegrationEventsFailure           = "failure"
+	IntegrationEventsSuccessAndFailure = "success_and_failure"
+
+	// Environment variables read by the LiteLLM logging integrations
+	LangfusePublicKeyEnv     = "LANGFUSE_PUBLIC_KEY"
+	LangfuseSecretKeyEnv     = "LANGFUSE_SECRET_KEY"
+	LangfuseHostEnv          = "LANGFUSE_HOST"
+	OTelExporterEnv          = "OTEL_EXPORTER"
+	OTelEndpointEnv          = "OTEL_ENDPOINT"
+	OTelHeadersEnv           = "OTEL_HEADERS"
+	S3AccessKeyIDEnv         = "S3_LOGGING_AWS_ACCESS_KEY_ID"     // Referenced from s3_callback_params
+	S3SecretAccessKeyEnv     = "S3_LOGGING_AWS_SECRET_ACCESS_KEY" // Referenced from s3_callback_params
+	GCSBucketNameEnv         = "GCS_BUCKET_NAME"
+	GCSPathServiceAccountEnv = "GCS_PATH_SERVICE_ACCOUNT"
+	DatadogAPIKeyEnv         = "DD_API_KEY"
+	DatadogSiteEnv           = "DD_SITE"
+	WebhookEndpointEnv       = "GENERIC_LOGGER_ENDPOINT"
+	WebhookHeadersEnv        = "GENERIC_LOGGER_HEADERS"
+
+	// Mount of the GCS service account key
+	GCSServiceAccountVolume = "gcs-service-account"
+	GCSServiceAccountDir    = "/etc/litellm-gcs"
+	GCSServiceAccountFile   = "service_account.json"
+)
+
+// integrationCallbacks maps the integration types to the names of their LiteLLM callbacks.
+var integrationCallbacks = map[string]string{
+	IntegrationLangfuse:   "langfuse",
+	IntegrationOTel:       "otel",
+	IntegrationS3:         "s3",
+	IntegrationGCS:        "gcs_bucket",
+	IntegrationDatadog:    "datadog",
+	IntegrationPrometheus: "prometheus",
+	IntegrationWebhook:    "generic",
+}
+
+// applyIntegrations adds the callbacks of the integrations to the litellm_settings section, after the
+// callbacks listed in the settings, and renders the S3 bucket into the s3_callback_params.
+func applyIntegrations(litellmSettings *LitellmSettingsYAML, integrations []litellmv1alpha1.LoggingIntegration) {
+	for _, integration := range integrations {
+		callback := integrationCallbacks[integration.Type]
+		events := defaultString(integration.Events, IntegrationEventsSuccessAndFailure)
+		if events != IntegrationEventsFailure && !slices.Contains(litellmSettings.SuccessCallback, callback) {
+			litellmSettings.SuccessCallback = append(litellmSettings.SuccessCallback, callback)
+		}
+		if events != IntegrationEventsSuccess && !slices.Contains(litellmSettings.FailureCallback, callback) {
+			litellmSettings.FailureCallback = append(litellmSettings.FailureCallback, callback)
+		}
+
+		if integration.Type == IntegrationS3 && integration.S3 != nil {
+			s3 := integration.S3
+			params := map[string]string{"s3_bucket_name": s3.BucketName}
+			if s3.Region != "" {
+				params["s3_region_name"] = s3.Region
+			}
+			if s3.Path != "" {
+				params["s3_path"] = s3.Path
+			}
+			if s3.EndpointURL != "" {
+				params["s3_endpoint_url"] = s3.EndpointURL
+			}
+			if s3.AccessKeyIDSecretRef != nil {
+				params["s3_aws_access_key_id"] = envReference(S3AccessKeyIDEnv)
+			}
+			if s3.SecretAccessKeySecretRef != nil {
+				params["s3_aws_secret_access_key"] = envReference(S3SecretAccessKeyEnv)
+			}
+			litellmSettings.S3CallbackParams = params
+		}
+	}
+}
+
+// buildIntegrationEnvironmentVariables builds the environment variables read by the logging integrations.
+func buildIntegrationEnvironmentVariables(integrations []litellmv1alpha1.LoggingIntegration) []corev1.EnvVar {
+	var envVars []corev1.EnvVar
+	for _, integration := range integrations {
+		switch integration.Type {
+		case IntegrationLangfuse:
+			if langfuse := integration.Langfuse; langfuse != nil {
+				envVars = append(envVars,
+					secretKeySelectorEnvVar(LangfusePublicKeyEnv, &langfuse.PublicKeySecretRef),
+					secretKeySelectorEnvVar(LangfuseSecretKeyEnv, &langfuse.SecretKeySecretRef))
+				if langfuse.Host != "" {
+					envVars = append(envVars, corev1.EnvVar{Name: LangfuseHostEnv, Value: langfuse.Host})
+				}
+			}
+		case IntegrationOTel:
+			if otel := integration.OpenTelemetry; otel != nil {
+				envVars = append(envVars, corev1.EnvVar{Name: OTelExporterEnv, Value: defaultString(otel.Exporter, "otlp_http")})
+				if otel.Endpoint != "" {
+					envVars = append(envVars, corev1.EnvVar{Name: OTelEndpointEnv, Value: otel.Endpoint})
+				}
+				if otel.HeadersSecretRef != nil {
+					envVars = append(envVars, secretKeySelectorEnvVar(OTelHeadersEnv, otel.HeadersSecretRef))
+				}
+			}
+		case IntegrationS3:
+			if s3 := integration.S3; s3 != nil {
+				if s3.AccessKeyIDSecretRef != nil {
+					envVars = append(envVars, secretKeySelectorEnvVar(S3AccessKeyIDEnv, s3.AccessKeyIDSecretRef))
+				}
+				if s3.SecretAccessKeySecretRef != nil {
+					envVars = append(envVars, secretKeySelectorEnvVar(S3SecretAccessKeyEnv, s3.SecretAccessKeySecretRef))
+				}
+			}
+		case IntegrationGCS:
+			if gcs := integration.GCS; gcs != nil {
+				envVars = append(envVars, corev1.EnvVar{Name: GCSBucketNameEnv, Value: gcs.BucketName})
+				if gcs.ServiceAccountSecretRef != nil {
+					envVars = append(envVars, corev1.EnvVar{Name: GCSPathServiceAccountEnv, Value: path.Join(GCSServiceAccountDir, GCSServiceAccountFile)})
+				}
+			}
+		case IntegrationDatadog:
+			if datadog := integration.Datadog; datadog != nil {
+				envVars = append(envVars, secretKeySelectorEnvVar(DatadogAPIKeyEnv, &datadog.APIKeySecretRef))
+				if datadog.Site != "" {
+					envVars = append(envVars, corev1.EnvVar{Name: DatadogSiteEnv, Value: datadog.Site})
+				}
+			}
+		case IntegrationWebhook:
+			if webhook := integration.Webhook; webhook != nil {
+				envVars = append(envVars, corev1.EnvVar{Name: WebhookEndpointEnv, Value: webhook.Endpoint})
+				if webhook.HeadersSecretRef != nil {
+					envVars = append(envVars, secretKeySelectorEnvVar(WebhookHeadersEnv, webhook.HeadersSecretRef))
+				}
+			}
+		}
+	}
+	return envVars
+}
+
+// buildIntegrationVolumes builds the volumes and mounts of the files read by the logging integrations.
+func buildIntegrationVolumes(integrations []litellmv1alpha1.LoggingIntegration) ([]corev1.Volume, []corev1.VolumeMount) {
+	for _, integration := range integrations {
+		if integration.Type != IntegrationGCS || integration.GCS == nil || integration.GCS.ServiceAccountSecretRef == nil {
+			continue
+		}
+
+		ref := integration.GCS.ServiceAccountSecretRef
+		volume := corev1.Volume{
+			Name: GCSServiceAccountVolume,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: ref.Name,
+					Items:      []corev1.KeyToPath{{Key: ref.Key, Path: GCSServiceAccountFile}},
+				},
+			},
+		}
+		mount := corev1.VolumeMount{Name: GCSServiceAccountVolume, MountPath: GCSServiceAccountDir, ReadOnly: true}
+		return []corev1.Volume{volume}, []corev1.VolumeMount{mount}
+	}
+	return nil, nil
+}
+
+// integrationSecretNames returns the names of the secrets referenced by the logging integrations.
+func integrationSecretNames(integrations []litellmv1alpha1.LoggingIntegration) []string {
+	var names []string
+	add := func(ref *corev1.SecretKeySelector) {
+		if ref != nil && ref.Name != "" {
+			names = append(names, ref.Name)
+		}
+	}
+	for _, integration := range integrations {
+		if langfuse := integration.Langfuse; langfuse != nil {
+			add(&langfuse.PublicKeySecretRef)
+			add(&langfuse.SecretKeySecretRef)
+		}
+		if otel := integration.OpenTelemetry; otel != nil {
+			add(otel.HeadersSecretRef)
+		}
+		if s3 := integration.S3; s3 != nil {
+			add(s3.AccessKeyIDSecretRef)
+			add(s3.SecretAccessKeySecretRef)
+		}
+		if gcs := integration.GCS; gcs != nil {
+			add(gcs.ServiceAccountSecretRef)
+		}
+		if datadog := integration.Datadog; datadog != nil {
+			add(&datadog.APIKeySecretRef)
+		}
+		if webhook := integration.Webhook; webhook != nil {
+			add(webhook.HeadersSecretRef)
+		}
+	}
+	return names
+}
+
+// secretKeySelectorEnvVar builds an environment variable sourced from the selected Secret key.
+func secretKeySelectorEnvVar(name string, ref *corev1.SecretKeySelector) corev1.EnvVar {
+	return secretKeyEnvVar(name, ref.Name, ref.Key)
+}
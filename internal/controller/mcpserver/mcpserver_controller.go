@@ -0,0 +1,404 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mcpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// MCPServerReconciler reconciles a MCPServer object
+type MCPServerReconciler struct {
+	*base.BaseController[*litellmv1alpha1.MCPServer]
+	LitellmMCPServerClient litellm.LitellmMCPServer
+}
+
+// NewMCPServerReconciler creates a new MCPServerReconciler instance
+func NewMCPServerReconciler(client client.Client, scheme *runtime.Scheme) *MCPServerReconciler {
+	return &MCPServerReconciler{
+		BaseController: &base.BaseController[*litellmv1alpha1.MCPServer]{
+			Client:         client,
+			Scheme:         scheme,
+			DefaultTimeout: 20 * time.Second,
+			ControllerName: "mcpserver",
+		},
+		LitellmMCPServerClient: nil,
+	}
+}
+
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=mcpservers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=mcpservers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=mcpservers/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// ============================================================================
+// Main Reconciler
+// ============================================================================
+
+// Reconcile implements the single-loop ensure* pattern with finalizer, conditions, and drift sync
+func (r *MCPServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// Add timeout to avoid long-running reconciliation
+	ctx, cancel := r.WithTimeout(ctx)
+	defer cancel()
+
+	// Instrument the reconcile loop
+	r.InstrumentReconcileLoop()
+	timer := r.InstrumentReconcileLatency()
+	defer timer.ObserveDuration()
+
+	log := logf.FromContext(ctx)
+
+	// Phase 1: Fetch and validate the resource
+	mcpServer := &litellmv1alpha1.MCPServer{}
+	mcpServer, err := r.FetchResource(ctx, req.NamespacedName, mcpServer)
+	if err != nil {
+		log.Error(err, "Failed to get MCPServer")
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+	if mcpServer == nil {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Reconciling MCP server resource", "mcpServer", mcpServer.Name)
+	// Phase 2: Set up connections and clients
+	if err := r.ensureConnectionSetup(ctx, mcpServer); err != nil {
+		log.Error(err, "Failed to setup connections")
+		return r.HandleErrorRetryable(ctx, mcpServer, err, base.ReasonConnectionError)
+	}
+
+	// Phase 3: Handle deletion if resource is being deleted
+	if !mcpServer.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, mcpServer)
+	}
+
+	// Phase 4: Upsert branch - ensure finalizer
+	if err := r.AddFinalizer(ctx, mcpServer, util.FinalizerName); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 5: Ensure external resource (create/patch/repair drift)
+	if res, err := r.ensureExternal(ctx, mcpServer); res.Requeue || res.RequeueAfter > 0 || err != nil {
+		r.InstrumentReconcileError()
+		return res, err
+	}
+
+	// Phase 6: Report the tools LiteLLM discovered on the server
+	r.updateTools(ctx, mcpServer)
+
+	// Phase 7: Mark Ready and persist ObservedGeneration
+	r.SetSuccessConditions(mcpServer, "MCP server is in desired state")
+	mcpServer.Status.ObservedGeneration = mcpServer.GetGeneration()
+	if err := r.PatchStatus(ctx, mcpServer); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 8: Periodic drift sync (external might change out of band)
+	return ctrl.Result{RequeueAfter: 60 * time.Second}, nil
+}
+
+// ensureConnectionSetup configures the LiteLLM client
+func (r *MCPServerReconciler) ensureConnectionSetup(ctx context.Context, mcpServer *litellmv1alpha1.MCPServer) error {
+	if r.LitellmMCPServerClient == nil {
+		litellmConnectionHandler, err := common.NewLitellmConnectionHandler(r.Client, ctx, mcpServer.Spec.ConnectionRef, mcpServer.Namespace)
+		if err != nil {
+			return err
+		}
+		r.LitellmMCPServerClient = litellmConnectionHandler.GetLitellmClient()
+	}
+
+	return nil
+}
+
+// reconcileDelete handles the deletion branch with idempotent external cleanup
+func (r *MCPServerReconciler) reconcileDelete(ctx context.Context, mcpServer *litellmv1alpha1.MCPServer) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if !r.HasFinalizer(mcpServer, util.FinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	// Set deleting condition and update status
+	r.SetCondition(mcpServer, base.CondReady, metav1.ConditionFalse, base.ReasonDeleting, "MCP server is being deleted")
+	if err := r.PatchStatus(ctx, mcpServer); err != nil {
+		log.Error(err, "Failed to update status during deletion")
+		// Continue with deletion even if status update fails
+	}
+
+	// Idempotent external cleanup
+	if mcpServer.Status.ServerID != "" {
+		if err := r.LitellmMCPServerClient.DeleteMCPServer(ctx, mcpServer.Status.ServerID); err != nil {
+			if !errors.Is(err, litellm.ErrNotFound) {
+				log.Error(err, "Failed to delete MCP server from LiteLLM")
+				return r.HandleErrorRetryable(ctx, mcpServer, err, base.ReasonDeleteFailed)
+			}
+			log.Info("Remote MCP server already not found in LiteLLM; proceeding to cleanup", "serverID", mcpServer.Status.ServerID)
+		}
+	}
+
+	// Remove finalizer
+	if err := r.RemoveFinalizer(ctx, mcpServer, util.FinalizerName); err != nil {
+		log.Error(err, "Failed to remove finalizer")
+		return r.HandleErrorRetryable(ctx, mcpServer, err, base.ReasonDeleteFailed)
+	}
+
+	log.Info("Successfully deleted MCP server", "mcpServer", mcpServer.Name)
+	return ctrl.Result{}, nil
+}
+
+// ensureExternal manages the external MCP server (create/patch/repair drift)
+func (r *MCPServerReconciler) ensureExternal(ctx context.Context, mcpServer *litellmv1alpha1.MCPServer) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	log.Info("Ensuring external MCP server resource", "mcpServer", mcpServer.Name)
+
+	// Set progressing condition
+	r.SetProgressingConditions(mcpServer, "Reconciling MCP server in LiteLLM")
+	if err := r.PatchStatus(ctx, mcpServer); err != nil {
+		log.Error(err, "Failed to update progressing status")
+		// Continue despite status update failure
+	}
+
+	serverRequest, err := r.convertToMCPServerRequest(ctx, mcpServer)
+	if err != nil {
+		log.Error(err, "Failed to create MCP server request")
+		return r.HandleErrorRetryable(ctx, mcpServer, err, base.ReasonInvalidSpec)
+	}
+	secretChecksum := ""
+	if serverRequest.Credentials != nil {
+		secretChecksum = common.SecretChecksum(map[string]string{"auth_value": serverRequest.Credentials.AuthValue})
+	}
+
+	// Adopt a server previously registered under the same name before registering a new one
+	serverID := mcpServer.Status.ServerID
+	if serverID == "" {
+		serverID, err = r.LitellmMCPServerClient.GetMCPServerID(ctx, serverRequest.ServerName)
+		if err != nil {
+			log.Error(err, "Failed to look up MCP server in LiteLLM")
+			return r.HandleErrorRetryable(ctx, mcpServer, err, base.ReasonLitellmError)
+		}
+	}
+
+	var observedServer litellm.MCPServerResponse
+	if serverID != "" {
+		observedServer, err = r.LitellmMCPServerClient.GetMCPServer(ctx, serverID)
+		if errors.Is(err, litellm.ErrNotFound) {
+			log.Info("MCP server not found in LiteLLM, recreating", "serverID", serverID)
+			serverID = ""
+		} else if err != nil {
+			log.Error(err, "Failed to get MCP server from LiteLLM")
+			return r.HandleErrorRetryable(ctx, mcpServer, err, base.ReasonLitellmError)
+		}
+	}
+
+	if serverID == "" {
+		log.Info("Registering new MCP server in LiteLLM", "serverName", serverRequest.ServerName)
+		createdServer, err := r.LitellmMCPServerClient.CreateMCPServer(ctx, serverRequest)
+		if err != nil {
+			log.Error(err, "Failed to create MCP server in LiteLLM")
+			return r.HandleErrorRetryable(ctx, mcpServer, err, base.ReasonLitellmError)
+		}
+
+		updateMCPServerStatus(mcpServer, &createdServer, secretChecksum)
+		if err := r.PatchStatus(ctx, mcpServer); err != nil {
+			log.Error(err, "Failed to update status after creation")
+			return r.HandleErrorRetryable(ctx, mcpServer, err, base.ReasonReconcileError)
+		}
+		log.Info("Successfully created MCP server in LiteLLM", "serverID", createdServer.ServerID)
+		return ctrl.Result{}, nil
+	}
+
+	// LiteLLM does not return credentials, so a rotated auth secret is detected through its checksum
+	secretChanged := mcpServer.Status.SecretChecksum != secretChecksum
+
+	if r.LitellmMCPServerClient.IsMCPServerUpdateNeeded(ctx, &observedServer, serverRequest) || secretChanged {
+		log.Info("Repairing drift in LiteLLM", "serverID", serverID, "secretChanged", secretChanged)
+		serverRequest.ServerID = serverID
+		updatedServer, err := r.LitellmMCPServerClient.UpdateMCPServer(ctx, serverRequest)
+		if err != nil {
+			log.Error(err, "Failed to update MCP server in LiteLLM")
+			return r.HandleErrorRetryable(ctx, mcpServer, err, base.ReasonLitellmError)
+		}
+		if updatedServer.ServerID == "" {
+			updatedServer.ServerID = serverID
+		}
+
+		updateMCPServerStatus(mcpServer, &updatedServer, secretChecksum)
+		if err := r.PatchStatus(ctx, mcpServer); err != nil {
+			log.Error(err, "Failed to update status after update")
+			return r.HandleErrorRetryable(ctx, mcpServer, err, base.ReasonReconcileError)
+		}
+		log.Info("Successfully repaired drift in LiteLLM", "serverID", serverID)
+	} else {
+		log.V(1).Info("MCP server is up to date in LiteLLM", "serverID", serverID)
+		mcpServer.Status.ServerID = serverID
+		mcpServer.Status.ServerName = serverRequest.ServerName
+		mcpServer.Status.URL = serverRequest.URL
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// updateTools records the tools LiteLLM discovered on the server. Discovery depends on the server
+// being reachable, so a failure is logged and the previously discovered tools are kept
+func (r *MCPServerReconciler) updateTools(ctx context.Context, mcpServer *litellmv1alpha1.MCPServer) {
+	log := logf.FromContext(ctx)
+
+	tools, err := r.LitellmMCPServerClient.ListMCPServerTools(ctx, mcpServer.Status.ServerID)
+	if err != nil {
+		log.Error(err, "Failed to list MCP server tools", "serverID", mcpServer.Status.ServerID)
+		return
+	}
+
+	mcpServer.Status.Tools = make([]litellmv1alpha1.MCPTool, 0, len(tools))
+	for _, tool := range tools {
+		mcpServer.Status.Tools = append(mcpServer.Status.Tools, litellmv1alpha1.MCPTool{Name: tool.Name, Description: tool.Description})
+	}
+}
+
+// updateMCPServerStatus records the pushed MCP server on the status of the k8s MCPServer
+func updateMCPServerStatus(mcpServer *litellmv1alpha1.MCPServer, serverResponse *litellm.MCPServerResponse, secretChecksum string) {
+	mcpServer.Status.ObservedGeneration = mcpServer.Generation
+	now := metav1.Now()
+	mcpServer.Status.LastUpdated = &now
+	mcpServer.Status.ServerID = serverResponse.ServerID
+	mcpServer.Status.ServerName = serverResponse.ServerName
+	mcpServer.Status.URL = serverResponse.URL
+	mcpServer.Status.SecretChecksum = secretChecksum
+}
+
+// ============================================================================
+// Conversion Functions
+// ============================================================================
+
+// serverName returns the name of the MCP server in LiteLLM
+func serverName(mcpServer *litellmv1alpha1.MCPServer) string {
+	if mcpServer.Spec.ServerName != "" {
+		return mcpServer.Spec.ServerName
+	}
+	return mcpServer.Name
+}
+
+// serverURL returns the address LiteLLM uses to reach an sse or http server
+func serverURL(mcpServer *litellmv1alpha1.MCPServer) string {
+	serviceRef := mcpServer.Spec.ServiceRef
+	if serviceRef == nil {
+		return mcpServer.Spec.URL
+	}
+
+	namespace := serviceRef.Namespace
+	if namespace == "" {
+		namespace = mcpServer.Namespace
+	}
+	port := serviceRef.Port
+	if port == 0 {
+		port = 80
+	}
+	return fmt.Sprintf("http://%s.%s.svc.cluster.local:%d%s", serviceRef.Name, namespace, port, serviceRef.Path)
+}
+
+// authSecretKey returns the key of the Secret holding the auth value
+func authSecretKey(mcpServer *litellmv1alpha1.MCPServer) client.ObjectKey {
+	namespace := mcpServer.Spec.AuthSecretRef.Namespace
+	if namespace == "" {
+		namespace = mcpServer.Namespace
+	}
+	return client.ObjectKey{Namespace: namespace, Name: mcpServer.Spec.AuthSecretRef.SecretName}
+}
+
+// convertToMCPServerRequest converts a Kubernetes MCPServer to a LiteLLM MCPServerRequest
+func (r *MCPServerReconciler) convertToMCPServerRequest(ctx context.Context, mcpServer *litellmv1alpha1.MCPServer) (*litellm.MCPServerRequest, error) {
+	name := serverName(mcpServer)
+	req := &litellm.MCPServerRequest{
+		Alias:        name,
+		AllowedTools: mcpServer.Spec.AllowedTools,
+		AuthType:     mcpServer.Spec.AuthType,
+		Description:  mcpServer.Spec.Description,
+		ServerName:   name,
+		Transport:    mcpServer.Spec.Transport,
+	}
+
+	if mcpServer.Spec.Transport == "stdio" {
+		req.Command = mcpServer.Spec.Command
+		req.Args = mcpServer.Spec.Args
+		req.Env = mcpServer.Spec.Env
+	} else {
+		req.URL = serverURL(mcpServer)
+	}
+
+	if mcpServer.Spec.AuthSecretRef != nil {
+		secretMap, err := util.GetMapFromSecret(ctx, r.Client, authSecretKey(mcpServer))
+		if err != nil {
+			return nil, err
+		}
+		key := mcpServer.Spec.AuthSecretRef.Key
+		if key == "" {
+			key = "token"
+		}
+		authValue, ok := secretMap[key]
+		if !ok {
+			return nil, fmt.Errorf("secret %s has no key %s", mcpServer.Spec.AuthSecretRef.SecretName, key)
+		}
+		req.Credentials = &litellm.MCPCredentials{AuthValue: authValue}
+	}
+
+	return req, nil
+}
+
+// mcpServerSecretIndexValues returns the index values of the Secrets read when reconciling the MCPServer
+func mcpServerSecretIndexValues(obj client.Object) []string {
+	mcpServer := obj.(*litellmv1alpha1.MCPServer)
+	values := common.ConnectionSecretIndexValues(mcpServer.Spec.ConnectionRef, mcpServer.Namespace)
+	if mcpServer.Spec.AuthSecretRef != nil && mcpServer.Spec.AuthSecretRef.SecretName != "" {
+		key := authSecretKey(mcpServer)
+		values = append(values, common.SecretIndexValue(key.Namespace, key.Name))
+	}
+	return values
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MCPServerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index the auth and connection secrets so that rotating either re-pushes the server
+	if err := common.IndexSecretRefs(mgr, &litellmv1alpha1.MCPServer{}, mcpServerSecretIndexValues); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&litellmv1alpha1.MCPServer{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{},
+			common.EnqueueForSecret(mgr.GetClient(), func() client.ObjectList { return &litellmv1alpha1.MCPServerList{} }),
+			builder.WithPredicates(common.SecretDataChangedPredicate())).
+		Named("litellm-mcpserver").
+		Complete(r)
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mcpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/util"
+)
+
+// mockLitellmMCPServerClient implements the LitellmMCPServer interface for testing
+type mockLitellmMCPServerClient struct {
+	servers      map[string]*litellm.MCPServerResponse
+	tools        []litellm.MCPToolResponse
+	toolsError   error
+	lastRequest  *litellm.MCPServerRequest
+	createCalled bool
+	updateCalled bool
+	deleteCalled bool
+}
+
+func (m *mockLitellmMCPServerClient) store(serverID string, req *litellm.MCPServerRequest) litellm.MCPServerResponse {
+	m.lastRequest = req
+	server := &litellm.MCPServerResponse{
+		Alias:        req.Alias,
+		AllowedTools: req.AllowedTools,
+		AuthType:     req.AuthType,
+		ServerID:     serverID,
+		ServerName:   req.ServerName,
+		Transport:    req.Transport,
+		URL:          req.URL,
+	}
+	m.servers[serverID] = server
+	return *server
+}
+
+func (m *mockLitellmMCPServerClient) CreateMCPServer(ctx context.Context, req *litellm.MCPServerRequest) (litellm.MCPServerResponse, error) {
+	m.createCalled = true
+	return m.store("mcp-"+req.ServerName, req), nil
+}
+
+func (m *mockLitellmMCPServerClient) DeleteMCPServer(ctx context.Context, serverID string) error {
+	m.deleteCalled = true
+	delete(m.servers, serverID)
+	return nil
+}
+
+func (m *mockLitellmMCPServerClient) GetMCPServer(ctx context.Context, serverID string) (litellm.MCPServerResponse, error) {
+	server, ok := m.servers[serverID]
+	if !ok {
+		return litellm.MCPServerResponse{}, fmt.Errorf("%w: MCP server %s", litellm.ErrNotFound, serverID)
+	}
+	return *server, nil
+}
+
+func (m *mockLitellmMCPServerClient) GetMCPServerID(ctx context.Context, serverName string) (string, error) {
+	for _, server := range m.servers {
+		if server.ServerName == serverName {
+			return server.ServerID, nil
+		}
+	}
+	return "", nil
+}
+
+func (m *mockLitellmMCPServerClient) IsMCPServerUpdateNeeded(ctx context.Context, server *litellm.MCPServerResponse, req *litellm.MCPServerRequest) bool {
+	return litellm.NewLitellmClient("", "").IsMCPServerUpdateNeeded(ctx, server, req)
+}
+
+func (m *mockLitellmMCPServerClient) ListMCPServerTools(ctx context.Context, serverID string) ([]litellm.MCPToolResponse, error) {
+	return m.tools, m.toolsError
+}
+
+func (m *mockLitellmMCPServerClient) UpdateMCPServer(ctx context.Context, req *litellm.MCPServerRequest) (litellm.MCPServerResponse, error) {
+	m.updateCalled = true
+	return m.store(req.ServerID, req), nil
+}
+
+func setupTestMCPServerReconciler(objects ...client.Object) (*MCPServerReconciler, *mockLitellmMCPServerClient) {
+	scheme := runtime.NewScheme()
+	_ = litellmv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&litellmv1alpha1.MCPServer{}).
+		Build()
+
+	mockClient := &mockLitellmMCPServerClient{servers: map[string]*litellm.MCPServerResponse{}}
+	reconciler := NewMCPServerReconciler(fakeClient, scheme)
+	reconciler.LitellmMCPServerClient = mockClient
+	return reconciler, mockClient
+}
+
+func createTestMCPServer() *litellmv1alpha1.MCPServer {
+	return &litellmv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "github",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: litellmv1alpha1.MCPServerSpec{
+			ConnectionRef: litellmv1alpha1.ConnectionRef{
+				SecretRef: litellmv1alpha1.SecretRef{SecretName: "test-connection"},
+			},
+			Transport:     "http",
+			ServiceRef:    &litellmv1alpha1.MCPServiceRef{Name: "github-mcp", Port: 8080, Path: "/mcp"},
+			AuthType:      "bearer_token",
+			AuthSecretRef: &litellmv1alpha1.MCPAuthSecretRef{SecretName: "github-token", Key: "token"},
+			AllowedTools:  []string{"create_issue"},
+		},
+	}
+}
+
+func createTestAuthSecret(token string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "github-token", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte(token)},
+	}
+}
+
+var _ = Describe("MCPServer Controller", func() {
+	var (
+		ctx     context.Context
+		request ctrl.Request
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		request = ctrl.Request{NamespacedName: types.NamespacedName{Name: "github", Namespace: "default"}}
+	})
+
+	It("registers the server at its Service address and reports the discovered tools", func() {
+		reconciler, mockClient := setupTestMCPServerReconciler(createTestMCPServer(), createTestAuthSecret("ghp-test"))
+		mockClient.tools = []litellm.MCPToolResponse{{Name: "create_issue", Description: "Create a GitHub issue"}}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.createCalled).To(BeTrue())
+		Expect(mockClient.lastRequest.URL).To(Equal("http://github-mcp.default.svc.cluster.local:8080/mcp"))
+		Expect(mockClient.lastRequest.Credentials).To(Equal(&litellm.MCPCredentials{AuthValue: "ghp-test"}))
+
+		mcpServer := &litellmv1alpha1.MCPServer{}
+		Expect(reconciler.Get(ctx, request.NamespacedName, mcpServer)).To(Succeed())
+		Expect(mcpServer.Status.ServerID).To(Equal("mcp-github"))
+		Expect(mcpServer.Status.URL).To(Equal("http://github-mcp.default.svc.cluster.local:8080/mcp"))
+		Expect(mcpServer.Status.Tools).To(Equal([]litellmv1alpha1.MCPTool{{Name: "create_issue", Description: "Create a GitHub issue"}}))
+		Expect(mcpServer.Finalizers).To(ContainElement(util.FinalizerName))
+	})
+
+	It("stays ready when tool discovery fails", func() {
+		reconciler, mockClient := setupTestMCPServerReconciler(createTestMCPServer(), createTestAuthSecret("ghp-test"))
+		mockClient.toolsError = errors.New("connection refused")
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		mcpServer := &litellmv1alpha1.MCPServer{}
+		Expect(reconciler.Get(ctx, request.NamespacedName, mcpServer)).To(Succeed())
+		Expect(mcpServer.Status.ServerID).To(Equal("mcp-github"))
+		Expect(mcpServer.Status.Tools).To(BeEmpty())
+	})
+
+	It("re-pushes the server when the auth secret is rotated", func() {
+		reconciler, mockClient := setupTestMCPServerReconciler(createTestMCPServer(), createTestAuthSecret("ghp-test"))
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mockClient.updateCalled).To(BeFalse())
+
+		Expect(reconciler.Update(ctx, createTestAuthSecret("ghp-rotated"))).To(Succeed())
+		_, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.updateCalled).To(BeTrue())
+		Expect(mockClient.lastRequest.ServerID).To(Equal("mcp-github"))
+		Expect(mockClient.lastRequest.Credentials.AuthValue).To(Equal("ghp-rotated"))
+	})
+
+	It("deletes the server from LiteLLM when the resource is deleted", func() {
+		mcpServer := createTestMCPServer()
+		now := metav1.Now()
+		mcpServer.DeletionTimestamp = &now
+		mcpServer.Finalizers = []string{util.FinalizerName}
+		mcpServer.Status.ServerID = "mcp-github"
+		reconciler, mockClient := setupTestMCPServerReconciler(mcpServer)
+		mockClient.servers["mcp-github"] = &litellm.MCPServerResponse{ServerID: "mcp-github"}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.deleteCalled).To(BeTrue())
+		Expect(mockClient.servers).To(BeEmpty())
+	})
+})
+
+var _ = Describe("MCPServer helpers", func() {
+	It("passes the command of a stdio server instead of a URL", func() {
+		mcpServer := createTestMCPServer()
+		mcpServer.Spec.Transport = "stdio"
+		mcpServer.Spec.ServiceRef = nil
+		mcpServer.Spec.AuthSecretRef = nil
+		mcpServer.Spec.Command = "npx"
+		mcpServer.Spec.Args = []string{"-y", "@modelcontextprotocol/server-github"}
+
+		reconciler, _ := setupTestMCPServerReconciler()
+		req, err := reconciler.convertToMCPServerRequest(context.Background(), mcpServer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(req.URL).To(BeEmpty())
+		Expect(req.Command).To(Equal("npx"))
+		Expect(req.Args).To(HaveLen(2))
+	})
+})
@@ -30,9 +30,11 @@ import (
 	"github.com/bbdsoftware/litellm-operator/internal/litellm"
 	modelProvider "github.com/bbdsoftware/litellm-operator/internal/model"
 	"github.com/bbdsoftware/litellm-operator/internal/util"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -209,6 +211,12 @@ func (r *ModelReconciler) ensureExternal(ctx context.Context, model *litellmv1al
 		return r.HandleErrorRetryable(ctx, model, err, base.ReasonInvalidSpec)
 	}
 
+	secretChecksum, err := r.modelSecretChecksum(ctx, model)
+	if err != nil {
+		log.Error(err, "Failed to read model secret")
+		return r.HandleErrorRetryable(ctx, model, err, base.ReasonInvalidSpec)
+	}
+
 	// Create if no external ID exists
 	if model.Status.ModelId == nil || *model.Status.ModelId == "" {
 		log.Info("Creating new model in LiteLLM", "modelName", model.Spec.ModelName)
@@ -225,6 +233,7 @@ func (r *ModelReconciler) ensureExternal(ctx context.Context, model *litellmv1al
 		externalData.ModelName = modelResponse.ModelName
 
 		r.updateModelStatus(model, &modelResponse)
+		model.Status.SecretChecksum = secretChecksum
 		if err := r.PatchStatus(ctx, model); err != nil {
 			log.Error(err, "Failed to update status after creation")
 			return r.HandleErrorRetryable(ctx, model, err, base.ReasonReconcileError)
@@ -247,8 +256,11 @@ func (r *ModelReconciler) ensureExternal(ctx context.Context, model *litellmv1al
 		return r.HandleErrorRetryable(ctx, model, err, base.ReasonLitellmError)
 	}
 
-	if updateNeeded.NeedsUpdate {
-		log.Info("Repairing drift in LiteLLM", "modelName", model.Spec.ModelName, "changedFields", updateNeeded.ChangedFields)
+	// LiteLLM does not return credentials, so a rotated model secret is detected through its checksum
+	secretChanged := model.Status.SecretChecksum != "" && model.Status.SecretChecksum != secretChecksum
+
+	if updateNeeded.NeedsUpdate || secretChanged {
+		log.Info("Repairing drift in LiteLLM", "modelName", model.Spec.ModelName, "changedFields", updateNeeded.ChangedFields, "secretChanged", secretChanged)
 		modelResponse, err := r.LitellmModelClient.UpdateModel(ctx, modelRequest)
 		if err != nil {
 			log.Error(err, "Failed to update model in LiteLLM")
@@ -262,6 +274,7 @@ func (r *ModelReconciler) ensureExternal(ctx context.Context, model *litellmv1al
 		externalData.ModelName = modelResponse.ModelName
 
 		r.updateModelStatus(model, &modelResponse)
+		model.Status.SecretChecksum = secretChecksum
 		if err := r.PatchStatus(ctx, model); err != nil {
 			log.Error(err, "Failed to update status after update")
 			return r.HandleErrorRetryable(ctx, model, err, base.ReasonReconcileError)
@@ -269,6 +282,7 @@ func (r *ModelReconciler) ensureExternal(ctx context.Context, model *litellmv1al
 		log.Info("Successfully repaired drift in LiteLLM", "modelID", *model.Status.ModelId)
 	} else {
 		log.V(1).Info("Model is up to date in LiteLLM", "modelID", *model.Status.ModelId)
+		model.Status.SecretChecksum = secretChecksum
 	}
 
 	return ctrl.Result{}, nil
@@ -318,6 +332,18 @@ func (r *ModelReconciler) updateModelStatus(model *litellmv1alpha1.Model, modelR
 // Model Provider and Conversion Functions
 // ============================================================================
 
+// modelSecretChecksum returns the checksum of the Model's provider secret
+func (r *ModelReconciler) modelSecretChecksum(ctx context.Context, model *litellmv1alpha1.Model) (string, error) {
+	secretMap, err := util.GetMapFromSecret(ctx, r.Client, client.ObjectKey{
+		Namespace: model.Spec.ModelSecretRef.Namespace,
+		Name:      model.Spec.ModelSecretRef.SecretName,
+	})
+	if err != nil {
+		return "", err
+	}
+	return common.SecretChecksum(secretMap), nil
+}
+
 // determine model provider from the Model
 func (r *ModelReconciler) determineModelProvider(model *litellmv1alpha1.Model) (*modelProvider.ModelProvider, error) {
 	if model.Spec.LiteLLMParams.CustomLLMProvider != nil {
@@ -479,9 +505,30 @@ func (r *ModelReconciler) convertToModelRequest(ctx context.Context, model *lite
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ModelReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index the model and connection secrets so that rotating either re-pushes the Models using them
+	if err := common.IndexSecretRefs(mgr, &litellmv1alpha1.Model{}, modelSecretIndexValues); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&litellmv1alpha1.Model{}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		For(&litellmv1alpha1.Model{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{},
+			common.EnqueueForSecret(mgr.GetClient(), func() client.ObjectList { return &litellmv1alpha1.ModelList{} }),
+			builder.WithPredicates(common.SecretDataChangedPredicate())).
 		Named("litellm-model").
 		Complete(r)
 }
+
+// modelSecretIndexValues returns the index values of the Secrets read when reconciling the Model
+func modelSecretIndexValues(obj client.Object) []string {
+	model := obj.(*litellmv1alpha1.Model)
+	values := common.ConnectionSecretIndexValues(model.Spec.ConnectionRef, model.Namespace)
+	if model.Spec.ModelSecretRef.SecretName != "" {
+		namespace := model.Spec.ModelSecretRef.Namespace
+		if namespace == "" {
+			namespace = model.Namespace
+		}
+		values = append(values, common.SecretIndexValue(namespace, model.Spec.ModelSecretRef.SecretName))
+	}
+	return values
+}
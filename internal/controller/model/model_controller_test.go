@@ -322,6 +322,46 @@ var _ = Describe("ModelReconciler", func() {
 			Expect(fakeClient.UpdateCalled).To(BeTrue())
 		})
 
+		It("re-pushes the model when the model secret has been rotated", func() {
+			// Arrange: create CR with an existing ID and a checksum of an older secret
+			model := newModelCR()
+			id := "model-rotated"
+			Expect(k8sClient.Create(ctx, model)).To(Succeed())
+
+			created := &litellmv1alpha1.Model{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: namespace}, created)).To(Succeed())
+			created.Status.ModelId = &id
+			created.Status.SecretChecksum = common.SecretChecksum(map[string]string{"api_key": "old-key"})
+			Expect(k8sClient.Status().Update(ctx, created)).To(Succeed())
+
+			// LiteLLM reports no drift since it does not return credentials
+			fakeClient.GetModelInfoFunc = func(ctx context.Context, gotID string) (litellm.ModelResponse, error) {
+				return litellm.ModelResponse{
+					ModelName: common.AppendModelSourceTag(resourceName, common.ModelTagCRD),
+					ModelInfo: &litellm.ModelInfo{ID: &id},
+				}, nil
+			}
+			fakeClient.IsModelUpdateNeededFunc = func(ctx context.Context, existing *litellm.ModelResponse, req *litellm.ModelRequest) (litellm.ModelUpdateNeeded, error) {
+				return litellm.ModelUpdateNeeded{NeedsUpdate: false}, nil
+			}
+			fakeClient.UpdateModelFunc = func(ctx context.Context, req *litellm.ModelRequest) (litellm.ModelResponse, error) {
+				return litellm.ModelResponse{
+					ModelName: common.AppendModelSourceTag(resourceName, common.ModelTagCRD),
+					ModelInfo: &litellm.ModelInfo{ID: &id},
+				}, nil
+			}
+
+			// Act
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: resourceName, Namespace: namespace}})
+			Expect(err).NotTo(HaveOccurred())
+
+			// Assert: the update is pushed and the new checksum recorded
+			Expect(fakeClient.UpdateCalled).To(BeTrue())
+			fetched := &litellmv1alpha1.Model{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: namespace}, fetched)).To(Succeed())
+			Expect(fetched.Status.SecretChecksum).NotTo(Equal(created.Status.SecretChecksum))
+		})
+
 		It("properly finalises model deletion when finalizer is present", func() {
 			// Arrange: create CR and add finalizer + status id, then mark for deletion
 			model := newModelCR()
@@ -366,3 +406,16 @@ func findCondition(conditions []metav1.Condition, condType string) *metav1.Condi
 	}
 	return nil
 }
+
+var _ = Describe("Model secret helpers", func() {
+	It("indexes the connection and model secrets", func() {
+		model := &litellmv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: "m", Namespace: "team-a"},
+			Spec: litellmv1alpha1.ModelSpec{
+				ConnectionRef:  litellmv1alpha1.ConnectionRef{InstanceRef: litellmv1alpha1.InstanceRef{Name: "proxy", Namespace: "litellm"}},
+				ModelSecretRef: litellmv1alpha1.SecretRef{SecretName: "openai"},
+			},
+		}
+		Expect(modelSecretIndexValues(model)).To(Equal([]string{"litellm/proxy-secrets", "team-a/openai"}))
+	})
+})
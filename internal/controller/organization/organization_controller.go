@@ -0,0 +1,309 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+	litellm "github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// OrganizationReconciler reconciles an Organization object
+type OrganizationReconciler struct {
+	*base.BaseController[*authv1alpha1.Organization]
+	LitellmClient litellm.LitellmOrganization
+}
+
+// NewOrganizationReconciler creates a new OrganizationReconciler instance
+func NewOrganizationReconciler(client client.Client, scheme *runtime.Scheme) *OrganizationReconciler {
+	return &OrganizationReconciler{
+		BaseController: &base.BaseController[*authv1alpha1.Organization]{
+			Client:         client,
+			Scheme:         scheme,
+			DefaultTimeout: 20 * time.Second,
+			ControllerName: "organization",
+		},
+		LitellmClient: nil,
+	}
+}
+
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=organizations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=organizations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=organizations/finalizers,verbs=update
+
+// Reconcile implements the single-loop ensure* pattern with finalizer, conditions, and drift sync
+func (r *OrganizationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// Add timeout to avoid long-running reconciliation
+	ctx, cancel := r.WithTimeout(ctx)
+	defer cancel()
+
+	// Instrument the reconcile loop
+	r.InstrumentReconcileLoop()
+	timer := r.InstrumentReconcileLatency()
+	defer timer.ObserveDuration()
+
+	log := log.FromContext(ctx)
+
+	// Phase 1: Fetch and validate the resource
+	organization := &authv1alpha1.Organization{}
+	organization, err := r.FetchResource(ctx, req.NamespacedName, organization)
+	if err != nil {
+		log.Error(err, "Failed to get Organization")
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+	if organization == nil {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Reconciling external organization resource", "organization", organization.Name)
+	// Phase 2: Set up connections and clients
+	if err := r.ensureConnectionSetup(ctx, organization); err != nil {
+		log.Error(err, "Failed to setup connections")
+		return r.HandleErrorRetryable(ctx, organization, err, base.ReasonConnectionError)
+	}
+
+	// Phase 3: Handle deletion if resource is being deleted
+	if !organization.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, organization)
+	}
+
+	// Phase 4: Upsert branch - ensure finalizer
+	if err := r.AddFinalizer(ctx, organization, util.FinalizerName); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 5: Ensure external resource (create/patch/repair drift)
+	if res, err := r.ensureExternal(ctx, organization); res.RequeueAfter > 0 || err != nil {
+		r.InstrumentReconcileError()
+		return res, err
+	}
+
+	// Phase 6: Mark Ready and persist ObservedGeneration
+	r.SetSuccessConditions(organization, "Organization is in desired state")
+	organization.Status.ObservedGeneration = organization.GetGeneration()
+	if err := r.PatchStatus(ctx, organization); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 7: Periodic drift sync (external might change out of band)
+	return ctrl.Result{RequeueAfter: 60 * time.Second}, nil
+}
+
+// ensureConnectionSetup configures the LiteLLM client
+func (r *OrganizationReconciler) ensureConnectionSetup(ctx context.Context, organization *authv1alpha1.Organization) error {
+	if r.LitellmClient == nil {
+		litellmConnectionHandler, err := common.NewLitellmConnectionHandler(r.Client, ctx, organization.Spec.ConnectionRef, organization.Namespace)
+		if err != nil {
+			return err
+		}
+		r.LitellmClient = litellmConnectionHandler.GetLitellmClient()
+	}
+
+	return nil
+}
+
+// reconcileDelete handles the deletion branch with idempotent external cleanup
+func (r *OrganizationReconciler) reconcileDelete(ctx context.Context, organization *authv1alpha1.Organization) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	if !r.HasFinalizer(organization, util.FinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	r.SetCondition(organization, base.CondReady, metav1.ConditionFalse, base.ReasonDeleting, "Organization is being deleted")
+	if err := r.PatchStatus(ctx, organization); err != nil {
+		log.Error(err, "Failed to update status during deletion")
+		// Continue with deletion even if status update fails
+	}
+
+	// Idempotent external cleanup
+	if organization.Status.OrganizationID != "" {
+		if err := r.LitellmClient.DeleteOrganization(ctx, organization.Status.OrganizationID); err != nil && !errors.Is(err, litellm.ErrNotFound) {
+			log.Error(err, "Failed to delete organization from LiteLLM")
+			return r.HandleErrorRetryable(ctx, organization, err, base.ReasonDeleteFailed)
+		}
+		log.Info("Successfully deleted organization from LiteLLM", "organizationID", organization.Status.OrganizationID)
+	}
+
+	if err := r.RemoveFinalizer(ctx, organization, util.FinalizerName); err != nil {
+		log.Error(err, "Failed to remove finalizer")
+		return r.HandleErrorRetryable(ctx, organization, err, base.ReasonDeleteFailed)
+	}
+
+	log.Info("Successfully deleted organization", "organization", organization.Name)
+	return ctrl.Result{}, nil
+}
+
+// ensureExternal manages the external organization resource (create/patch/repair drift)
+func (r *OrganizationReconciler) ensureExternal(ctx context.Context, organization *authv1alpha1.Organization) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("Ensuring external organization resource", "organization", organization.Name)
+
+	r.SetProgressingConditions(organization, "Reconciling organization in LiteLLM")
+	if err := r.PatchStatus(ctx, organization); err != nil {
+		log.Error(err, "Failed to update progressing status")
+		// Continue despite status update failure
+	}
+
+	organizationRequest, err := convertToOrganizationRequest(organization)
+	if err != nil {
+		log.Error(err, "Failed to create organization request")
+		return r.HandleErrorRetryable(ctx, organization, err, base.ReasonInvalidSpec)
+	}
+
+	// Check if organization exists by alias
+	existingOrganizationID, err := r.LitellmClient.GetOrganizationID(ctx, organization.Spec.OrganizationAlias)
+	if err != nil {
+		log.Error(err, "Failed to check if organization exists")
+		return r.HandleErrorRetryable(ctx, organization, err, base.ReasonLitellmError)
+	}
+
+	// If organization exists but doesn't match our managed ID, it's a conflict
+	if existingOrganizationID != "" && organization.Status.OrganizationID != "" && existingOrganizationID != organization.Status.OrganizationID {
+		err := fmt.Errorf("organization with alias %s already exists with different ID (existing: %s, ours: %s)",
+			organization.Spec.OrganizationAlias, existingOrganizationID, organization.Status.OrganizationID)
+		log.Error(err, "Organization alias conflict")
+		return r.HandleErrorRetryable(ctx, organization, err, base.ReasonConfigError)
+	}
+
+	// Create if no external ID exists or if no organization found by alias
+	if organization.Status.OrganizationID == "" || existingOrganizationID == "" {
+		log.Info("Creating new organization in LiteLLM", "organizationAlias", organization.Spec.OrganizationAlias)
+		createResponse, err := r.LitellmClient.CreateOrganization(ctx, &organizationRequest)
+		if err != nil {
+			log.Error(err, "Failed to create organization in LiteLLM")
+			return r.HandleErrorRetryable(ctx, organization, err, base.ReasonLitellmError)
+		}
+
+		updateOrganizationStatus(organization, createResponse)
+		if err := r.PatchStatus(ctx, organization); err != nil {
+			log.Error(err, "Failed to update status after creation")
+			return r.HandleErrorRetryable(ctx, organization, err, base.ReasonReconcileError)
+		}
+		log.Info("Successfully created organization in LiteLLM", "organizationID", createResponse.OrganizationID)
+		return ctrl.Result{}, nil
+	}
+
+	// Organization exists, check for drift and repair if needed
+	log.V(1).Info("Checking for drift", "organizationID", organization.Status.OrganizationID)
+	observedOrganization, err := r.LitellmClient.GetOrganization(ctx, organization.Status.OrganizationID)
+	if err != nil {
+		log.Error(err, "Failed to get organization from LiteLLM")
+		return r.HandleErrorRetryable(ctx, organization, err, base.ReasonLitellmError)
+	}
+
+	organizationRequest.OrganizationID = organization.Status.OrganizationID
+
+	if r.LitellmClient.IsOrganizationUpdateNeeded(ctx, &observedOrganization, &organizationRequest) {
+		log.Info("Repairing drift in LiteLLM", "organizationAlias", organization.Spec.OrganizationAlias)
+		updateResponse, err := r.LitellmClient.UpdateOrganization(ctx, &organizationRequest)
+		if err != nil {
+			log.Error(err, "Failed to update organization in LiteLLM")
+			return r.HandleErrorRetryable(ctx, organization, err, base.ReasonLitellmError)
+		}
+		observedOrganization = updateResponse
+		log.Info("Successfully repaired drift in LiteLLM", "organizationID", organization.Status.OrganizationID)
+	} else {
+		log.V(1).Info("Organization is up to date in LiteLLM", "organizationID", organization.Status.OrganizationID)
+	}
+
+	updateOrganizationStatus(organization, observedOrganization)
+	if err := r.PatchStatus(ctx, organization); err != nil {
+		log.Error(err, "Failed to update status after drift check")
+		return r.HandleErrorRetryable(ctx, organization, err, base.ReasonReconcileError)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// convertToOrganizationRequest creates an OrganizationRequest from an Organization (isolated for testing)
+func convertToOrganizationRequest(organization *authv1alpha1.Organization) (litellm.OrganizationRequest, error) {
+	organizationRequest := litellm.OrganizationRequest{
+		BudgetDuration:    organization.Spec.BudgetDuration,
+		Metadata:          util.EnsureMetadata(organization.Spec.Metadata),
+		Models:            organization.Spec.Models,
+		OrganizationAlias: organization.Spec.OrganizationAlias,
+		OrganizationID:    organization.Spec.OrganizationID,
+		RPMLimit:          organization.Spec.RPMLimit,
+		Tags:              organization.Spec.Tags,
+		TPMLimit:          organization.Spec.TPMLimit,
+	}
+
+	if organization.Spec.MaxBudget != "" {
+		maxBudget, err := strconv.ParseFloat(organization.Spec.MaxBudget, 64)
+		if err != nil {
+			return litellm.OrganizationRequest{}, errors.New("maxBudget: " + err.Error())
+		}
+		organizationRequest.MaxBudget = maxBudget
+	}
+
+	return organizationRequest, nil
+}
+
+// updateOrganizationStatus updates the status of the k8s Organization from the litellm response
+func updateOrganizationStatus(organization *authv1alpha1.Organization, organizationResponse litellm.OrganizationResponse) {
+	budget := organizationResponse.GetBudget()
+
+	organization.Status.BudgetDuration = budget.BudgetDuration
+	organization.Status.BudgetID = organizationResponse.BudgetID
+	organization.Status.CreatedAt = organizationResponse.CreatedAt
+	organization.Status.MaxBudget = fmt.Sprintf("%.2f", budget.MaxBudget)
+	organization.Status.Models = organizationResponse.Models
+	organization.Status.OrganizationAlias = organizationResponse.OrganizationAlias
+	organization.Status.OrganizationID = organizationResponse.OrganizationID
+	organization.Status.RPMLimit = budget.RPMLimit
+	organization.Status.Spend = fmt.Sprintf("%.2f", organizationResponse.Spend)
+	organization.Status.TPMLimit = budget.TPMLimit
+	organization.Status.UpdatedAt = organizationResponse.UpdatedAt
+}
+
+func (r *OrganizationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index the connection secret so that rotating it re-reconciles the Organizations using it
+	if err := common.IndexSecretRefs(mgr, &authv1alpha1.Organization{}, func(obj client.Object) []string {
+		organization := obj.(*authv1alpha1.Organization)
+		return common.ConnectionSecretIndexValues(organization.Spec.ConnectionRef, organization.Namespace)
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&authv1alpha1.Organization{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{},
+			common.EnqueueForSecret(mgr.GetClient(), func() client.ObjectList { return &authv1alpha1.OrganizationList{} }),
+			builder.WithPredicates(common.SecretDataChangedPredicate())).
+		Named("litellm-organization").
+		Complete(r)
+}
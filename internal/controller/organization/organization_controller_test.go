@@ -0,0 +1,264 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/util"
+)
+
+type mockLitellmOrganizationClient struct {
+	organizations map[string]*litellm.OrganizationResponse
+	createError   error
+	updateCalled  bool
+	deleteCalled  bool
+}
+
+func (m *mockLitellmOrganizationClient) CreateOrganization(ctx context.Context, req *litellm.OrganizationRequest) (litellm.OrganizationResponse, error) {
+	if m.createError != nil {
+		return litellm.OrganizationResponse{}, m.createError
+	}
+	organization := &litellm.OrganizationResponse{
+		OrganizationID:    "org-" + req.OrganizationAlias,
+		OrganizationAlias: req.OrganizationAlias,
+		Models:            req.Models,
+		LiteLLMBudgetTable: &litellm.BudgetTable{
+			BudgetDuration: req.BudgetDuration,
+			MaxBudget:      req.MaxBudget,
+			RPMLimit:       req.RPMLimit,
+			TPMLimit:       req.TPMLimit,
+		},
+	}
+	m.organizations[organization.OrganizationID] = organization
+	return *organization, nil
+}
+
+func (m *mockLitellmOrganizationClient) DeleteOrganization(ctx context.Context, organizationID string) error {
+	m.deleteCalled = true
+	delete(m.organizations, organizationID)
+	return nil
+}
+
+func (m *mockLitellmOrganizationClient) GetOrganization(ctx context.Context, organizationID string) (litellm.OrganizationResponse, error) {
+	organization, exists := m.organizations[organizationID]
+	if !exists {
+		return litellm.OrganizationResponse{}, errors.New("organization not found")
+	}
+	return *organization, nil
+}
+
+func (m *mockLitellmOrganizationClient) GetOrganizationID(ctx context.Context, organizationAlias string) (string, error) {
+	for _, organization := range m.organizations {
+		if organization.OrganizationAlias == organizationAlias {
+			return organization.OrganizationID, nil
+		}
+	}
+	return "", nil
+}
+
+func (m *mockLitellmOrganizationClient) IsOrganizationUpdateNeeded(ctx context.Context, organization *litellm.OrganizationResponse, req *litellm.OrganizationRequest) bool {
+	return litellm.NewLitellmClient("", "").IsOrganizationUpdateNeeded(ctx, organization, req)
+}
+
+func (m *mockLitellmOrganizationClient) UpdateOrganization(ctx context.Context, req *litellm.OrganizationRequest) (litellm.OrganizationResponse, error) {
+	m.updateCalled = true
+	organization, exists := m.organizations[req.OrganizationID]
+	if !exists {
+		return litellm.OrganizationResponse{}, errors.New("organization not found")
+	}
+	organization.Models = req.Models
+	organization.LiteLLMBudgetTable = &litellm.BudgetTable{
+		BudgetDuration: req.BudgetDuration,
+		MaxBudget:      req.MaxBudget,
+		RPMLimit:       req.RPMLimit,
+		TPMLimit:       req.TPMLimit,
+	}
+	return *organization, nil
+}
+
+var _ = Describe("Organization Controller", func() {
+	const (
+		resourceName = "test-organization"
+		namespace    = "default"
+		secretName   = "test-secret"
+	)
+
+	var (
+		ctx                context.Context
+		typeNamespacedName types.NamespacedName
+		reconciler         *OrganizationReconciler
+		mockClient         *mockLitellmOrganizationClient
+	)
+
+	newOrganization := func() *authv1alpha1.Organization {
+		return &authv1alpha1.Organization{
+			ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: namespace},
+			Spec: authv1alpha1.OrganizationSpec{
+				ConnectionRef: authv1alpha1.ConnectionRef{
+					SecretRef: &authv1alpha1.SecretRef{
+						Name: secretName,
+						Keys: authv1alpha1.SecretKeys{
+							MasterKey: "masterkey",
+							URL:       "url",
+						},
+					},
+				},
+				OrganizationAlias: "acme",
+				MaxBudget:         "100",
+				Models:            []string{"gpt-4o"},
+				RPMLimit:          10,
+			},
+		}
+	}
+
+	reconcileOrganization := func() {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		typeNamespacedName = types.NamespacedName{Name: resourceName, Namespace: namespace}
+
+		connectionSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespace},
+			Data: map[string][]byte{
+				"masterkey": []byte("test-master-key"),
+				"url":       []byte("http://test-url"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, connectionSecret)).To(Succeed())
+
+		mockClient = &mockLitellmOrganizationClient{organizations: map[string]*litellm.OrganizationResponse{}}
+		reconciler = NewOrganizationReconciler(k8sClient, k8sClient.Scheme())
+		reconciler.LitellmClient = mockClient
+	})
+
+	AfterEach(func() {
+		organization := &authv1alpha1.Organization{}
+		err := k8sClient.Get(ctx, typeNamespacedName, organization)
+		if err == nil {
+			organization.Finalizers = nil
+			_ = k8sClient.Update(ctx, organization)
+			_ = k8sClient.Delete(ctx, organization)
+			Eventually(func() bool {
+				e := k8sClient.Get(ctx, typeNamespacedName, &authv1alpha1.Organization{})
+				return apierrors.IsNotFound(e)
+			}, time.Second*5, time.Millisecond*200).Should(BeTrue())
+		} else if !apierrors.IsNotFound(err) {
+			Fail("unexpected get error: " + err.Error())
+		}
+
+		connectionSecret := &corev1.Secret{}
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: secretName, Namespace: namespace}, connectionSecret); err == nil {
+			Expect(k8sClient.Delete(ctx, connectionSecret)).To(Succeed())
+		}
+	})
+
+	It("creates the organization in LiteLLM and records its ID", func() {
+		Expect(k8sClient.Create(ctx, newOrganization())).To(Succeed())
+
+		reconcileOrganization()
+
+		updated := &authv1alpha1.Organization{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+		Expect(updated.Status.OrganizationID).To(Equal("org-acme"))
+		Expect(updated.Status.MaxBudget).To(Equal("100.00"))
+		Expect(updated.Status.RPMLimit).To(Equal(10))
+		Expect(updated.Finalizers).To(ContainElement(util.FinalizerName))
+		Expect(updated.Status.ObservedGeneration).To(Equal(updated.Generation))
+	})
+
+	It("repairs drift when the spec changes", func() {
+		Expect(k8sClient.Create(ctx, newOrganization())).To(Succeed())
+		reconcileOrganization()
+		Expect(mockClient.updateCalled).To(BeFalse())
+
+		organization := &authv1alpha1.Organization{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, organization)).To(Succeed())
+		organization.Spec.MaxBudget = "250"
+		Expect(k8sClient.Update(ctx, organization)).To(Succeed())
+
+		reconcileOrganization()
+
+		Expect(mockClient.updateCalled).To(BeTrue())
+		updated := &authv1alpha1.Organization{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+		Expect(updated.Status.MaxBudget).To(Equal("250.00"))
+	})
+
+	It("sets a degraded condition when LiteLLM rejects the organization", func() {
+		mockClient.createError = errors.New("connection refused")
+		Expect(k8sClient.Create(ctx, newOrganization())).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+		updated := &authv1alpha1.Organization{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+		Expect(updated.Status.Conditions).To(ContainElement(SatisfyAll(
+			HaveField("Type", base.CondDegraded),
+			HaveField("Status", metav1.ConditionTrue),
+			HaveField("Reason", base.ReasonLitellmError),
+		)))
+	})
+
+	It("deletes the organization from LiteLLM when the resource is deleted", func() {
+		Expect(k8sClient.Create(ctx, newOrganization())).To(Succeed())
+		reconcileOrganization()
+
+		organization := &authv1alpha1.Organization{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, organization)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, organization)).To(Succeed())
+
+		reconcileOrganization()
+
+		Expect(mockClient.deleteCalled).To(BeTrue())
+		Expect(mockClient.organizations).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Organization helpers", func() {
+	It("rejects an invalid maxBudget", func() {
+		organization := &authv1alpha1.Organization{Spec: authv1alpha1.OrganizationSpec{MaxBudget: "lots"}}
+		_, err := convertToOrganizationRequest(organization)
+		Expect(err).To(MatchError(ContainSubstring("maxBudget")))
+	})
+
+	It("adds the managed-by metadata", func() {
+		organization := &authv1alpha1.Organization{Spec: authv1alpha1.OrganizationSpec{OrganizationAlias: "acme", MaxBudget: "12.5"}}
+		req, err := convertToOrganizationRequest(organization)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(req.MaxBudget).To(Equal(12.5))
+		Expect(req.Metadata).To(HaveKey("managed_by"))
+	})
+})
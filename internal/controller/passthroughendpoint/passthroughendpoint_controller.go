@@ -0,0 +1,381 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package passthroughendpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// PassThroughEndpointReconciler reconciles a PassThroughEndpoint object
+type PassThroughEndpointReconciler struct {
+	*base.BaseController[*litellmv1alpha1.PassThroughEndpoint]
+	LitellmPassThroughEndpointClient litellm.LitellmPassThroughEndpoint
+}
+
+// NewPassThroughEndpointReconciler creates a new PassThroughEndpointReconciler instance
+func NewPassThroughEndpointReconciler(client client.Client, scheme *runtime.Scheme) *PassThroughEndpointReconciler {
+	return &PassThroughEndpointReconciler{
+		BaseController: &base.BaseController[*litellmv1alpha1.PassThroughEndpoint]{
+			Client:         client,
+			Scheme:         scheme,
+			DefaultTimeout: 20 * time.Second,
+			ControllerName: "passthroughendpoint",
+		},
+		LitellmPassThroughEndpointClient: nil,
+	}
+}
+
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=passthroughendpoints,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=passthroughendpoints/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=passthroughendpoints/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// ============================================================================
+// Main Reconciler
+// ============================================================================
+
+// Reconcile implements the single-loop ensure* pattern with finalizer, conditions, and drift sync
+func (r *PassThroughEndpointReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// Add timeout to avoid long-running reconciliation
+	ctx, cancel := r.WithTimeout(ctx)
+	defer cancel()
+
+	// Instrument the reconcile loop
+	r.InstrumentReconcileLoop()
+	timer := r.InstrumentReconcileLatency()
+	defer timer.ObserveDuration()
+
+	log := logf.FromContext(ctx)
+
+	// Phase 1: Fetch and validate the resource
+	endpoint := &litellmv1alpha1.PassThroughEndpoint{}
+	endpoint, err := r.FetchResource(ctx, req.NamespacedName, endpoint)
+	if err != nil {
+		log.Error(err, "Failed to get PassThroughEndpoint")
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+	if endpoint == nil {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Reconciling pass-through endpoint resource", "passThroughEndpoint", endpoint.Name)
+	// Phase 2: Set up connections and clients
+	if err := r.ensureConnectionSetup(ctx, endpoint); err != nil {
+		log.Error(err, "Failed to setup connections")
+		return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonConnectionError)
+	}
+
+	// Phase 3: Handle deletion if resource is being deleted
+	if !endpoint.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, endpoint)
+	}
+
+	// Phase 4: Upsert branch - ensure finalizer
+	if err := r.AddFinalizer(ctx, endpoint, util.FinalizerName); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 5: Ensure external resource (create/patch/repair drift)
+	if res, err := r.ensureExternal(ctx, endpoint); res.Requeue || res.RequeueAfter > 0 || err != nil {
+		r.InstrumentReconcileError()
+		return res, err
+	}
+
+	// Phase 6: Mark Ready and persist ObservedGeneration
+	r.SetSuccessConditions(endpoint, "PassThroughEndpoint is in desired state")
+	endpoint.Status.ObservedGeneration = endpoint.GetGeneration()
+	if err := r.PatchStatus(ctx, endpoint); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 7: Periodic drift sync (external might change out of band)
+	return ctrl.Result{RequeueAfter: 60 * time.Second}, nil
+}
+
+// ensureConnectionSetup configures the LiteLLM client
+func (r *PassThroughEndpointReconciler) ensureConnectionSetup(ctx context.Context, endpoint *litellmv1alpha1.PassThroughEndpoint) error {
+	if r.LitellmPassThroughEndpointClient == nil {
+		litellmConnectionHandler, err := common.NewLitellmConnectionHandler(r.Client, ctx, endpoint.Spec.ConnectionRef, endpoint.Namespace)
+		if err != nil {
+			return err
+		}
+		r.LitellmPassThroughEndpointClient = litellmConnectionHandler.GetLitellmClient()
+	}
+
+	return nil
+}
+
+// reconcileDelete handles the deletion branch with idempotent external cleanup
+func (r *PassThroughEndpointReconciler) reconcileDelete(ctx context.Context, endpoint *litellmv1alpha1.PassThroughEndpoint) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if !r.HasFinalizer(endpoint, util.FinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	// Set deleting condition and update status
+	r.SetCondition(endpoint, base.CondReady, metav1.ConditionFalse, base.ReasonDeleting, "PassThroughEndpoint is being deleted")
+	if err := r.PatchStatus(ctx, endpoint); err != nil {
+		log.Error(err, "Failed to update status during deletion")
+		// Continue with deletion even if status update fails
+	}
+
+	// Idempotent external cleanup
+	if endpoint.Status.EndpointID != "" {
+		if err := r.LitellmPassThroughEndpointClient.DeletePassThroughEndpoint(ctx, endpoint.Status.EndpointID); err != nil {
+			if !errors.Is(err, litellm.ErrNotFound) {
+				log.Error(err, "Failed to delete pass-through endpoint from LiteLLM")
+				return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonDeleteFailed)
+			}
+			log.Info("Remote pass-through endpoint already not found in LiteLLM; proceeding to cleanup", "endpointID", endpoint.Status.EndpointID)
+		}
+	}
+
+	// Remove finalizer
+	if err := r.RemoveFinalizer(ctx, endpoint, util.FinalizerName); err != nil {
+		log.Error(err, "Failed to remove finalizer")
+		return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonDeleteFailed)
+	}
+
+	log.Info("Successfully deleted pass-through endpoint", "passThroughEndpoint", endpoint.Name)
+	return ctrl.Result{}, nil
+}
+
+// ensureExternal manages the external pass-through endpoint (create/patch/repair drift)
+func (r *PassThroughEndpointReconciler) ensureExternal(ctx context.Context, endpoint *litellmv1alpha1.PassThroughEndpoint) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	log.Info("Ensuring external pass-through endpoint resource", "passThroughEndpoint", endpoint.Name)
+
+	// Set progressing condition
+	r.SetProgressingConditions(endpoint, "Reconciling pass-through endpoint in LiteLLM")
+	if err := r.PatchStatus(ctx, endpoint); err != nil {
+		log.Error(err, "Failed to update progressing status")
+		// Continue despite status update failure
+	}
+
+	endpointRequest, err := r.convertToPassThroughEndpointRequest(ctx, endpoint)
+	if err != nil {
+		log.Error(err, "Failed to create pass-through endpoint request")
+		return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonInvalidSpec)
+	}
+	secretChecksum := common.SecretChecksum(endpointRequest.SecretHeaders)
+
+	// Adopt an endpoint previously created for the same path before creating a new one, unless another
+	// PassThroughEndpoint manages it
+	endpointID := endpoint.Status.EndpointID
+	if endpointID == "" {
+		endpointID, err = r.LitellmPassThroughEndpointClient.GetPassThroughEndpointID(ctx, endpointRequest.Path)
+		if err != nil {
+			log.Error(err, "Failed to look up pass-through endpoint in LiteLLM")
+			return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonLitellmError)
+		}
+		if endpointID != "" {
+			owner, err := r.endpointOwner(ctx, endpoint, endpointID)
+			if err != nil {
+				log.Error(err, "Failed to list PassThroughEndpoints")
+				return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonReconcileError)
+			}
+			if owner != nil {
+				err := fmt.Errorf("pass-through endpoint for path %s is already managed by PassThroughEndpoint %s/%s",
+					endpointRequest.Path, owner.Namespace, owner.Name)
+				log.Error(err, "Pass-through endpoint conflict")
+				return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonConfigError)
+			}
+		}
+	}
+
+	var observedEndpoint litellm.PassThroughEndpointResponse
+	if endpointID != "" {
+		observedEndpoint, err = r.LitellmPassThroughEndpointClient.GetPassThroughEndpoint(ctx, endpointID)
+		if errors.Is(err, litellm.ErrNotFound) {
+			log.Info("Pass-through endpoint not found in LiteLLM, recreating", "endpointID", endpointID)
+			endpointID = ""
+		} else if err != nil {
+			log.Error(err, "Failed to get pass-through endpoint from LiteLLM")
+			return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonLitellmError)
+		}
+	}
+
+	if endpointID == "" {
+		log.Info("Creating new pass-through endpoint in LiteLLM", "path", endpointRequest.Path)
+		createdEndpoint, err := r.LitellmPassThroughEndpointClient.CreatePassThroughEndpoint(ctx, endpointRequest)
+		if err != nil {
+			log.Error(err, "Failed to create pass-through endpoint in LiteLLM")
+			return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonLitellmError)
+		}
+
+		updatePassThroughEndpointStatus(endpoint, &createdEndpoint, secretChecksum)
+		if err := r.PatchStatus(ctx, endpoint); err != nil {
+			log.Error(err, "Failed to update status after creation")
+			return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonReconcileError)
+		}
+		log.Info("Successfully created pass-through endpoint in LiteLLM", "endpointID", createdEndpoint.ID)
+		return ctrl.Result{}, nil
+	}
+
+	updateNeeded, err := r.LitellmPassThroughEndpointClient.IsPassThroughEndpointUpdateNeeded(ctx, &observedEndpoint, endpointRequest)
+	if err != nil {
+		log.Error(err, "Failed to check if pass-through endpoint needs update")
+		return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonLitellmError)
+	}
+
+	// LiteLLM may mask secret headers, so a rotated secret is detected through its checksum
+	secretChanged := endpoint.Status.SecretChecksum != secretChecksum
+
+	if updateNeeded.NeedsUpdate || secretChanged {
+		log.Info("Repairing drift in LiteLLM", "endpointID", endpointID, "changedFields", updateNeeded.ChangedFields, "secretChanged", secretChanged)
+		updatedEndpoint, err := r.LitellmPassThroughEndpointClient.UpdatePassThroughEndpoint(ctx, endpointID, endpointRequest)
+		if err != nil {
+			log.Error(err, "Failed to update pass-through endpoint in LiteLLM")
+			return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonLitellmError)
+		}
+
+		updatePassThroughEndpointStatus(endpoint, &updatedEndpoint, secretChecksum)
+		if err := r.PatchStatus(ctx, endpoint); err != nil {
+			log.Error(err, "Failed to update status after update")
+			return r.HandleErrorRetryable(ctx, endpoint, err, base.ReasonReconcileError)
+		}
+		log.Info("Successfully repaired drift in LiteLLM", "endpointID", endpointID)
+	} else {
+		log.V(1).Info("Pass-through endpoint is up to date in LiteLLM", "endpointID", endpointID)
+		endpoint.Status.EndpointID = endpointID
+		endpoint.Status.Path = publicPath(&observedEndpoint)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// endpointOwner returns the other PassThroughEndpoint on the same connection that manages the LiteLLM endpoint, if any
+func (r *PassThroughEndpointReconciler) endpointOwner(ctx context.Context, endpoint *litellmv1alpha1.PassThroughEndpoint, endpointID string) (*litellmv1alpha1.PassThroughEndpoint, error) {
+	endpoints := &litellmv1alpha1.PassThroughEndpointList{}
+	if err := r.List(ctx, endpoints); err != nil {
+		return nil, err
+	}
+	connection := common.ConnectionSecretIndexValues(endpoint.Spec.ConnectionRef, endpoint.Namespace)
+	for i := range endpoints.Items {
+		other := &endpoints.Items[i]
+		if (other.Namespace == endpoint.Namespace && other.Name == endpoint.Name) || other.Status.EndpointID != endpointID {
+			continue
+		}
+		if slices.Equal(common.ConnectionSecretIndexValues(other.Spec.ConnectionRef, other.Namespace), connection) {
+			return other, nil
+		}
+	}
+	return nil, nil
+}
+
+// updatePassThroughEndpointStatus records the pushed endpoint on the status of the k8s PassThroughEndpoint
+func updatePassThroughEndpointStatus(endpoint *litellmv1alpha1.PassThroughEndpoint, endpointResponse *litellm.PassThroughEndpointResponse, secretChecksum string) {
+	endpoint.Status.ObservedGeneration = endpoint.Generation
+	now := metav1.Now()
+	endpoint.Status.LastUpdated = &now
+	endpoint.Status.EndpointID = endpointResponse.ID
+	endpoint.Status.Path = publicPath(endpointResponse)
+	endpoint.Status.SecretChecksum = secretChecksum
+}
+
+// publicPath returns the path served by the proxy for the endpoint
+func publicPath(endpointResponse *litellm.PassThroughEndpointResponse) string {
+	if endpointResponse.IncludeSubpath {
+		return strings.TrimSuffix(endpointResponse.Path, "/") + "/*"
+	}
+	return endpointResponse.Path
+}
+
+// ============================================================================
+// Conversion Functions
+// ============================================================================
+
+// headersSecretKey returns the key of the Secret holding the secret headers
+func headersSecretKey(endpoint *litellmv1alpha1.PassThroughEndpoint) client.ObjectKey {
+	namespace := endpoint.Spec.HeadersSecretRef.Namespace
+	if namespace == "" {
+		namespace = endpoint.Namespace
+	}
+	return client.ObjectKey{Namespace: namespace, Name: endpoint.Spec.HeadersSecretRef.SecretName}
+}
+
+// convertToPassThroughEndpointRequest converts a Kubernetes PassThroughEndpoint to a LiteLLM PassThroughEndpointRequest
+func (r *PassThroughEndpointReconciler) convertToPassThroughEndpointRequest(ctx context.Context, endpoint *litellmv1alpha1.PassThroughEndpoint) (*litellm.PassThroughEndpointRequest, error) {
+	req := &litellm.PassThroughEndpointRequest{
+		Path:           endpoint.Spec.Path,
+		Target:         endpoint.Spec.Target,
+		Headers:        endpoint.Spec.Headers,
+		IncludeSubpath: endpoint.Spec.IncludeSubpath,
+		Auth:           endpoint.Spec.Auth,
+		ForwardHeaders: endpoint.Spec.ForwardHeaders,
+	}
+
+	if endpoint.Spec.HeadersSecretRef != nil {
+		secretMap, err := util.GetMapFromSecret(ctx, r.Client, headersSecretKey(endpoint))
+		if err != nil {
+			return nil, err
+		}
+		req.SecretHeaders = secretMap
+	}
+
+	return req, nil
+}
+
+// passThroughEndpointSecretIndexValues returns the index values of the Secrets read when reconciling the PassThroughEndpoint
+func passThroughEndpointSecretIndexValues(obj client.Object) []string {
+	endpoint := obj.(*litellmv1alpha1.PassThroughEndpoint)
+	values := common.ConnectionSecretIndexValues(endpoint.Spec.ConnectionRef, endpoint.Namespace)
+	if endpoint.Spec.HeadersSecretRef != nil && endpoint.Spec.HeadersSecretRef.SecretName != "" {
+		key := headersSecretKey(endpoint)
+		values = append(values, common.SecretIndexValue(key.Namespace, key.Name))
+	}
+	return values
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PassThroughEndpointReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index the headers and connection secrets so that rotating either re-pushes the endpoint
+	if err := common.IndexSecretRefs(mgr, &litellmv1alpha1.PassThroughEndpoint{}, passThroughEndpointSecretIndexValues); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&litellmv1alpha1.PassThroughEndpoint{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{},
+			common.EnqueueForSecret(mgr.GetClient(), func() client.ObjectList { return &litellmv1alpha1.PassThroughEndpointList{} }),
+			builder.WithPredicates(common.SecretDataChangedPredicate())).
+		Named("litellm-passthroughendpoint").
+		Complete(r)
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package passthroughendpoint
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/util"
+)
+
+// mockLitellmPassThroughEndpointClient implements the LitellmPassThroughEndpoint interface for testing
+type mockLitellmPassThroughEndpointClient struct {
+	endpoints    map[string]*litellm.PassThroughEndpointResponse
+	lastRequest  *litellm.PassThroughEndpointRequest
+	createCalled bool
+	updateCalled bool
+	deleteCalled bool
+}
+
+func (m *mockLitellmPassThroughEndpointClient) store(endpointID string, req *litellm.PassThroughEndpointRequest) litellm.PassThroughEndpointResponse {
+	m.lastRequest = req
+	endpoint := &litellm.PassThroughEndpointResponse{
+		ID:             endpointID,
+		Path:           req.Path,
+		Target:         req.Target,
+		Headers:        req.Headers,
+		IncludeSubpath: req.IncludeSubpath,
+		Auth:           req.Auth,
+		ForwardHeaders: req.ForwardHeaders,
+	}
+	m.endpoints[endpointID] = endpoint
+	return *endpoint
+}
+
+func (m *mockLitellmPassThroughEndpointClient) CreatePassThroughEndpoint(ctx context.Context, req *litellm.PassThroughEndpointRequest) (litellm.PassThroughEndpointResponse, error) {
+	m.createCalled = true
+	return m.store("pte"+req.Path, req), nil
+}
+
+func (m *mockLitellmPassThroughEndpointClient) DeletePassThroughEndpoint(ctx context.Context, endpointID string) error {
+	m.deleteCalled = true
+	delete(m.endpoints, endpointID)
+	return nil
+}
+
+func (m *mockLitellmPassThroughEndpointClient) GetPassThroughEndpoint(ctx context.Context, endpointID string) (litellm.PassThroughEndpointResponse, error) {
+	endpoint, ok := m.endpoints[endpointID]
+	if !ok {
+		return litellm.PassThroughEndpointResponse{}, fmt.Errorf("%w: pass-through endpoint %s", litellm.ErrNotFound, endpointID)
+	}
+	return *endpoint, nil
+}
+
+func (m *mockLitellmPassThroughEndpointClient) GetPassThroughEndpointID(ctx context.Context, path string) (string, error) {
+	for _, endpoint := range m.endpoints {
+		if endpoint.Path == path {
+			return endpoint.ID, nil
+		}
+	}
+	return "", nil
+}
+
+func (m *mockLitellmPassThroughEndpointClient) IsPassThroughEndpointUpdateNeeded(ctx context.Context, endpoint *litellm.PassThroughEndpointResponse, req *litellm.PassThroughEndpointRequest) (litellm.PassThroughEndpointUpdateNeeded, error) {
+	return litellm.NewLitellmClient("", "").IsPassThroughEndpointUpdateNeeded(ctx, endpoint, req)
+}
+
+func (m *mockLitellmPassThroughEndpointClient) UpdatePassThroughEndpoint(ctx context.Context, endpointID string, req *litellm.PassThroughEndpointRequest) (litellm.PassThroughEndpointResponse, error) {
+	m.updateCalled = true
+	return m.store(endpointID, req), nil
+}
+
+func setupTestPassThroughEndpointReconciler(objects ...client.Object) (*PassThroughEndpointReconciler, *mockLitellmPassThroughEndpointClient) {
+	scheme := runtime.NewScheme()
+	_ = litellmv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&litellmv1alpha1.PassThroughEndpoint{}).
+		Build()
+
+	mockClient := &mockLitellmPassThroughEndpointClient{endpoints: map[string]*litellm.PassThroughEndpointResponse{}}
+	reconciler := NewPassThroughEndpointReconciler(fakeClient, scheme)
+	reconciler.LitellmPassThroughEndpointClient = mockClient
+	return reconciler, mockClient
+}
+
+func createTestPassThroughEndpoint() *litellmv1alpha1.PassThroughEndpoint {
+	return &litellmv1alpha1.PassThroughEndpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "cohere-rerank",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: litellmv1alpha1.PassThroughEndpointSpec{
+			ConnectionRef: litellmv1alpha1.ConnectionRef{
+				SecretRef: litellmv1alpha1.SecretRef{SecretName: "test-connection"},
+			},
+			Path:             "/v1/rerank",
+			Target:           "https://api.cohere.com/v1/rerank",
+			Headers:          map[string]string{"content-type": "application/json"},
+			HeadersSecretRef: &litellmv1alpha1.SecretRef{SecretName: "cohere-headers"},
+			Auth:             true,
+		},
+	}
+}
+
+func createTestHeadersSecret(authorization string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cohere-headers", Namespace: "default"},
+		Data:       map[string][]byte{"Authorization": []byte(authorization)},
+	}
+}
+
+var _ = Describe("PassThroughEndpoint Controller", func() {
+	var (
+		ctx     context.Context
+		request ctrl.Request
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		request = ctrl.Request{NamespacedName: types.NamespacedName{Name: "cohere-rerank", Namespace: "default"}}
+	})
+
+	It("creates the endpoint with the secret headers and reports its public path", func() {
+		reconciler, mockClient := setupTestPassThroughEndpointReconciler(createTestPassThroughEndpoint(), createTestHeadersSecret("bearer sk-test"))
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.createCalled).To(BeTrue())
+		Expect(mockClient.lastRequest.SecretHeaders).To(Equal(map[string]string{"Authorization": "bearer sk-test"}))
+		Expect(mockClient.lastRequest.Auth).To(BeTrue())
+
+		endpoint := &litellmv1alpha1.PassThroughEndpoint{}
+		Expect(reconciler.Get(ctx, request.NamespacedName, endpoint)).To(Succeed())
+		Expect(endpoint.Status.EndpointID).To(Equal("pte/v1/rerank"))
+		Expect(endpoint.Status.Path).To(Equal("/v1/rerank"))
+		Expect(endpoint.Status.SecretChecksum).NotTo(BeEmpty())
+		Expect(endpoint.Finalizers).To(ContainElement(util.FinalizerName))
+	})
+
+	It("repairs drift made outside the operator", func() {
+		endpoint := createTestPassThroughEndpoint()
+		endpoint.Spec.IncludeSubpath = true
+		reconciler, mockClient := setupTestPassThroughEndpointReconciler(endpoint, createTestHeadersSecret("bearer sk-test"))
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mockClient.updateCalled).To(BeFalse())
+
+		mockClient.endpoints["pte/v1/rerank"].Target = "https://api.cohere.com/v2/rerank"
+		_, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.updateCalled).To(BeTrue())
+		Expect(mockClient.endpoints["pte/v1/rerank"].Target).To(Equal("https://api.cohere.com/v1/rerank"))
+
+		Expect(reconciler.Get(ctx, request.NamespacedName, endpoint)).To(Succeed())
+		Expect(endpoint.Status.Path).To(Equal("/v1/rerank/*"))
+	})
+
+	It("re-pushes the endpoint when the headers secret is rotated", func() {
+		reconciler, mockClient := setupTestPassThroughEndpointReconciler(createTestPassThroughEndpoint(), createTestHeadersSecret("bearer sk-test"))
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(reconciler.Update(ctx, createTestHeadersSecret("bearer sk-rotated"))).To(Succeed())
+		_, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.updateCalled).To(BeTrue())
+		Expect(mockClient.lastRequest.SecretHeaders["Authorization"]).To(Equal("bearer sk-rotated"))
+	})
+
+	It("refuses to adopt an endpoint managed by another PassThroughEndpoint", func() {
+		owner := createTestPassThroughEndpoint()
+		owner.Name = "cohere-rerank-owner"
+		owner.Namespace = "other"
+		owner.Spec.ConnectionRef.SecretRef.Namespace = "default"
+		owner.Spec.HeadersSecretRef = nil
+		owner.Status.EndpointID = "pte/v1/rerank"
+		endpoint := createTestPassThroughEndpoint()
+		reconciler, mockClient := setupTestPassThroughEndpointReconciler(owner, endpoint, createTestHeadersSecret("bearer sk-test"))
+		mockClient.store("pte/v1/rerank", &litellm.PassThroughEndpointRequest{Path: "/v1/rerank", Target: "https://example.com"})
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.updateCalled).To(BeFalse())
+		Expect(reconciler.Get(ctx, request.NamespacedName, endpoint)).To(Succeed())
+		Expect(endpoint.Status.EndpointID).To(BeEmpty())
+		condition := meta.FindStatusCondition(endpoint.Status.Conditions, base.CondDegraded)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(base.ReasonConfigError))
+		Expect(condition.Message).To(ContainSubstring("other/cohere-rerank-owner"))
+	})
+
+	It("deletes the endpoint from LiteLLM when the resource is deleted", func() {
+		endpoint := createTestPassThroughEndpoint()
+		now := metav1.Now()
+		endpoint.DeletionTimestamp = &now
+		endpoint.Finalizers = []string{util.FinalizerName}
+		endpoint.Status.EndpointID = "pte/v1/rerank"
+		reconciler, mockClient := setupTestPassThroughEndpointReconciler(endpoint)
+		mockClient.endpoints["pte/v1/rerank"] = &litellm.PassThroughEndpointResponse{ID: "pte/v1/rerank"}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockClient.deleteCalled).To(BeTrue())
+		Expect(mockClient.endpoints).To(BeEmpty())
+	})
+})
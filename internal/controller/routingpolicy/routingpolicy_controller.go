@@ -0,0 +1,196 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routingpolicy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// RoutingPolicyReconciler reconciles a RoutingPolicy object. The policy itself is rendered into the
+// router settings by the LiteLLMInstance controller; this reconciler resolves the referenced models and
+// reports whether the policy can be applied.
+type RoutingPolicyReconciler struct {
+	*base.BaseController[*litellmv1alpha1.RoutingPolicy]
+}
+
+// NewRoutingPolicyReconciler creates a new RoutingPolicyReconciler instance
+func NewRoutingPolicyReconciler(client client.Client, scheme *runtime.Scheme) *RoutingPolicyReconciler {
+	return &RoutingPolicyReconciler{
+		BaseController: &base.BaseController[*litellmv1alpha1.RoutingPolicy]{
+			Client:         client,
+			Scheme:         scheme,
+			DefaultTimeout: 20 * time.Second,
+			ControllerName: "routingpolicy",
+		},
+	}
+}
+
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=routingpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=routingpolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=routingpolicies/finalizers,verbs=update
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=models,verbs=get;list;watch
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=litellminstances,verbs=get;list;watch
+
+// ============================================================================
+// Main Reconciler
+// ============================================================================
+
+// Reconcile resolves the models referenced by the policy and reports its conditions
+func (r *RoutingPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// Add timeout to avoid long-running reconciliation
+	ctx, cancel := r.WithTimeout(ctx)
+	defer cancel()
+
+	// Instrument the reconcile loop
+	r.InstrumentReconcileLoop()
+	timer := r.InstrumentReconcileLatency()
+	defer timer.ObserveDuration()
+
+	log := logf.FromContext(ctx)
+
+	// Phase 1: Fetch and validate the resource
+	policy := &litellmv1alpha1.RoutingPolicy{}
+	policy, err := r.FetchResource(ctx, req.NamespacedName, policy)
+	if err != nil {
+		log.Error(err, "Failed to get RoutingPolicy")
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+	if policy == nil {
+		return ctrl.Result{}, nil
+	}
+
+	// Phase 2: Nothing to clean up on deletion, the instance re-renders its config without the policy
+	if !policy.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Reconciling routing policy resource", "routingPolicy", policy.Name)
+	// Phase 3: Ensure the instance the policy applies to exists in the namespace of the policy
+	instanceKey := common.RoutingPolicyInstanceKey(policy)
+	if !common.RoutingPolicyNamespaceAllowed(policy) {
+		err := fmt.Errorf("instanceRef.namespace %s must be the namespace of the RoutingPolicy", instanceKey.Namespace)
+		log.Error(err, "RoutingPolicy references an instance in another namespace")
+		return r.HandleErrorFinal(ctx, policy, err, base.ReasonInvalidSpec)
+	}
+	if err := r.Get(ctx, instanceKey, &litellmv1alpha1.LiteLLMInstance{}); err != nil {
+		log.Error(err, "Failed to get LiteLLMInstance", "instance", instanceKey)
+		return r.HandleErrorRetryable(ctx, policy, fmt.Errorf("failed to get LiteLLMInstance %s: %w", instanceKey, err), base.ReasonDependencyNotReady)
+	}
+
+	// Phase 4: Ensure no earlier policy of the instance already sets the same router settings
+	policies, err := common.RoutingPoliciesForInstance(ctx, r.Client, &litellmv1alpha1.LiteLLMInstance{
+		ObjectMeta: metav1.ObjectMeta{Namespace: instanceKey.Namespace, Name: instanceKey.Name},
+	})
+	if err != nil {
+		log.Error(err, "Failed to list routing policies of the instance")
+		return r.HandleErrorRetryable(ctx, policy, err, base.ReasonReconcileError)
+	}
+	if conflict, ok := common.RoutingPolicyConflicts(policies)[policy.Name]; ok {
+		err := fmt.Errorf("%s is already set by RoutingPolicy %s for LiteLLMInstance %s", conflict.Setting, conflict.Policy, instanceKey)
+		log.Info("RoutingPolicy conflicts with an earlier policy", "setting", conflict.Setting, "conflictsWith", conflict.Policy)
+		return r.HandleErrorRetryable(ctx, policy, err, base.ReasonConfigError)
+	}
+
+	// Phase 5: Resolve the referenced models to their model names
+	modelNames, err := common.RoutingPolicyModelNames(ctx, r.Client, policy)
+	if err != nil {
+		log.Error(err, "Failed to resolve referenced models")
+		return r.HandleErrorRetryable(ctx, policy, err, base.ReasonDependencyNotReady)
+	}
+	policy.Status.ModelNames = modelNames
+
+	// Phase 6: Mark Ready and persist ObservedGeneration
+	r.SetSuccessConditions(policy, "RoutingPolicy is applied to the router settings of "+instanceKey.String())
+	policy.Status.ObservedGeneration = policy.GetGeneration()
+	if err := r.PatchStatus(ctx, policy); err != nil {
+		r.InstrumentReconcileError()
+		return ctrl.Result{}, err
+	}
+
+	// Phase 7: Periodic sync (models might be renamed or deleted)
+	return ctrl.Result{RequeueAfter: 60 * time.Second}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RoutingPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&litellmv1alpha1.RoutingPolicy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&litellmv1alpha1.Model{},
+			handler.EnqueueRequestsFromMapFunc(r.policiesForModel)).
+		Watches(&litellmv1alpha1.RoutingPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.policiesForSameInstance),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("litellm-routingpolicy").
+		Complete(r)
+}
+
+// policiesForModel enqueues the RoutingPolicies referencing a Model
+func (r *RoutingPolicyReconciler) policiesForModel(ctx context.Context, obj client.Object) []reconcile.Request {
+	policies := &litellmv1alpha1.RoutingPolicyList{}
+	if err := r.List(ctx, policies, client.InNamespace(obj.GetNamespace())); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list routing policies for model", "model", obj.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, policy := range policies.Items {
+		if slices.Contains(policy.ReferencedModels(), obj.GetName()) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&policy)})
+		}
+	}
+	return requests
+}
+
+// policiesForSameInstance enqueues the other RoutingPolicies of the instance a RoutingPolicy applies to, so that
+// their conflicts are re-evaluated when it changes or is deleted
+func (r *RoutingPolicyReconciler) policiesForSameInstance(ctx context.Context, obj client.Object) []reconcile.Request {
+	changed, ok := obj.(*litellmv1alpha1.RoutingPolicy)
+	if !ok {
+		return nil
+	}
+	policies := &litellmv1alpha1.RoutingPolicyList{}
+	if err := r.List(ctx, policies, client.InNamespace(obj.GetNamespace())); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list routing policies", "routingPolicy", obj.GetName())
+		return nil
+	}
+
+	instanceKey := common.RoutingPolicyInstanceKey(changed)
+	var requests []reconcile.Request
+	for _, policy := range policies.Items {
+		if policy.Name != changed.Name && common.RoutingPolicyInstanceKey(&policy) == instanceKey {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&policy)})
+		}
+	}
+	return requests
+}
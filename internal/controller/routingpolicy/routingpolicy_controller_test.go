@@ -0,0 +1,183 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routingpolicy
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+)
+
+func setupTestRoutingPolicyReconciler(objects ...client.Object) *RoutingPolicyReconciler {
+	scheme := runtime.NewScheme()
+	_ = litellmv1alpha1.AddToScheme(scheme)
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&litellmv1alpha1.RoutingPolicy{}).
+		Build()
+
+	return NewRoutingPolicyReconciler(fakeClient, scheme)
+}
+
+func createTestRoutingPolicy() *litellmv1alpha1.RoutingPolicy {
+	retries := 2
+	return &litellmv1alpha1.RoutingPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "gpt-4o-resilience",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: litellmv1alpha1.RoutingPolicySpec{
+			InstanceRef: litellmv1alpha1.InstanceRef{Name: "litellm"},
+			Fallbacks: []litellmv1alpha1.RoutingFallback{
+				{ModelRef: "gpt-4o", FallbackRefs: []string{"claude-sonnet"}},
+			},
+			RetryPolicy: &litellmv1alpha1.RetryPolicy{RateLimitErrorRetries: &retries},
+		},
+	}
+}
+
+func createTestModel(name, modelName string) *litellmv1alpha1.Model {
+	return &litellmv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       litellmv1alpha1.ModelSpec{ModelName: modelName},
+	}
+}
+
+func createTestInstance() *litellmv1alpha1.LiteLLMInstance {
+	return &litellmv1alpha1.LiteLLMInstance{ObjectMeta: metav1.ObjectMeta{Name: "litellm", Namespace: "default"}}
+}
+
+func readyCondition(policy *litellmv1alpha1.RoutingPolicy) *metav1.Condition {
+	for i := range policy.Status.Conditions {
+		if policy.Status.Conditions[i].Type == base.CondReady {
+			return &policy.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+var _ = Describe("RoutingPolicy Controller", func() {
+	var (
+		ctx     context.Context
+		request ctrl.Request
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		request = ctrl.Request{NamespacedName: types.NamespacedName{Name: "gpt-4o-resilience", Namespace: "default"}}
+	})
+
+	It("resolves the referenced models to their tagged model names", func() {
+		reconciler := setupTestRoutingPolicyReconciler(createTestRoutingPolicy(), createTestInstance(),
+			createTestModel("gpt-4o", "gpt-4o"), createTestModel("claude-sonnet", "claude-sonnet-4"))
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		policy := &litellmv1alpha1.RoutingPolicy{}
+		Expect(reconciler.Get(ctx, request.NamespacedName, policy)).To(Succeed())
+		Expect(policy.Status.ModelNames).To(Equal(map[string]string{
+			"gpt-4o":        "gpt-4o-[crd]",
+			"claude-sonnet": "claude-sonnet-4-[crd]",
+		}))
+		Expect(readyCondition(policy).Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("reports a missing model as a dependency that is not ready", func() {
+		reconciler := setupTestRoutingPolicyReconciler(createTestRoutingPolicy(), createTestInstance(), createTestModel("gpt-4o", "gpt-4o"))
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+		policy := &litellmv1alpha1.RoutingPolicy{}
+		Expect(reconciler.Get(ctx, request.NamespacedName, policy)).To(Succeed())
+		Expect(policy.Status.ModelNames).To(BeEmpty())
+		Expect(readyCondition(policy).Reason).To(Equal(base.ReasonDependencyNotReady))
+	})
+
+	It("reports a conflict on the newer of two policies setting the same retries", func() {
+		older := createTestRoutingPolicy()
+		older.Name = "z-older"
+		older.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+		newer := createTestRoutingPolicy()
+		newer.CreationTimestamp = metav1.NewTime(time.Now())
+		reconciler := setupTestRoutingPolicyReconciler(older, newer, createTestInstance(),
+			createTestModel("gpt-4o", "gpt-4o"), createTestModel("claude-sonnet", "claude-sonnet-4"))
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		policy := &litellmv1alpha1.RoutingPolicy{}
+		Expect(reconciler.Get(ctx, request.NamespacedName, policy)).To(Succeed())
+		Expect(readyCondition(policy).Status).To(Equal(metav1.ConditionFalse))
+		Expect(readyCondition(policy).Reason).To(Equal(base.ReasonConfigError))
+		Expect(readyCondition(policy).Message).To(ContainSubstring("retryPolicy.rateLimitErrorRetries is already set by RoutingPolicy z-older"))
+
+		_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(older)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reconciler.Get(ctx, client.ObjectKeyFromObject(older), policy)).To(Succeed())
+		Expect(readyCondition(policy).Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("refuses to apply to an instance in another namespace", func() {
+		policy := createTestRoutingPolicy()
+		policy.Spec.InstanceRef.Namespace = "other-team"
+		instance := createTestInstance()
+		instance.Namespace = "other-team"
+		reconciler := setupTestRoutingPolicyReconciler(policy, instance,
+			createTestModel("gpt-4o", "gpt-4o"), createTestModel("claude-sonnet", "claude-sonnet-4"))
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(reconciler.Get(ctx, request.NamespacedName, policy)).To(Succeed())
+		Expect(readyCondition(policy).Status).To(Equal(metav1.ConditionFalse))
+		Expect(readyCondition(policy).Reason).To(Equal(base.ReasonInvalidSpec))
+
+		policies, err := common.RoutingPoliciesForInstance(ctx, reconciler.Client, instance)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policies).To(BeEmpty())
+	})
+
+	It("reports a missing instance as a dependency that is not ready", func() {
+		reconciler := setupTestRoutingPolicyReconciler(createTestRoutingPolicy(),
+			createTestModel("gpt-4o", "gpt-4o"), createTestModel("claude-sonnet", "claude-sonnet-4"))
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		policy := &litellmv1alpha1.RoutingPolicy{}
+		Expect(reconciler.Get(ctx, request.NamespacedName, policy)).To(Succeed())
+		Expect(readyCondition(policy).Reason).To(Equal(base.ReasonDependencyNotReady))
+	})
+})
@@ -20,17 +20,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"strconv"
 	"time"
 
 	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
 	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
 	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
 	litellm "github.com/bbdsoftware/litellm-operator/internal/litellm"
 	"github.com/bbdsoftware/litellm-operator/internal/util"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -62,6 +68,10 @@ type ExternalData struct {
 // +kubebuilder:rbac:groups=auth.litellm.ai,resources=teams,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=auth.litellm.ai,resources=teams/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=auth.litellm.ai,resources=teams/finalizers,verbs=update
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=organizations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=budgets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=guardrails,verbs=get;list;watch
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=mcpservers,verbs=get;list;watch
 
 // Reconcile implements the single-loop ensure* pattern with finalizer, conditions, and drift sync
 func (r *TeamReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -113,12 +123,18 @@ func (r *TeamReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		return res, err
 	}
 
-	// Phase 6: Ensure in-cluster children (owned -> GC on delete)
+	// Phase 6: Sync the logging callbacks of the team
+	if err := r.ensureLoggingCallbacks(ctx, team); err != nil {
+		log.Error(err, "Failed to sync team logging callbacks")
+		return r.HandleErrorRetryable(ctx, team, err, base.ReasonLitellmError)
+	}
+
+	// Phase 7: Ensure in-cluster children (owned -> GC on delete)
 	if err := r.ensureChildren(ctx, team, &externalData); err != nil {
 		return r.HandleCommonErrors(ctx, team, err)
 	}
 
-	// Phase 7: Mark Ready and persist ObservedGeneration
+	// Phase 8: Mark Ready and persist ObservedGeneration
 	r.SetSuccessConditions(team, "Team is in desired state")
 	team.Status.ObservedGeneration = team.GetGeneration()
 	if err := r.PatchStatus(ctx, team); err != nil {
@@ -126,7 +142,7 @@ func (r *TeamReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		return ctrl.Result{}, err
 	}
 
-	// Phase 8: Periodic drift sync (external might change out of band)
+	// Phase 9: Periodic drift sync (external might change out of band)
 	return ctrl.Result{RequeueAfter: 60 * time.Second}, nil
 }
 
@@ -195,6 +211,47 @@ func (r *TeamReconciler) ensureExternal(ctx context.Context, team *authv1alpha1.
 		return r.HandleErrorRetryable(ctx, team, err, base.ReasonInvalidSpec)
 	}
 
+	// Resolve the referenced Organization to its LiteLLM ID
+	if team.Spec.OrganizationRef != nil {
+		organizationID, err := r.resolveOrganizationID(ctx, team)
+		if err != nil {
+			log.Error(err, "Failed to resolve organizationRef", "organizationRef", team.Spec.OrganizationRef.Name)
+			return r.HandleErrorRetryable(ctx, team, err, base.ReasonDependencyNotReady)
+		}
+		teamRequest.OrganizationID = organizationID
+	}
+
+	// Apply the limits of the referenced Budget
+	if team.Spec.BudgetRef != nil {
+		budget, err := common.ResolveBudgetRef(ctx, r.Client, team.Spec.BudgetRef, team.Namespace)
+		if err != nil {
+			log.Error(err, "Failed to resolve budgetRef", "budgetRef", team.Spec.BudgetRef.Name)
+			return r.HandleErrorRetryable(ctx, team, err, base.ReasonDependencyNotReady)
+		}
+		if err := budget.RejectLimits(common.BudgetLimitMaxParallelRequests, common.BudgetLimitModelMaxBudget, common.BudgetLimitSoftBudget); err != nil {
+			log.Error(err, "Referenced Budget sets limits a team cannot apply", "budgetRef", team.Spec.BudgetRef.Name)
+			return r.HandleErrorRetryable(ctx, team, err, base.ReasonConfigError)
+		}
+		teamRequest.BudgetDuration = budget.BudgetDuration
+		teamRequest.MaxBudget = budget.MaxBudget
+		teamRequest.RPMLimit = budget.RPMLimit
+		teamRequest.TPMLimit = budget.TPMLimit
+	}
+
+	// Check that the referenced guardrails exist
+	if err := common.ValidateGuardrails(ctx, r.Client, r.LitellmClient, team.Spec.Guardrails); err != nil {
+		log.Error(err, "Failed to validate guardrails", "guardrails", team.Spec.Guardrails)
+		return r.HandleErrorRetryable(ctx, team, err, base.ReasonDependencyNotReady)
+	}
+
+	// Grant access to the MCPServers that allow the team
+	objectPermission, err := common.MCPServerPermission(ctx, r.Client, team, team.Spec.ConnectionRef, team.Status.MCPServers, common.MCPServerAllowedTeams)
+	if err != nil {
+		log.Error(err, "Failed to resolve MCP server access")
+		return r.HandleErrorRetryable(ctx, team, err, base.ReasonReconcileError)
+	}
+	teamRequest.ObjectPermission = objectPermission
+
 	// Check if team exists by alias
 	existingTeamID, err := r.LitellmClient.GetTeamID(ctx, team.Spec.TeamAlias)
 	if err != nil {
@@ -223,6 +280,7 @@ func (r *TeamReconciler) ensureExternal(ctx context.Context, team *authv1alpha1.
 		externalData.TeamAlias = createResponse.TeamAlias
 
 		r.updateTeamStatus(team, createResponse)
+		team.Status.MCPServers = common.MCPServerIDs(objectPermission)
 		if err := r.PatchStatus(ctx, team); err != nil {
 			log.Error(err, "Failed to update status after creation")
 			return r.HandleErrorRetryable(ctx, team, err, base.ReasonReconcileError)
@@ -265,6 +323,7 @@ func (r *TeamReconciler) ensureExternal(ctx context.Context, team *authv1alpha1.
 		externalData.TeamAlias = updateResponse.TeamAlias
 
 		r.updateTeamStatus(team, updateResponse)
+		team.Status.MCPServers = common.MCPServerIDs(objectPermission)
 		if err := r.PatchStatus(ctx, team); err != nil {
 			log.Error(err, "Failed to update status after update")
 			return r.HandleErrorRetryable(ctx, team, err, base.ReasonReconcileError)
@@ -272,11 +331,107 @@ func (r *TeamReconciler) ensureExternal(ctx context.Context, team *authv1alpha1.
 		log.Info("Successfully repaired drift in LiteLLM", "teamID", team.Status.TeamID)
 	} else {
 		log.V(1).Info("Team is up to date in LiteLLM", "teamID", team.Status.TeamID)
+		team.Status.MCPServers = common.MCPServerIDs(objectPermission)
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// ensureLoggingCallbacks syncs the logging callbacks of the team through the team callback API. Callbacks can
+// only be added one at a time, so any difference disables the team's logging before adding them all again.
+// Callbacks synced by the operator are disabled once loggingCallbacks is removed from the spec.
+func (r *TeamReconciler) ensureLoggingCallbacks(ctx context.Context, team *authv1alpha1.Team) error {
+	if team.Status.TeamID == "" {
+		return nil
+	}
+	log := log.FromContext(ctx)
+
+	loggingCallbacks := team.Spec.LoggingCallbacks
+	if loggingCallbacks == nil {
+		// The checksum is only recorded once the operator has synced the callbacks, others are left untouched
+		if team.Status.CallbackVarsChecksum == "" {
+			return nil
+		}
+		log.Info("Disabling team logging callbacks removed from the spec", "teamID", team.Status.TeamID)
+		if err := r.LitellmClient.DisableTeamLogging(ctx, team.Status.TeamID); err != nil {
+			return err
+		}
+		team.Status.CallbackVarsChecksum = ""
+		return nil
+	}
+
+	callbackVars := make(map[string]string, len(loggingCallbacks.Vars))
+	maps.Copy(callbackVars, loggingCallbacks.Vars)
+	var secretVars map[string]string
+	if loggingCallbacks.VarsSecretName != "" {
+		var err error
+		secretVars, err = util.GetMapFromSecret(ctx, r.Client, client.ObjectKey{Namespace: team.Namespace, Name: loggingCallbacks.VarsSecretName})
+		if err != nil {
+			return err
+		}
+		maps.Copy(callbackVars, secretVars)
+	}
+	// LiteLLM may mask secret callback variables, so a rotated secret is detected through its checksum
+	secretChecksum := common.SecretChecksum(secretVars)
+
+	observed, err := r.LitellmClient.GetTeamCallbacks(ctx, team.Status.TeamID)
+	if err != nil {
+		return err
+	}
+
+	var successCallbacks, failureCallbacks []string
+	for _, callback := range loggingCallbacks.Callbacks {
+		if teamCallbackType(callback) != "failure" {
+			successCallbacks = append(successCallbacks, callback.Name)
+		}
+		if teamCallbackType(callback) != "success" {
+			failureCallbacks = append(failureCallbacks, callback.Name)
+		}
+	}
+	observedVars := make(map[string]string, len(loggingCallbacks.Vars))
+	for key := range loggingCallbacks.Vars {
+		if value, ok := observed.CallbackVars[key]; ok {
+			observedVars[key] = value
+		}
+	}
+
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	if cmp.Equal(observed.SuccessCallbacks, successCallbacks, cmpopts.EquateEmpty(), sortStrings) &&
+		cmp.Equal(observed.FailureCallbacks, failureCallbacks, cmpopts.EquateEmpty(), sortStrings) &&
+		cmp.Equal(observedVars, loggingCallbacks.Vars, cmpopts.EquateEmpty()) &&
+		team.Status.CallbackVarsChecksum == secretChecksum {
+		log.V(1).Info("Team logging callbacks are up to date in LiteLLM", "teamID", team.Status.TeamID)
+		return nil
+	}
+
+	log.Info("Repairing team logging callbacks in LiteLLM", "teamID", team.Status.TeamID)
+	if len(observed.SuccessCallbacks) > 0 || len(observed.FailureCallbacks) > 0 {
+		if err := r.LitellmClient.DisableTeamLogging(ctx, team.Status.TeamID); err != nil {
+			return err
+		}
+	}
+	for _, callback := range loggingCallbacks.Callbacks {
+		if err := r.LitellmClient.AddTeamCallback(ctx, team.Status.TeamID, &litellm.TeamCallbackRequest{
+			CallbackName: callback.Name,
+			CallbackType: teamCallbackType(callback),
+			CallbackVars: callbackVars,
+		}); err != nil {
+			return err
+		}
+	}
+
+	team.Status.CallbackVarsChecksum = secretChecksum
+	return nil
+}
+
+// teamCallbackType returns when the callback runs, defaulting to both successful and failed requests
+func teamCallbackType(callback authv1alpha1.TeamCallback) string {
+	if callback.Type == "" {
+		return "success_and_failure"
+	}
+	return callback.Type
+}
+
 // ensureChildren manages in-cluster child resources (teams don't have children currently)
 func (r *TeamReconciler) ensureChildren(ctx context.Context, team *authv1alpha1.Team, externalData *ExternalData) error {
 	// Teams don't currently have child resources, but this follows the pattern
@@ -312,6 +467,24 @@ func (r *TeamReconciler) convertToTeamRequest(team *authv1alpha1.Team) (litellm.
 	return teamRequest, nil
 }
 
+// resolveOrganizationID returns the LiteLLM ID of the Organization referenced by the team
+func (r *TeamReconciler) resolveOrganizationID(ctx context.Context, team *authv1alpha1.Team) (string, error) {
+	namespace := team.Spec.OrganizationRef.Namespace
+	if namespace == "" {
+		namespace = team.Namespace
+	}
+
+	organization := &authv1alpha1.Organization{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: team.Spec.OrganizationRef.Name}, organization); err != nil {
+		return "", fmt.Errorf("failed to get organization %s/%s: %w", namespace, team.Spec.OrganizationRef.Name, err)
+	}
+	if organization.Status.OrganizationID == "" {
+		return "", fmt.Errorf("organization %s/%s has not been created in LiteLLM yet", namespace, organization.Name)
+	}
+
+	return organization.Status.OrganizationID, nil
+}
+
 // updateTeamStatus updates the status of the k8s Team from the litellm response
 func (r *TeamReconciler) updateTeamStatus(team *authv1alpha1.Team, teamResponse litellm.TeamResponse) {
 	team.Status.Blocked = teamResponse.Blocked
@@ -349,9 +522,37 @@ func convertToK8sTeamMemberWithRole(membersWithRole []litellm.TeamMemberWithRole
 }
 
 func (r *TeamReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index the connection and callback variables secrets so that rotating either re-reconciles the Teams using them
+	if err := common.IndexSecretRefs(mgr, &authv1alpha1.Team{}, func(obj client.Object) []string {
+		team := obj.(*authv1alpha1.Team)
+		values := common.ConnectionSecretIndexValues(team.Spec.ConnectionRef, team.Namespace)
+		if team.Spec.LoggingCallbacks != nil && team.Spec.LoggingCallbacks.VarsSecretName != "" {
+			values = append(values, common.SecretIndexValue(team.Namespace, team.Spec.LoggingCallbacks.VarsSecretName))
+		}
+		return values
+	}); err != nil {
+		return err
+	}
+
+	// Index the referenced Budget so that changing it re-reconciles the Teams using it
+	if err := common.IndexBudgetRefs(mgr, &authv1alpha1.Team{}, func(obj client.Object) []string {
+		team := obj.(*authv1alpha1.Team)
+		return common.BudgetRefIndexValues(team.Spec.BudgetRef, team.Namespace)
+	}); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&authv1alpha1.Team{}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		For(&authv1alpha1.Team{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{},
+			common.EnqueueForSecret(mgr.GetClient(), func() client.ObjectList { return &authv1alpha1.TeamList{} }),
+			builder.WithPredicates(common.SecretDataChangedPredicate())).
+		Watches(&authv1alpha1.Budget{},
+			common.EnqueueForBudget(mgr.GetClient(), func() client.ObjectList { return &authv1alpha1.TeamList{} }),
+			builder.WithPredicates(common.BudgetChangedPredicate())).
+		Watches(&litellmv1alpha1.MCPServer{},
+			common.EnqueueForMCPServer(common.MCPServerAllowedTeams),
+			builder.WithPredicates(common.MCPServerAccessChangedPredicate())).
 		Named("litellm-team").
 		Complete(r)
 }
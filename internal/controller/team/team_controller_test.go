@@ -47,6 +47,9 @@ type mockLitellmTeamClient struct {
 	getTeamFunc        func(ctx context.Context, teamID string) (litellm.TeamResponse, error)
 	getTeamIDFunc      func(ctx context.Context, teamAlias string) (string, error)
 	isUpdateNeededFunc func(ctx context.Context, observed *litellm.TeamResponse, desired *litellm.TeamRequest) bool
+	callbacks          litellm.TeamCallbacksResponse
+	addedCallbacks     []litellm.TeamCallbackRequest
+	loggingDisabled    bool
 }
 
 func (m *mockLitellmTeamClient) CreateTeam(ctx context.Context, req *litellm.TeamRequest) (litellm.TeamResponse, error) {
@@ -150,6 +153,32 @@ func (m *mockLitellmTeamClient) SetTeamBlockedState(ctx context.Context, teamID
 	return nil
 }
 
+func (m *mockLitellmTeamClient) ListGuardrails(ctx context.Context) ([]litellm.GuardrailResponse, error) {
+	return nil, nil
+}
+
+func (m *mockLitellmTeamClient) GetTeamCallbacks(ctx context.Context, teamID string) (litellm.TeamCallbacksResponse, error) {
+	return m.callbacks, nil
+}
+
+func (m *mockLitellmTeamClient) AddTeamCallback(ctx context.Context, teamID string, req *litellm.TeamCallbackRequest) error {
+	m.addedCallbacks = append(m.addedCallbacks, *req)
+	if req.CallbackType != "failure" {
+		m.callbacks.SuccessCallbacks = append(m.callbacks.SuccessCallbacks, req.CallbackName)
+	}
+	if req.CallbackType != "success" {
+		m.callbacks.FailureCallbacks = append(m.callbacks.FailureCallbacks, req.CallbackName)
+	}
+	m.callbacks.CallbackVars = req.CallbackVars
+	return nil
+}
+
+func (m *mockLitellmTeamClient) DisableTeamLogging(ctx context.Context, teamID string) error {
+	m.loggingDisabled = true
+	m.callbacks = litellm.TeamCallbacksResponse{}
+	return nil
+}
+
 // Helper function to create test team
 func createTestTeam() *authv1alpha1.Team {
 	const testTeamName = "test-team"
@@ -367,6 +396,147 @@ var _ = Describe("Team Controller", func() {
 		})
 	})
 
+	Context("Organization reference behaviour", func() {
+		const organizationName = "test-organization"
+
+		AfterEach(func() {
+			organization := &authv1alpha1.Organization{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Name: organizationName, Namespace: namespace}, organization); err == nil {
+				Expect(k8sClient.Delete(ctx, organization)).To(Succeed())
+			}
+		})
+
+		createTeamWithOrganizationRef := func() {
+			team := createTestTeam()
+			team.Spec.OrganizationID = ""
+			team.Spec.OrganizationRef = &authv1alpha1.CRDRef{Name: organizationName}
+			Expect(k8sClient.Create(ctx, team)).To(Succeed())
+		}
+
+		It("creates the team under the referenced organization's ID", func() {
+			By("creating an organization that has been synced to LiteLLM")
+			organization := &authv1alpha1.Organization{
+				ObjectMeta: metav1.ObjectMeta{Name: organizationName, Namespace: namespace},
+				Spec: authv1alpha1.OrganizationSpec{
+					ConnectionRef:     createTestTeam().Spec.ConnectionRef,
+					OrganizationAlias: "test-organization-alias",
+				},
+			}
+			Expect(k8sClient.Create(ctx, organization)).To(Succeed())
+			organization.Status.OrganizationID = "org-1234"
+			Expect(k8sClient.Status().Update(ctx, organization)).To(Succeed())
+
+			createTeamWithOrganizationRef()
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedTeam := &authv1alpha1.Team{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updatedTeam)).To(Succeed())
+			Expect(updatedTeam.Status.OrganizationID).To(Equal("org-1234"))
+			assertCondition(updatedTeam.Status.Conditions, base.CondReady, metav1.ConditionTrue, base.ReasonReady)
+		})
+
+		It("waits for the referenced organization to exist", func() {
+			createTeamWithOrganizationRef()
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			Expect(mockClient.teams).To(BeEmpty())
+
+			updatedTeam := &authv1alpha1.Team{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updatedTeam)).To(Succeed())
+			assertCondition(updatedTeam.Status.Conditions, base.CondDegraded, metav1.ConditionTrue, base.ReasonDependencyNotReady)
+		})
+	})
+
+	Context("Logging callbacks behaviour", func() {
+		const varsSecretName = "test-langfuse-keys"
+
+		AfterEach(func() {
+			secret := &corev1.Secret{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Name: varsSecretName, Namespace: namespace}, secret); err == nil {
+				Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+			}
+		})
+
+		It("adds the callbacks with the secret variables and repairs drift", func() {
+			Expect(k8sClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: varsSecretName, Namespace: namespace},
+				Data:       map[string][]byte{"langfuse_secret_key": []byte("sk-lf-test")},
+			})).To(Succeed())
+
+			team := createTestTeam()
+			team.Spec.LoggingCallbacks = &authv1alpha1.TeamLoggingCallbacks{
+				Callbacks:      []authv1alpha1.TeamCallback{{Name: "langfuse", Type: "success"}},
+				Vars:           map[string]string{"langfuse_host": "https://cloud.langfuse.com"},
+				VarsSecretName: varsSecretName,
+			}
+			Expect(k8sClient.Create(ctx, team)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockClient.addedCallbacks).To(HaveLen(1))
+			Expect(mockClient.addedCallbacks[0].CallbackName).To(Equal("langfuse"))
+			Expect(mockClient.addedCallbacks[0].CallbackType).To(Equal("success"))
+			Expect(mockClient.addedCallbacks[0].CallbackVars).To(Equal(map[string]string{
+				"langfuse_host":       "https://cloud.langfuse.com",
+				"langfuse_secret_key": "sk-lf-test",
+			}))
+			Expect(mockClient.loggingDisabled).To(BeFalse())
+
+			updatedTeam := &authv1alpha1.Team{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updatedTeam)).To(Succeed())
+			Expect(updatedTeam.Status.CallbackVarsChecksum).NotTo(BeEmpty())
+
+			By("leaving callbacks that are in sync untouched")
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.addedCallbacks).To(HaveLen(1))
+
+			By("replacing callbacks changed outside the operator")
+			mockClient.callbacks.SuccessCallbacks = append(mockClient.callbacks.SuccessCallbacks, "datadog")
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.loggingDisabled).To(BeTrue())
+			Expect(mockClient.callbacks.SuccessCallbacks).To(Equal([]string{"langfuse"}))
+		})
+
+		It("disables the callbacks once they are removed from the spec", func() {
+			team := createTestTeam()
+			team.Spec.LoggingCallbacks = &authv1alpha1.TeamLoggingCallbacks{
+				Callbacks: []authv1alpha1.TeamCallback{{Name: "langfuse"}},
+			}
+			Expect(k8sClient.Create(ctx, team)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.callbacks.SuccessCallbacks).To(Equal([]string{"langfuse"}))
+
+			By("removing the logging callbacks")
+			updatedTeam := &authv1alpha1.Team{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updatedTeam)).To(Succeed())
+			updatedTeam.Spec.LoggingCallbacks = nil
+			Expect(k8sClient.Update(ctx, updatedTeam)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.loggingDisabled).To(BeTrue())
+			Expect(mockClient.callbacks.SuccessCallbacks).To(BeEmpty())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updatedTeam)).To(Succeed())
+			Expect(updatedTeam.Status.CallbackVarsChecksum).To(BeEmpty())
+
+			By("leaving the callbacks alone afterwards")
+			mockClient.loggingDisabled = false
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.loggingDisabled).To(BeFalse())
+		})
+	})
+
 	Context("Error handling behaviour", func() {
 		It("handles LiteLLM connection errors gracefully", func() {
 			By("setting up mock to return connection error")
@@ -32,8 +32,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
@@ -69,7 +69,11 @@ type ExternalData struct {
 // +kubebuilder:rbac:groups=auth.litellm.ai,resources=users,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=auth.litellm.ai,resources=users/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=auth.litellm.ai,resources=users/finalizers,verbs=update
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=budgets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=clustervaultstores,verbs=get;list;watch
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=guardrails,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=external-secrets.io,resources=pushsecrets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile implements the single-loop ensure* pattern with finalizer, conditions, and drift sync
 func (r *UserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -123,9 +127,23 @@ func (r *UserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 
 	// Phase 6: Ensure in-cluster children (owned -> GC on delete)
 	if err := r.ensureChildren(ctx, user, &externalData); err != nil {
+		if errors.Is(err, common.ErrKeySecretConflict) {
+			return r.handleKeySecretError(ctx, user, err)
+		}
 		return r.HandleCommonErrors(ctx, user, err)
 	}
 
+	// Phase 6b: Write the key Secret to the external secret store
+	written, err := common.SyncSecretStore(ctx, r.Client, r.Scheme, user, user.Spec.SecretStoreRef,
+		user.Status.KeySecretRef, user.Spec.KeyAlias, secretStoreEntry(user))
+	user.Status.SecretStoreRef = written.Ref
+	user.Status.SecretStorePath = written.Path
+	user.Status.SecretStoreChecksum = written.Checksum
+	if err != nil {
+		log.Error(err, "Failed to write key to the secret store")
+		return r.HandleErrorRetryable(ctx, user, err, base.ReasonReconcileError)
+	}
+
 	// Phase 7: Mark Ready and persist ObservedGeneration
 	r.SetSuccessConditions(user, "User is in desired state")
 	user.Status.ObservedGeneration = user.GetGeneration()
@@ -170,6 +188,10 @@ func (r *UserReconciler) reconcileDelete(ctx context.Context, user *authv1alpha1
 	}
 
 	// Idempotent external cleanup
+	if err := common.RemoveFromSecretStore(ctx, r.Client, r.Scheme, user, user.Status.KeySecretRef, secretStoreEntry(user)); err != nil {
+		log.Error(err, "Failed to remove key from the secret store")
+		return r.HandleErrorRetryable(ctx, user, err, base.ReasonDeleteFailed)
+	}
 	if user.Status.UserID != "" {
 		if err := r.LitellmClient.DeleteUser(ctx, user.Status.UserID); err != nil {
 			log.Error(err, "Failed to delete user from LiteLLM")
@@ -215,8 +237,39 @@ func (r *UserReconciler) ensureExternal(ctx context.Context, user *authv1alpha1.
 		return r.HandleErrorRetryable(ctx, user, err, base.ReasonInvalidSpec)
 	}
 
+	// Apply the limits of the referenced Budget
+	if user.Spec.BudgetRef != nil {
+		budget, err := common.ResolveBudgetRef(ctx, r.Client, user.Spec.BudgetRef, user.Namespace)
+		if err != nil {
+			log.Error(err, "Failed to resolve budgetRef", "budgetRef", user.Spec.BudgetRef.Name)
+			return r.HandleErrorRetryable(ctx, user, err, base.ReasonDependencyNotReady)
+		}
+		if err := budget.RejectLimits(common.BudgetLimitModelMaxBudget); err != nil {
+			log.Error(err, "Referenced Budget sets limits a user cannot apply", "budgetRef", user.Spec.BudgetRef.Name)
+			return r.HandleErrorRetryable(ctx, user, err, base.ReasonConfigError)
+		}
+		desiredUser.BudgetDuration = budget.BudgetDuration
+		desiredUser.MaxBudget = budget.MaxBudget
+		desiredUser.MaxParallelRequests = budget.MaxParallelRequests
+		desiredUser.RPMLimit = budget.RPMLimit
+		desiredUser.SoftBudget = budget.SoftBudget
+		desiredUser.TPMLimit = budget.TPMLimit
+	}
+
+	// Check that the referenced guardrails exist
+	if err := common.ValidateGuardrails(ctx, r.Client, r.LitellmClient, user.Spec.Guardrails); err != nil {
+		log.Error(err, "Failed to validate guardrails", "guardrails", user.Spec.Guardrails)
+		return r.HandleErrorRetryable(ctx, user, err, base.ReasonDependencyNotReady)
+	}
+
 	// Create if no external ID exists
 	if user.Status.UserID == "" {
+		// The key of the user is only shown once, so the user is created once it can be written to the key Secret
+		if desiredUser.KeyAlias != "" {
+			if err := common.CheckKeySecretAvailable(ctx, r.Client, user, r.keySecretName(user)); err != nil {
+				return r.handleKeySecretError(ctx, user, err)
+			}
+		}
 		log.Info("Creating new user in LiteLLM", "userAlias", user.Spec.UserAlias)
 		createResponse, err := r.LitellmClient.CreateUser(ctx, &desiredUser)
 		if err != nil {
@@ -229,7 +282,11 @@ func (r *UserReconciler) ensureExternal(ctx context.Context, user *authv1alpha1.
 		externalData.UserRole = createResponse.UserRole
 		externalData.Key = createResponse.Key
 
-		r.updateUserStatus(user, createResponse, desiredUser.KeyAlias)
+		secretName := ""
+		if desiredUser.KeyAlias != "" {
+			secretName = r.keySecretName(user)
+		}
+		r.updateUserStatus(user, createResponse, secretName)
 		if err := r.PatchStatus(ctx, user); err != nil {
 			log.Error(err, "Failed to update status after creation")
 			return r.HandleErrorRetryable(ctx, user, err, base.ReasonReconcileError)
@@ -283,35 +340,57 @@ func (r *UserReconciler) ensureExternal(ctx context.Context, user *authv1alpha1.
 
 // ensureChildren manages in-cluster child resources using CreateOrUpdate pattern
 func (r *UserReconciler) ensureChildren(ctx context.Context, user *authv1alpha1.User, externalData *ExternalData) error {
-	// the VirtualKey is never shown again after the User is created, so prevent the secret from being reset to an empty string
-	if user.Status.KeySecretRef == "" || externalData.Key == "" {
+	if user.Status.KeySecretRef == "" {
 		return nil // No secret to create
 	}
 
-	secretName := r.litellmResourceNaming.GenerateSecretName(user.Spec.KeyAlias)
-	secret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      secretName,
-			Namespace: user.Namespace,
-		},
+	values := common.KeySecretValues{
+		Key:      externalData.Key,
+		KeyAlias: user.Spec.KeyAlias,
+		UserID:   externalData.UserID,
 	}
-
-	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
-		// Set controller reference for garbage collection
-		if err := controllerutil.SetControllerReference(user, secret, r.Scheme); err != nil {
+	if len(user.Spec.Teams) > 0 {
+		values.TeamID = user.Spec.Teams[0]
+	}
+	if user.Spec.SecretTemplate != nil && len(user.Spec.SecretTemplate.Data) > 0 {
+		url, err := common.ConnectionURL(ctx, r.Client, user.Spec.ConnectionRef, user.Namespace)
+		if err != nil {
 			return err
 		}
+		values.URL = url
+	}
 
-		// Update secret data
-		if secret.Data == nil {
-			secret.Data = make(map[string][]byte)
-		}
-		secret.Data["key"] = []byte(externalData.Key)
+	// the VirtualKey is never shown again after the User is created, so the secret is only written when the key is known
+	secretName := r.keySecretName(user)
+	if err := common.EnsureKeySecret(ctx, r.Client, r.Scheme, user, secretName, user.Status.KeySecretRef, user.Spec.SecretTemplate, values); err != nil {
+		return err
+	}
+	user.Status.KeySecretRef = secretName
+	return nil
+}
 
-		return nil
-	})
+// handleKeySecretError reports a key Secret that cannot be written with the SecretConflict reason
+func (r *UserReconciler) handleKeySecretError(ctx context.Context, user *authv1alpha1.User, err error) (ctrl.Result, error) {
+	reason := base.ReasonReconcileError
+	if errors.Is(err, common.ErrKeySecretConflict) {
+		log.FromContext(ctx).Info("Key Secret is not controlled by the User", "error", err.Error())
+		reason = common.ReasonSecretConflict
+	}
+	return r.HandleErrorRetryable(ctx, user, err, reason)
+}
+
+// keySecretName returns the name of the Secret the auto-created key is written to
+func (r *UserReconciler) keySecretName(user *authv1alpha1.User) string {
+	return common.KeySecretName(user.Spec.SecretTemplate, r.litellmResourceNaming.GenerateSecretName(user.Spec.KeyAlias))
+}
 
-	return err
+// secretStoreEntry returns the entry the key Secret was last written to in the secret store
+func secretStoreEntry(user *authv1alpha1.User) common.SecretStoreEntry {
+	return common.SecretStoreEntry{
+		Ref:      user.Status.SecretStoreRef,
+		Path:     user.Status.SecretStorePath,
+		Checksum: user.Status.SecretStoreChecksum,
+	}
 }
 
 // convertToUserRequest creates a UserRequest from a User (isolated for testing)
@@ -401,9 +480,30 @@ func (r *UserReconciler) updateUserStatus(user *authv1alpha1.User, userResponse
 }
 
 func (r *UserReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index the connection secret so that rotating it re-reconciles the Users using it
+	if err := common.IndexSecretRefs(mgr, &authv1alpha1.User{}, func(obj client.Object) []string {
+		user := obj.(*authv1alpha1.User)
+		return common.ConnectionSecretIndexValues(user.Spec.ConnectionRef, user.Namespace)
+	}); err != nil {
+		return err
+	}
+
+	// Index the referenced Budget so that changing it re-reconciles the Users using it
+	if err := common.IndexBudgetRefs(mgr, &authv1alpha1.User{}, func(obj client.Object) []string {
+		user := obj.(*authv1alpha1.User)
+		return common.BudgetRefIndexValues(user.Spec.BudgetRef, user.Namespace)
+	}); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&authv1alpha1.User{}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		For(&authv1alpha1.User{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{},
+			common.EnqueueForSecret(mgr.GetClient(), func() client.ObjectList { return &authv1alpha1.UserList{} }),
+			builder.WithPredicates(common.SecretDataChangedPredicate())).
+		Watches(&authv1alpha1.Budget{},
+			common.EnqueueForBudget(mgr.GetClient(), func() client.ObjectList { return &authv1alpha1.UserList{} }),
+			builder.WithPredicates(common.BudgetChangedPredicate())).
 		Named("litellm-user").
 		Complete(r)
 }
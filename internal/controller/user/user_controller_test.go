@@ -84,6 +84,10 @@ func (l *FakeLitellmUserClient) UpdateUser(ctx context.Context, req *litellm.Use
 	return fakeUserResponse, nil
 }
 
+func (l *FakeLitellmUserClient) ListGuardrails(ctx context.Context) ([]litellm.GuardrailResponse, error) {
+	return nil, nil
+}
+
 var _ = Describe("User Controller", func() {
 	Context("When reconciling a resource", func() {
 		const resourceName = "test-resource"
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
@@ -28,12 +29,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
 	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
 	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
 	"github.com/bbdsoftware/litellm-operator/internal/litellm"
@@ -64,15 +66,23 @@ func NewVirtualKeyReconciler(client client.Client, scheme *runtime.Scheme) *Virt
 }
 
 type ExternalData struct {
-	Key      string `json:"key"`
-	KeyAlias string `json:"keyAlias"`
-	KeyID    string `json:"keyID"`
+	Key         string `json:"key"`
+	KeyAlias    string `json:"keyAlias"`
+	KeyID       string `json:"keyID"`
+	KeyHash     string `json:"keyHash"`
+	PreviousKey string `json:"previousKey"`
 }
 
 // +kubebuilder:rbac:groups=auth.litellm.ai,resources=virtualkeys,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=auth.litellm.ai,resources=virtualkeys/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=auth.litellm.ai,resources=virtualkeys/finalizers,verbs=update
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=budgets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=clustervaultstores,verbs=get;list;watch
+// +kubebuilder:rbac:groups=auth.litellm.ai,resources=keydeliverygrants,verbs=get;list;watch
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=guardrails,verbs=get;list;watch
+// +kubebuilder:rbac:groups=litellm.litellm.ai,resources=mcpservers,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=external-secrets.io,resources=pushsecrets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile implements the single-loop ensure* pattern with finalizer, conditions, and drift sync
 func (r *VirtualKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -124,11 +134,43 @@ func (r *VirtualKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return res, err
 	}
 
+	// Phase 5b: Rotate the key when due and revoke the previous key after its grace period
+	if res, err := r.ensureRotation(ctx, virtualKey, &externalData); res.RequeueAfter > 0 || err != nil {
+		r.InstrumentReconcileError()
+		return res, err
+	}
+
+	// Phase 5c: Check the key Secret still holds the key, regenerating a lost key under the recovery policy
+	if res, err := r.ensureKeyInSync(ctx, virtualKey, &externalData); res.RequeueAfter > 0 || err != nil {
+		r.InstrumentReconcileError()
+		return res, err
+	}
+
 	// Phase 6: Ensure in-cluster children (owned -> GC on delete)
 	if err := r.ensureChildren(ctx, virtualKey, &externalData); err != nil {
+		if errors.Is(err, common.ErrKeySecretConflict) {
+			return r.handleKeySecretError(ctx, virtualKey, err)
+		}
 		return r.HandleCommonErrors(ctx, virtualKey, err)
 	}
 
+	// Phase 6b: Write the key Secret to the external secret store
+	written, err := common.SyncSecretStore(ctx, r.Client, r.Scheme, virtualKey, virtualKey.Spec.SecretStoreRef,
+		virtualKey.Status.KeySecretRef, virtualKey.Spec.KeyAlias, secretStoreEntry(virtualKey))
+	virtualKey.Status.SecretStoreRef = written.Ref
+	virtualKey.Status.SecretStorePath = written.Path
+	virtualKey.Status.SecretStoreChecksum = written.Checksum
+	if err != nil {
+		log.Error(err, "Failed to write key to the secret store")
+		return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonReconcileError)
+	}
+
+	// Phase 6c: Copy the key Secret into the target namespaces that grant it
+	if err := r.ensureDelivery(ctx, virtualKey); err != nil {
+		log.Error(err, "Failed to deliver key Secret to target namespaces")
+		return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonReconcileError)
+	}
+
 	// Phase 7: Mark Ready and persist ObservedGeneration
 	r.SetSuccessConditions(virtualKey, "VirtualKey is in desired state")
 	virtualKey.Status.ObservedGeneration = virtualKey.GetGeneration()
@@ -137,14 +179,50 @@ func (r *VirtualKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	// Phase 8: Periodic drift sync (external might change out of band)
-	return ctrl.Result{RequeueAfter: 60 * time.Second}, nil
+	// Phase 8: Periodic drift sync (external might change out of band), or earlier when a rotation is due
+	return ctrl.Result{RequeueAfter: requeueAfter(virtualKey)}, nil
 }
 
 func (r *VirtualKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index the connection secret so that rotating it re-reconciles the VirtualKeys using it
+	if err := common.IndexSecretRefs(mgr, &authv1alpha1.VirtualKey{}, func(obj client.Object) []string {
+		virtualKey := obj.(*authv1alpha1.VirtualKey)
+		return common.ConnectionSecretIndexValues(virtualKey.Spec.ConnectionRef, virtualKey.Namespace)
+	}); err != nil {
+		return err
+	}
+
+	// Index the referenced Budget so that changing it re-reconciles the VirtualKeys using it
+	if err := common.IndexBudgetRefs(mgr, &authv1alpha1.VirtualKey{}, func(obj client.Object) []string {
+		virtualKey := obj.(*authv1alpha1.VirtualKey)
+		return common.BudgetRefIndexValues(virtualKey.Spec.BudgetRef, virtualKey.Namespace)
+	}); err != nil {
+		return err
+	}
+
+	// Index the target namespaces so that granting delivery into a namespace re-reconciles the VirtualKeys targeting it
+	if err := common.IndexTargetNamespaces(mgr, &authv1alpha1.VirtualKey{}, func(obj client.Object) []string {
+		return obj.(*authv1alpha1.VirtualKey).Spec.TargetNamespaces
+	}); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&authv1alpha1.VirtualKey{}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		For(&authv1alpha1.VirtualKey{}, builder.WithPredicates(predicate.Or[client.Object](
+			predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Owns(&corev1.Secret{}).
+		Watches(&corev1.Secret{},
+			common.EnqueueForSecret(mgr.GetClient(), func() client.ObjectList { return &authv1alpha1.VirtualKeyList{} }),
+			builder.WithPredicates(common.SecretDataChangedPredicate())).
+		Watches(&corev1.Secret{}, common.EnqueueForDeliveredSecret(virtualKeyKind)).
+		Watches(&authv1alpha1.KeyDeliveryGrant{},
+			common.EnqueueForKeyDeliveryGrant(mgr.GetClient(), func() client.ObjectList { return &authv1alpha1.VirtualKeyList{} })).
+		Watches(&authv1alpha1.Budget{},
+			common.EnqueueForBudget(mgr.GetClient(), func() client.ObjectList { return &authv1alpha1.VirtualKeyList{} }),
+			builder.WithPredicates(common.BudgetChangedPredicate())).
+		Watches(&litellmv1alpha1.MCPServer{},
+			common.EnqueueForMCPServer(common.MCPServerAllowedKeys),
+			builder.WithPredicates(common.MCPServerAccessChangedPredicate())).
 		Named("litellm-virtualkey").
 		Complete(r)
 }
@@ -182,6 +260,14 @@ func (r *VirtualKeyReconciler) reconcileDelete(ctx context.Context, virtualKey *
 	}
 
 	// Idempotent external cleanup
+	if err := r.removeDeliveredSecrets(ctx, virtualKey, nil); err != nil {
+		log.Error(err, "Failed to remove delivered key Secrets")
+		return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonDeleteFailed)
+	}
+	if err := common.RemoveFromSecretStore(ctx, r.Client, r.Scheme, virtualKey, virtualKey.Status.KeySecretRef, secretStoreEntry(virtualKey)); err != nil {
+		log.Error(err, "Failed to remove key from the secret store")
+		return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonDeleteFailed)
+	}
 	if virtualKey.Status.KeyAlias != "" {
 		if err := r.LitellmClient.DeleteVirtualKey(ctx, virtualKey.Status.KeyAlias); err != nil {
 			log.Error(err, "Failed to delete virtual key from LiteLLM")
@@ -218,6 +304,30 @@ func (r *VirtualKeyReconciler) ensureExternal(ctx context.Context, virtualKey *a
 		return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonInvalidSpec)
 	}
 
+	// Resolve the referenced Budget to its LiteLLM ID
+	if virtualKey.Spec.BudgetRef != nil {
+		budget, err := common.ResolveBudgetRef(ctx, r.Client, virtualKey.Spec.BudgetRef, virtualKey.Namespace)
+		if err != nil {
+			log.Error(err, "Failed to resolve budgetRef", "budgetRef", virtualKey.Spec.BudgetRef.Name)
+			return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonDependencyNotReady)
+		}
+		desiredVirtualKey.BudgetID = budget.BudgetID
+	}
+
+	// Check that the referenced guardrails exist
+	if err := common.ValidateGuardrails(ctx, r.Client, r.LitellmClient, virtualKey.Spec.Guardrails); err != nil {
+		log.Error(err, "Failed to validate guardrails", "guardrails", virtualKey.Spec.Guardrails)
+		return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonDependencyNotReady)
+	}
+
+	// Grant access to the MCPServers that allow the key
+	objectPermission, err := common.MCPServerPermission(ctx, r.Client, virtualKey, virtualKey.Spec.ConnectionRef, virtualKey.Status.MCPServers, common.MCPServerAllowedKeys)
+	if err != nil {
+		log.Error(err, "Failed to resolve MCP server access")
+		return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonReconcileError)
+	}
+	desiredVirtualKey.ObjectPermission = objectPermission
+
 	observedVirtualKeys, err := r.LitellmClient.GetVirtualKeyFromAlias(ctx, virtualKey.Spec.KeyAlias)
 	if err != nil {
 		log.Error(err, "Failed to get virtual key from LiteLLM")
@@ -227,7 +337,10 @@ func (r *VirtualKeyReconciler) ensureExternal(ctx context.Context, virtualKey *a
 	var observedVirtualKeyDetails litellm.VirtualKeyResponse
 
 	if len(observedVirtualKeys) == 0 {
-		// Create if no external key exists
+		// Create if no external key exists, once the key can be written to the key Secret
+		if err := common.CheckKeySecretAvailable(ctx, r.Client, virtualKey, r.keySecretName(virtualKey)); err != nil {
+			return r.handleKeySecretError(ctx, virtualKey, err)
+		}
 		log.Info("Creating new virtual key in LiteLLM", "keyAlias", virtualKey.Spec.KeyAlias)
 		createResponse, err := r.LitellmClient.GenerateVirtualKey(ctx, &desiredVirtualKey)
 		if err != nil {
@@ -239,8 +352,10 @@ func (r *VirtualKeyReconciler) ensureExternal(ctx context.Context, virtualKey *a
 		externalData.KeyAlias = createResponse.KeyAlias
 		externalData.KeyID = createResponse.Token
 
-		secretName := r.litellmResourceNaming.GenerateSecretName(createResponse.KeyAlias)
-		r.updateVirtualKeyStatus(virtualKey, createResponse, secretName)
+		r.updateVirtualKeyStatus(virtualKey, createResponse, r.keySecretName(virtualKey))
+		virtualKey.Status.MCPServers = common.MCPServerIDs(objectPermission)
+		// A rotate annotation set on creation does not rotate the new key
+		virtualKey.Status.RotationTrigger = virtualKey.Annotations[RotateAnnotation]
 		if err := r.PatchStatus(ctx, virtualKey); err != nil {
 			log.Error(err, "Failed to update status after creation")
 			return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonReconcileError)
@@ -248,13 +363,22 @@ func (r *VirtualKeyReconciler) ensureExternal(ctx context.Context, virtualKey *a
 		log.Info("Successfully created virtual key in LiteLLM", "keyAlias", createResponse.KeyAlias)
 		return ctrl.Result{}, nil
 	} else {
-		// Get virtual key details for existing key
+		// Get virtual key details for existing key. During a rotation grace period LiteLLM may still list
+		// the previous key under the same alias, so prefer the current key
+		observedKeyID := observedVirtualKeys[0]
+		if slices.Contains(observedVirtualKeys, virtualKey.Status.KeyID) {
+			observedKeyID = virtualKey.Status.KeyID
+		}
+		externalData.KeyID = observedKeyID
+
 		var err error
-		observedVirtualKeyDetails, err = r.LitellmClient.GetVirtualKeyInfo(ctx, observedVirtualKeys[0])
+		observedVirtualKeyDetails, err = r.LitellmClient.GetVirtualKeyInfo(ctx, observedKeyID)
 		if err != nil {
 			log.Error(err, "Failed to get virtual key info from LiteLLM")
 			return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonLitellmError)
 		}
+		// LiteLLM reports the hash of the key as its token
+		externalData.KeyHash = observedVirtualKeyDetails.Token
 	}
 
 	updateNeeded := r.LitellmClient.IsVirtualKeyUpdateNeeded(ctx, &observedVirtualKeyDetails, &desiredVirtualKey)
@@ -284,7 +408,9 @@ func (r *VirtualKeyReconciler) ensureExternal(ctx context.Context, virtualKey *a
 		}
 
 		externalData.KeyAlias = updateResponse.KeyAlias
-		externalData.KeyID = updateResponse.Token
+		if updateResponse.Token != "" {
+			externalData.KeyID = updateResponse.Token
+		}
 
 		// If the key is the same as the token, then it's clearly not the key
 		if updateResponse.Key != updateResponse.Token {
@@ -292,6 +418,7 @@ func (r *VirtualKeyReconciler) ensureExternal(ctx context.Context, virtualKey *a
 		}
 
 		r.updateVirtualKeyStatus(virtualKey, updateResponse, virtualKey.Status.KeySecretRef)
+		virtualKey.Status.MCPServers = common.MCPServerIDs(objectPermission)
 		if err := r.PatchStatus(ctx, virtualKey); err != nil {
 			log.Error(err, "Failed to update status after update")
 			return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonReconcileError)
@@ -299,6 +426,7 @@ func (r *VirtualKeyReconciler) ensureExternal(ctx context.Context, virtualKey *a
 		log.Info("Successfully repaired drift in LiteLLM", "keyAlias", virtualKey.Spec.KeyAlias)
 	} else {
 		log.V(1).Info("Virtual key is up to date in LiteLLM", "keyAlias", virtualKey.Spec.KeyAlias)
+		virtualKey.Status.MCPServers = common.MCPServerIDs(objectPermission)
 		// Still need to populate external data for secret management
 		externalData.Key = observedVirtualKeyDetails.Key
 		externalData.KeyAlias = virtualKey.Status.KeyAlias
@@ -309,35 +437,56 @@ func (r *VirtualKeyReconciler) ensureExternal(ctx context.Context, virtualKey *a
 
 // ensureChildren manages in-cluster child resources using CreateOrUpdate pattern
 func (r *VirtualKeyReconciler) ensureChildren(ctx context.Context, virtualKey *authv1alpha1.VirtualKey, externalData *ExternalData) error {
-	// the VirtualKey is never shown again after the VirtualKey is created, so prevent the secret from being reset to an empty string
-	if virtualKey.Status.KeySecretRef == "" || externalData.Key == "" {
+	if virtualKey.Status.KeySecretRef == "" {
 		return nil // No secret to create
 	}
 
-	secretName := r.litellmResourceNaming.GenerateSecretName(virtualKey.Spec.KeyAlias)
-	secret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      secretName,
-			Namespace: virtualKey.Namespace,
-		},
+	values := common.KeySecretValues{
+		Key:         externalData.Key,
+		KeyAlias:    virtualKey.Spec.KeyAlias,
+		TeamID:      virtualKey.Spec.TeamID,
+		UserID:      virtualKey.Spec.UserID,
+		PreviousKey: externalData.PreviousKey,
 	}
-
-	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
-		// Set controller reference for garbage collection
-		if err := controllerutil.SetControllerReference(virtualKey, secret, r.Scheme); err != nil {
+	if virtualKey.Spec.SecretTemplate != nil && len(virtualKey.Spec.SecretTemplate.Data) > 0 {
+		url, err := common.ConnectionURL(ctx, r.Client, virtualKey.Spec.ConnectionRef, virtualKey.Namespace)
+		if err != nil {
 			return err
 		}
+		values.URL = url
+	}
 
-		// Update secret data
-		if secret.Data == nil {
-			secret.Data = make(map[string][]byte)
-		}
-		secret.Data["key"] = []byte(externalData.Key)
+	// the VirtualKey is never shown again after the VirtualKey is created, so the secret is only written when the key is known
+	secretName := r.keySecretName(virtualKey)
+	if err := common.EnsureKeySecret(ctx, r.Client, r.Scheme, virtualKey, secretName, virtualKey.Status.KeySecretRef, virtualKey.Spec.SecretTemplate, values); err != nil {
+		return err
+	}
+	virtualKey.Status.KeySecretRef = secretName
+	return nil
+}
+
+// handleKeySecretError reports a key Secret that cannot be written with the SecretConflict reason
+func (r *VirtualKeyReconciler) handleKeySecretError(ctx context.Context, virtualKey *authv1alpha1.VirtualKey, err error) (ctrl.Result, error) {
+	reason := base.ReasonReconcileError
+	if errors.Is(err, common.ErrKeySecretConflict) {
+		log.FromContext(ctx).Info("Key Secret is not controlled by the VirtualKey", "error", err.Error())
+		reason = common.ReasonSecretConflict
+	}
+	return r.HandleErrorRetryable(ctx, virtualKey, err, reason)
+}
 
-		return nil
-	})
+// keySecretName returns the name of the Secret the key is written to
+func (r *VirtualKeyReconciler) keySecretName(virtualKey *authv1alpha1.VirtualKey) string {
+	return common.KeySecretName(virtualKey.Spec.SecretTemplate, r.litellmResourceNaming.GenerateSecretName(virtualKey.Spec.KeyAlias))
+}
 
-	return err
+// secretStoreEntry returns the entry the key Secret was last written to in the secret store
+func secretStoreEntry(virtualKey *authv1alpha1.VirtualKey) common.SecretStoreEntry {
+	return common.SecretStoreEntry{
+		Ref:      virtualKey.Status.SecretStoreRef,
+		Path:     virtualKey.Status.SecretStorePath,
+		Checksum: virtualKey.Status.SecretStoreChecksum,
+	}
 }
 
 func (r *VirtualKeyReconciler) getSecretKeyValue(ctx context.Context, virtualKey *authv1alpha1.VirtualKey) (string, error) {
@@ -24,16 +24,23 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+	litellmv1alpha1 "github.com/bbdsoftware/litellm-operator/api/litellm/v1alpha1"
 	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
 	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+	"github.com/bbdsoftware/litellm-operator/internal/secretstore"
 	"github.com/bbdsoftware/litellm-operator/internal/util"
 )
 
@@ -46,6 +53,13 @@ type mockLitellmVirtualKeyClient struct {
 	getError     error
 	keyExists    bool
 	updateNeeded bool
+	guardrails   []litellm.GuardrailResponse
+	// Rotation
+	regenerated   int
+	gracePeriod   string
+	deletedKeyIDs []string
+	// hideKeys returns key info like LiteLLM, with the hash of the key in place of the key
+	hideKeys bool
 }
 
 func newMockLitellmVirtualKeyClient() *mockLitellmVirtualKeyClient {
@@ -62,15 +76,17 @@ func (m *mockLitellmVirtualKeyClient) GenerateVirtualKey(ctx context.Context, re
 	}
 
 	response := litellm.VirtualKeyResponse{
-		KeyAlias:  req.KeyAlias,
-		KeyName:   "key-" + req.KeyAlias,
-		UserID:    req.UserID,
-		TeamID:    req.TeamID,
-		Key:       "sk-test-" + req.KeyAlias,
-		TokenID:   "token-" + req.KeyAlias,
-		MaxBudget: req.MaxBudget,
-		CreatedAt: time.Now().Format(time.RFC3339),
-		UpdatedAt: time.Now().Format(time.RFC3339),
+		KeyAlias:         req.KeyAlias,
+		KeyName:          "key-" + req.KeyAlias,
+		UserID:           req.UserID,
+		TeamID:           req.TeamID,
+		BudgetID:         req.BudgetID,
+		Key:              "sk-test-" + req.KeyAlias,
+		TokenID:          "token-" + req.KeyAlias,
+		MaxBudget:        req.MaxBudget,
+		ObjectPermission: req.ObjectPermission,
+		CreatedAt:        time.Now().Format(time.RFC3339),
+		UpdatedAt:        time.Now().Format(time.RFC3339),
 	}
 
 	m.virtualKeys[req.KeyAlias] = &response
@@ -85,6 +101,28 @@ func (m *mockLitellmVirtualKeyClient) DeleteVirtualKey(ctx context.Context, keyA
 	return nil
 }
 
+func (m *mockLitellmVirtualKeyClient) DeleteVirtualKeyByID(ctx context.Context, keyID string) error {
+	if m.deleteError != nil {
+		return m.deleteError
+	}
+	m.deletedKeyIDs = append(m.deletedKeyIDs, keyID)
+	return nil
+}
+
+func (m *mockLitellmVirtualKeyClient) RegenerateVirtualKey(ctx context.Context, keyID string, gracePeriod string) (litellm.VirtualKeyResponse, error) {
+	for alias, vk := range m.virtualKeys {
+		if vk.Key == keyID {
+			m.regenerated++
+			m.gracePeriod = gracePeriod
+			regenerated := *vk
+			regenerated.Key = fmt.Sprintf("sk-rotated-%d-%s", m.regenerated, alias)
+			m.virtualKeys[alias] = &regenerated
+			return regenerated, nil
+		}
+	}
+	return litellm.VirtualKeyResponse{}, fmt.Errorf("%w: virtual key %s", litellm.ErrNotFound, keyID)
+}
+
 func (m *mockLitellmVirtualKeyClient) GetVirtualKeyFromAlias(ctx context.Context, keyAlias string) ([]string, error) {
 	if m.getError != nil {
 		return []string{}, m.getError
@@ -107,11 +145,16 @@ func (m *mockLitellmVirtualKeyClient) GetVirtualKeyInfo(ctx context.Context, key
 	// Find by key value
 	for _, vk := range m.virtualKeys {
 		if vk.Key == keyID {
-			return *vk, nil
+			info := *vk
+			if m.hideKeys {
+				info.Key = ""
+				info.Token = common.KeyHash(vk.Key)
+			}
+			return info, nil
 		}
 	}
 
-	return litellm.VirtualKeyResponse{}, fmt.Errorf("virtual key not found: %s", keyID)
+	return litellm.VirtualKeyResponse{}, fmt.Errorf("%w: virtual key %s", litellm.ErrNotFound, keyID)
 }
 
 func (m *mockLitellmVirtualKeyClient) GetVirtualKey(ctx context.Context, key string) (litellm.VirtualKeyResponse, error) {
@@ -150,6 +193,7 @@ func (m *mockLitellmVirtualKeyClient) UpdateVirtualKey(ctx context.Context, req
 		updated.UserID = req.UserID
 		updated.TeamID = req.TeamID
 		updated.MaxBudget = req.MaxBudget
+		updated.ObjectPermission = req.ObjectPermission
 		updated.UpdatedAt = time.Now().Format(time.RFC3339)
 		m.virtualKeys[req.KeyAlias] = &updated
 		return updated, nil
@@ -162,10 +206,15 @@ func (m *mockLitellmVirtualKeyClient) SetVirtualKeyBlockedState(ctx context.Cont
 	return nil
 }
 
+func (m *mockLitellmVirtualKeyClient) ListGuardrails(ctx context.Context) ([]litellm.GuardrailResponse, error) {
+	return m.guardrails, nil
+}
+
 // Helper functions for testing
 func setupTestVirtualKeyReconciler(objects ...client.Object) *VirtualKeyReconciler {
 	scheme := runtime.NewScheme()
 	_ = authv1alpha1.AddToScheme(scheme)
+	_ = litellmv1alpha1.AddToScheme(scheme)
 	_ = corev1.AddToScheme(scheme)
 
 	fakeClient := fake.NewClientBuilder().
@@ -326,6 +375,7 @@ var _ = Describe("VirtualKey Controller", func() {
 						"key": []byte("sk-existing-key"),
 					},
 				}
+				Expect(controllerutil.SetControllerReference(virtualKey, secret, reconciler.Scheme)).To(Succeed())
 				Expect(reconciler.Create(ctx, secret)).To(Succeed())
 
 				// Update status to reflect existing key
@@ -377,6 +427,717 @@ var _ = Describe("VirtualKey Controller", func() {
 			})
 		})
 
+		Context("when the virtual key references a Budget", func() {
+			request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "budget-vk", Namespace: "default"}}
+
+			newBudgetVirtualKey := func() *authv1alpha1.VirtualKey {
+				vk := createTestVirtualKey("budget-vk", "default")
+				vk.Spec.BudgetRef = &authv1alpha1.CRDRef{Name: "finance"}
+				return vk
+			}
+
+			It("links the key to the budget's LiteLLM ID", func() {
+				budget := &authv1alpha1.Budget{
+					ObjectMeta: metav1.ObjectMeta{Name: "finance", Namespace: "default"},
+					Status:     authv1alpha1.BudgetStatus{BudgetID: "finance-budget"},
+				}
+				reconciler = setupTestVirtualKeyReconciler(newBudgetVirtualKey(), budget)
+				mockClient = reconciler.LitellmClient.(*mockLitellmVirtualKeyClient)
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(mockClient.virtualKeys).To(HaveKey("budget-vk-alias"))
+				Expect(mockClient.virtualKeys["budget-vk-alias"].BudgetID).To(Equal("finance-budget"))
+			})
+
+			It("waits for the budget to be created", func() {
+				reconciler = setupTestVirtualKeyReconciler(newBudgetVirtualKey())
+				mockClient = reconciler.LitellmClient.(*mockLitellmVirtualKeyClient)
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+				Expect(mockClient.virtualKeys).To(BeEmpty())
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				assertCondition(updatedVK.Status.Conditions, base.CondDegraded, base.ReasonDependencyNotReady)
+			})
+		})
+
+		Context("when the virtual key references guardrails", func() {
+			request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "guarded-vk", Namespace: "default"}}
+
+			newGuardedVirtualKey := func(guardrails ...string) *authv1alpha1.VirtualKey {
+				vk := createTestVirtualKey("guarded-vk", "default")
+				vk.Spec.Guardrails = guardrails
+				return vk
+			}
+
+			It("accepts guardrails defined by a Guardrail resource or the proxy config", func() {
+				guardrail := &litellmv1alpha1.Guardrail{
+					ObjectMeta: metav1.ObjectMeta{Name: "pii-mask", Namespace: "guardrails"},
+					Spec:       litellmv1alpha1.GuardrailSpec{Provider: "presidio"},
+					Status:     litellmv1alpha1.GuardrailStatus{GuardrailID: "g-1"},
+				}
+				reconciler = setupTestVirtualKeyReconciler(newGuardedVirtualKey("pii-mask", "aporia-pre"), guardrail)
+				mockClient = reconciler.LitellmClient.(*mockLitellmVirtualKeyClient)
+				mockClient.guardrails = []litellm.GuardrailResponse{{GuardrailName: "aporia-pre"}}
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(mockClient.virtualKeys).To(HaveKey("guarded-vk-alias"))
+			})
+
+			It("waits for an unknown guardrail", func() {
+				reconciler = setupTestVirtualKeyReconciler(newGuardedVirtualKey("missing"))
+				mockClient = reconciler.LitellmClient.(*mockLitellmVirtualKeyClient)
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+				Expect(mockClient.virtualKeys).To(BeEmpty())
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				assertCondition(updatedVK.Status.Conditions, base.CondDegraded, base.ReasonDependencyNotReady)
+				Expect(findCondition(updatedVK.Status.Conditions, base.CondDegraded).Message).To(ContainSubstring("missing"))
+			})
+		})
+
+		Context("when an MCPServer allows the virtual key", func() {
+			request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "mcp-vk", Namespace: "default"}}
+
+			newMCPServer := func(name, serverID string, allowedKeys ...litellmv1alpha1.ResourceRef) *litellmv1alpha1.MCPServer {
+				return &litellmv1alpha1.MCPServer{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "tools"},
+					Spec: litellmv1alpha1.MCPServerSpec{
+						ConnectionRef: litellmv1alpha1.ConnectionRef{SecretRef: litellmv1alpha1.SecretRef{Namespace: "default", SecretName: "test-connection"}},
+						URL:           "http://" + name,
+						AllowedKeys:   allowedKeys,
+					},
+					Status: litellmv1alpha1.MCPServerStatus{ServerID: serverID},
+				}
+			}
+
+			It("grants the key access to the servers on its connection that allow it", func() {
+				allowed := newMCPServer("github", "mcp-github", litellmv1alpha1.ResourceRef{Name: "mcp-vk", Namespace: "default"})
+				other := newMCPServer("jira", "mcp-jira", litellmv1alpha1.ResourceRef{Name: "other-vk", Namespace: "default"})
+				otherInstance := newMCPServer("slack", "mcp-slack", litellmv1alpha1.ResourceRef{Name: "mcp-vk", Namespace: "default"})
+				otherInstance.Spec.ConnectionRef = litellmv1alpha1.ConnectionRef{InstanceRef: litellmv1alpha1.InstanceRef{Name: "other-litellm"}}
+				reconciler = setupTestVirtualKeyReconciler(createTestVirtualKey("mcp-vk", "default"), allowed, other, otherInstance)
+				mockClient = reconciler.LitellmClient.(*mockLitellmVirtualKeyClient)
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(mockClient.virtualKeys).To(HaveKey("mcp-vk-alias"))
+				Expect(mockClient.virtualKeys["mcp-vk-alias"].ObjectPermission).To(Equal(&litellm.ObjectPermission{MCPServers: []string{"mcp-github"}}))
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(updatedVK.Status.MCPServers).To(Equal([]string{"mcp-github"}))
+			})
+
+			It("leaves the permission unmanaged when no MCPServer allows the key", func() {
+				other := newMCPServer("jira", "mcp-jira", litellmv1alpha1.ResourceRef{Name: "other-vk", Namespace: "default"})
+				reconciler = setupTestVirtualKeyReconciler(createTestVirtualKey("mcp-vk", "default"), other)
+				mockClient = reconciler.LitellmClient.(*mockLitellmVirtualKeyClient)
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(mockClient.virtualKeys["mcp-vk-alias"].ObjectPermission).To(BeNil())
+			})
+
+			It("revokes the access it granted once the MCPServer no longer allows the key", func() {
+				allowed := newMCPServer("github", "mcp-github", litellmv1alpha1.ResourceRef{Name: "mcp-vk", Namespace: "default"})
+				reconciler = setupTestVirtualKeyReconciler(createTestVirtualKey("mcp-vk", "default"), allowed)
+				mockClient = reconciler.LitellmClient.(*mockLitellmVirtualKeyClient)
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mockClient.virtualKeys["mcp-vk-alias"].ObjectPermission.MCPServers).To(Equal([]string{"mcp-github"}))
+
+				Expect(reconciler.Get(ctx, client.ObjectKeyFromObject(allowed), allowed)).To(Succeed())
+				allowed.Spec.AllowedKeys = nil
+				Expect(reconciler.Update(ctx, allowed)).To(Succeed())
+				mockClient.updateNeeded = true
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mockClient.virtualKeys["mcp-vk-alias"].ObjectPermission).To(Equal(&litellm.ObjectPermission{MCPServers: []string{}}))
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(updatedVK.Status.MCPServers).To(BeEmpty())
+			})
+		})
+
+		Context("when the virtual key is rotated", func() {
+			request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "rotating-vk", Namespace: "default"}}
+			var secretName string
+
+			newRotatingVirtualKey := func(rotation *authv1alpha1.KeyRotation, annotations map[string]string) *authv1alpha1.VirtualKey {
+				vk := createTestVirtualKey("rotating-vk", "default")
+				vk.CreationTimestamp = metav1.NewTime(time.Now().Add(-100 * 24 * time.Hour))
+				vk.Annotations = annotations
+				vk.Spec.Rotation = rotation
+				return vk
+			}
+
+			setupRotatingVirtualKey := func(vk *authv1alpha1.VirtualKey, status authv1alpha1.VirtualKeyStatus, secretData map[string][]byte) {
+				reconciler = setupTestVirtualKeyReconciler(vk)
+				mockClient = reconciler.LitellmClient.(*mockLitellmVirtualKeyClient)
+				mockClient.virtualKeys[vk.Spec.KeyAlias] = &litellm.VirtualKeyResponse{KeyAlias: vk.Spec.KeyAlias, Key: "sk-existing-key"}
+
+				secretName = reconciler.litellmResourceNaming.GenerateSecretName(vk.Spec.KeyAlias)
+				secret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: vk.Namespace},
+					Data:       secretData,
+				}
+				Expect(controllerutil.SetControllerReference(vk, secret, reconciler.Scheme)).To(Succeed())
+				Expect(reconciler.Create(ctx, secret)).To(Succeed())
+
+				status.KeyAlias = vk.Spec.KeyAlias
+				status.KeySecretRef = secretName
+				vk.Status = status
+				Expect(reconciler.Status().Update(ctx, vk)).To(Succeed())
+			}
+
+			getSecret := func() *corev1.Secret {
+				secret := &corev1.Secret{}
+				Expect(reconciler.Get(ctx, types.NamespacedName{Name: secretName, Namespace: "default"}, secret)).To(Succeed())
+				return secret
+			}
+
+			It("rotates the key on the rotate annotation and keeps the previous key for the grace period", func() {
+				rotation := &authv1alpha1.KeyRotation{GracePeriod: &metav1.Duration{Duration: time.Hour}}
+				setupRotatingVirtualKey(newRotatingVirtualKey(rotation, map[string]string{RotateAnnotation: "2025-10-01"}),
+					authv1alpha1.VirtualKeyStatus{}, map[string][]byte{"key": []byte("sk-existing-key")})
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(BeNumerically("<=", 60*time.Second))
+
+				Expect(mockClient.regenerated).To(Equal(1))
+				Expect(mockClient.gracePeriod).To(Equal("3600s"))
+				Expect(getSecret().Data).To(Equal(map[string][]byte{
+					"key":                       []byte("sk-rotated-1-rotating-vk-alias"),
+					common.PreviousKeySecretKey: []byte("sk-existing-key"),
+				}))
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(updatedVK.Status.LastRotatedAt).NotTo(BeNil())
+				Expect(updatedVK.Status.NextRotationAt).To(BeNil())
+				Expect(updatedVK.Status.RotationTrigger).To(Equal("2025-10-01"))
+				Expect(updatedVK.Status.PreviousKeyID).To(Equal("sk-existing-key"))
+				Expect(updatedVK.Status.PreviousKeyRevokeAt.Time).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+
+				// The same annotation value does not rotate the key again
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mockClient.regenerated).To(Equal(1))
+			})
+
+			It("rotates the key when the interval has elapsed", func() {
+				rotation := &authv1alpha1.KeyRotation{Interval: &metav1.Duration{Duration: 90 * 24 * time.Hour}}
+				setupRotatingVirtualKey(newRotatingVirtualKey(rotation, nil),
+					authv1alpha1.VirtualKeyStatus{}, map[string][]byte{"key": []byte("sk-existing-key")})
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(mockClient.regenerated).To(Equal(1))
+				Expect(mockClient.gracePeriod).To(Equal("86400s"))
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(updatedVK.Status.NextRotationAt.Time).To(BeTemporally("~", updatedVK.Status.LastRotatedAt.Add(90*24*time.Hour), time.Second))
+			})
+
+			It("does not rotate the key before the interval has elapsed", func() {
+				rotation := &authv1alpha1.KeyRotation{Interval: &metav1.Duration{Duration: 90 * 24 * time.Hour}}
+				lastRotatedAt := metav1.NewTime(time.Now().Add(-24 * time.Hour))
+				setupRotatingVirtualKey(newRotatingVirtualKey(rotation, nil),
+					authv1alpha1.VirtualKeyStatus{LastRotatedAt: &lastRotatedAt}, map[string][]byte{"key": []byte("sk-existing-key")})
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(60 * time.Second))
+				Expect(mockClient.regenerated).To(BeZero())
+			})
+
+			It("revokes the previous key during its grace period and rotates on the rotate annotation", func() {
+				revokeAt := metav1.NewTime(time.Now().Add(time.Hour))
+				setupRotatingVirtualKey(newRotatingVirtualKey(&authv1alpha1.KeyRotation{}, map[string]string{RotateAnnotation: "leaked"}),
+					authv1alpha1.VirtualKeyStatus{PreviousKeyID: "sk-previous-key", PreviousKeyRevokeAt: &revokeAt, RotationTrigger: "2025-10-01"},
+					map[string][]byte{"key": []byte("sk-existing-key"), common.PreviousKeySecretKey: []byte("sk-previous-key")})
+				mockClient.virtualKeys["previous"] = &litellm.VirtualKeyResponse{Key: "sk-previous-key"}
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(mockClient.deletedKeyIDs).To(Equal([]string{"sk-previous-key"}))
+				Expect(mockClient.regenerated).To(Equal(1))
+				Expect(getSecret().Data).To(Equal(map[string][]byte{
+					"key":                       []byte("sk-rotated-1-rotating-vk-alias"),
+					common.PreviousKeySecretKey: []byte("sk-existing-key"),
+				}))
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(updatedVK.Status.RotationTrigger).To(Equal("leaked"))
+				Expect(updatedVK.Status.PreviousKeyID).To(Equal("sk-existing-key"))
+			})
+
+			It("revokes the previous key once the grace period has passed", func() {
+				revokeAt := metav1.NewTime(time.Now().Add(-time.Minute))
+				setupRotatingVirtualKey(newRotatingVirtualKey(&authv1alpha1.KeyRotation{}, nil),
+					authv1alpha1.VirtualKeyStatus{PreviousKeyID: "sk-previous-key", PreviousKeyRevokeAt: &revokeAt},
+					map[string][]byte{"key": []byte("sk-existing-key"), common.PreviousKeySecretKey: []byte("sk-previous-key")})
+				mockClient.virtualKeys["previous"] = &litellm.VirtualKeyResponse{Key: "sk-previous-key"}
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(mockClient.deletedKeyIDs).To(Equal([]string{"sk-previous-key"}))
+				Expect(getSecret().Data).To(Equal(map[string][]byte{"key": []byte("sk-existing-key")}))
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(updatedVK.Status.PreviousKeyID).To(BeEmpty())
+				Expect(updatedVK.Status.PreviousKeyRevokeAt).To(BeNil())
+				Expect(mockClient.regenerated).To(BeZero())
+			})
+		})
+
+		Context("when the key Secret is templated", func() {
+			request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "templated-vk", Namespace: "default"}}
+
+			newTemplatedVirtualKey := func(secretTemplate *authv1alpha1.SecretTemplate) *authv1alpha1.VirtualKey {
+				vk := createTestVirtualKey("templated-vk", "default")
+				vk.Spec.TeamID = "team-a"
+				vk.Spec.SecretTemplate = secretTemplate
+				return vk
+			}
+			connectionSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-connection", Namespace: "default"},
+				Data:       map[string][]byte{"masterkey": []byte("sk-master"), "url": []byte("http://litellm:4000")},
+			}
+
+			It("renders the template data into the Secret and a .env document", func() {
+				reconciler = setupTestVirtualKeyReconciler(newTemplatedVirtualKey(&authv1alpha1.SecretTemplate{
+					Name:   "openai-credentials",
+					Labels: map[string]string{"app": "chat"},
+					Data: map[string]string{
+						"OPENAI_API_KEY":  "{{ .Key }}",
+						"OPENAI_BASE_URL": "{{ .URL }}/v1",
+						"LITELLM_TEAM":    "{{ .TeamID }} {{ .KeyAlias }}",
+					},
+					Format: common.SecretFormatEnv,
+				}), connectionSecret.DeepCopy())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				secret := &corev1.Secret{}
+				Expect(reconciler.Get(ctx, types.NamespacedName{Name: "openai-credentials", Namespace: "default"}, secret)).To(Succeed())
+				Expect(secret.Labels).To(HaveKeyWithValue("app", "chat"))
+				Expect(secret.Data).To(Equal(map[string][]byte{
+					"key":             []byte("sk-test-templated-vk-alias"),
+					"OPENAI_API_KEY":  []byte("sk-test-templated-vk-alias"),
+					"OPENAI_BASE_URL": []byte("http://litellm:4000/v1"),
+					"LITELLM_TEAM":    []byte("team-a templated-vk-alias"),
+					".env": []byte("LITELLM_TEAM=\"team-a templated-vk-alias\"\n" +
+						"OPENAI_API_KEY=sk-test-templated-vk-alias\n" +
+						"OPENAI_BASE_URL=http://litellm:4000/v1\n"),
+				}))
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(updatedVK.Status.KeySecretRef).To(Equal("openai-credentials"))
+			})
+
+			It("moves the key when the template renames the Secret", func() {
+				vk := newTemplatedVirtualKey(&authv1alpha1.SecretTemplate{
+					Data:   map[string]string{"OPENAI_API_KEY": "{{ .Key }}"},
+					Format: common.SecretFormatJSON,
+				})
+				reconciler = setupTestVirtualKeyReconciler(vk, connectionSecret.DeepCopy())
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				defaultName := reconciler.litellmResourceNaming.GenerateSecretName("templated-vk-alias")
+
+				Expect(reconciler.Get(ctx, request.NamespacedName, vk)).To(Succeed())
+				vk.Spec.SecretTemplate.Name = "renamed-credentials"
+				Expect(reconciler.Update(ctx, vk)).To(Succeed())
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				secret := &corev1.Secret{}
+				Expect(reconciler.Get(ctx, types.NamespacedName{Name: "renamed-credentials", Namespace: "default"}, secret)).To(Succeed())
+				Expect(secret.Data).To(HaveKeyWithValue("key", []byte("sk-test-templated-vk-alias")))
+				Expect(secret.Data).To(HaveKeyWithValue("config.json", []byte(`{"OPENAI_API_KEY":"sk-test-templated-vk-alias"}`)))
+				err = reconciler.Get(ctx, types.NamespacedName{Name: defaultName, Namespace: "default"}, &corev1.Secret{})
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("does not adopt a Secret of the same name that it does not control", func() {
+				foreign := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "openai-credentials", Namespace: "default"},
+					Data:       map[string][]byte{"password": []byte("hunter2")},
+				}
+				reconciler = setupTestVirtualKeyReconciler(newTemplatedVirtualKey(&authv1alpha1.SecretTemplate{
+					Name: "openai-credentials",
+				}), connectionSecret.DeepCopy(), foreign)
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				secret := &corev1.Secret{}
+				Expect(reconciler.Get(ctx, types.NamespacedName{Name: "openai-credentials", Namespace: "default"}, secret)).To(Succeed())
+				Expect(secret.OwnerReferences).To(BeEmpty())
+				Expect(secret.Data).To(Equal(map[string][]byte{"password": []byte("hunter2")}))
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				assertCondition(updatedVK.Status.Conditions, base.CondDegraded, common.ReasonSecretConflict)
+				// The key is only shown once, so it is not generated while it cannot be written
+				Expect(reconciler.LitellmClient.(*mockLitellmVirtualKeyClient).virtualKeys).To(BeEmpty())
+			})
+
+			It("rejects template data replacing the key", func() {
+				secret := &corev1.Secret{}
+				err := common.RenderKeySecret(secret, &authv1alpha1.SecretTemplate{
+					Data: map[string]string{common.PreviousKeySecretKey: "{{ .Key }}"},
+				}, common.KeySecretValues{Key: "sk-test"})
+				Expect(err).To(MatchError(ContainSubstring("reserved key previousKey")))
+
+				err = common.RenderKeySecret(secret, &authv1alpha1.SecretTemplate{
+					Data:      map[string]string{"OPENAI_API_KEY": "{{ .Key }}"},
+					Format:    common.SecretFormatEnv,
+					FormatKey: common.KeySecretKey,
+				}, common.KeySecretValues{Key: "sk-test"})
+				Expect(err).To(MatchError(ContainSubstring("reserved key key")))
+			})
+		})
+
+		Context("when the key is lost from the key Secret", func() {
+			request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "lost-vk", Namespace: "default"}}
+			var secretName string
+
+			// createKey creates the key and its Secret, after which LiteLLM no longer shows the key
+			createKey := func(recoveryPolicy string) {
+				vk := createTestVirtualKey("lost-vk", "default")
+				vk.Spec.RecoveryPolicy = recoveryPolicy
+				reconciler = setupTestVirtualKeyReconciler(vk)
+				mockClient = reconciler.LitellmClient.(*mockLitellmVirtualKeyClient)
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				mockClient.hideKeys = true
+				secretName = reconciler.litellmResourceNaming.GenerateSecretName("lost-vk-alias")
+			}
+
+			getCondition := func() *metav1.Condition {
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				return meta.FindStatusCondition(updatedVK.Status.Conditions, CondSecretOutOfSync)
+			}
+
+			It("records the hash of the key and keeps the key Secret in sync", func() {
+				createKey("")
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(updatedVK.Status.KeyHash).To(Equal(common.KeyHash("sk-test-lost-vk-alias")))
+				Expect(meta.IsStatusConditionFalse(updatedVK.Status.Conditions, CondSecretOutOfSync)).To(BeTrue())
+				Expect(meta.IsStatusConditionTrue(updatedVK.Status.Conditions, base.CondReady)).To(BeTrue())
+			})
+
+			It("reports a deleted key Secret instead of becoming Ready", func() {
+				createKey(RecoveryPolicyNone)
+				Expect(reconciler.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: "default"}})).To(Succeed())
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+				condition := getCondition()
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(ReasonKeyMissing))
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(meta.IsStatusConditionFalse(updatedVK.Status.Conditions, base.CondReady)).To(BeTrue())
+				Expect(mockClient.regenerated).To(BeZero())
+			})
+
+			It("reports a key Secret holding another key", func() {
+				createKey("")
+				secret := &corev1.Secret{}
+				Expect(reconciler.Get(ctx, types.NamespacedName{Name: secretName, Namespace: "default"}, secret)).To(Succeed())
+				secret.Data[common.KeySecretKey] = []byte("sk-restored-from-backup")
+				Expect(reconciler.Update(ctx, secret)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				condition := getCondition()
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(ReasonKeyMismatch))
+			})
+
+			It("checks the key Secret of a VirtualKey restored without its status", func() {
+				createKey(RecoveryPolicyNone)
+				vk := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, vk)).To(Succeed())
+				vk.Status = authv1alpha1.VirtualKeyStatus{}
+				Expect(reconciler.Status().Update(ctx, vk)).To(Succeed())
+
+				By("finding the key in the Secret it is written to")
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reconciler.Get(ctx, request.NamespacedName, vk)).To(Succeed())
+				Expect(vk.Status.KeySecretRef).To(Equal(secretName))
+				Expect(getCondition().Reason).To(Equal(ReasonKeyInSync))
+				Expect(meta.IsStatusConditionTrue(vk.Status.Conditions, base.CondReady)).To(BeTrue())
+
+				By("reporting the key Secret when it was not restored")
+				vk.Status = authv1alpha1.VirtualKeyStatus{}
+				Expect(reconciler.Status().Update(ctx, vk)).To(Succeed())
+				Expect(reconciler.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: "default"}})).To(Succeed())
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(getCondition().Reason).To(Equal(ReasonKeyMissing))
+				Expect(reconciler.Get(ctx, request.NamespacedName, vk)).To(Succeed())
+				Expect(meta.IsStatusConditionFalse(vk.Status.Conditions, base.CondReady)).To(BeTrue())
+			})
+
+			It("regenerates the key under the Regenerate recovery policy", func() {
+				createKey(RecoveryPolicyRegenerate)
+				Expect(reconciler.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: "default"}})).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mockClient.regenerated).To(Equal(1))
+				Expect(mockClient.gracePeriod).To(BeEmpty())
+
+				secret := &corev1.Secret{}
+				Expect(reconciler.Get(ctx, types.NamespacedName{Name: secretName, Namespace: "default"}, secret)).To(Succeed())
+				Expect(secret.Data).To(HaveKeyWithValue("key", []byte("sk-rotated-1-lost-vk-alias")))
+
+				condition := getCondition()
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(condition.Reason).To(Equal(ReasonKeyRegenerated))
+
+				// The regenerated key is in sync on the next reconcile
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mockClient.regenerated).To(Equal(1))
+				Expect(getCondition().Reason).To(Equal(ReasonKeyInSync))
+			})
+		})
+
+		Context("when the key is pushed to a secret store", func() {
+			request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "pushed-vk", Namespace: "default"}}
+
+			getPushSecret := func() (*unstructured.Unstructured, error) {
+				pushSecret := &unstructured.Unstructured{}
+				pushSecret.SetGroupVersionKind(secretstore.PushSecretGVK)
+				err := reconciler.Get(ctx, types.NamespacedName{Name: "pushed-vk-virtualkey", Namespace: "default"}, pushSecret)
+				return pushSecret, err
+			}
+
+			It("emits a PushSecret for the key Secret and removes it on deletion", func() {
+				vk := createTestVirtualKey("pushed-vk", "default")
+				vk.Spec.SecretStoreRef = &authv1alpha1.SecretStoreRef{
+					PushSecret: &authv1alpha1.PushSecretStore{StoreName: "vault-backend", StoreKind: "ClusterSecretStore"},
+				}
+				reconciler = setupTestVirtualKeyReconciler(vk)
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+
+				pushSecret, err := getPushSecret()
+				Expect(err).NotTo(HaveOccurred())
+				secretName, _, _ := unstructured.NestedString(pushSecret.Object, "spec", "selector", "secret", "name")
+				Expect(secretName).To(Equal(reconciler.litellmResourceNaming.GenerateSecretName("pushed-vk-alias")))
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(updatedVK.Status.SecretStorePath).To(Equal("default/pushed-vk-alias"))
+				Expect(updatedVK.Status.SecretStoreRef).To(Equal(vk.Spec.SecretStoreRef))
+				Expect(updatedVK.Status.SecretStoreChecksum).NotTo(BeEmpty())
+				checksum := updatedVK.Status.SecretStoreChecksum
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(updatedVK.Status.SecretStoreChecksum).To(Equal(checksum))
+
+				Expect(reconciler.Delete(ctx, updatedVK)).To(Succeed())
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = getPushSecret()
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("removes the PushSecret when the secret store reference is removed", func() {
+				vk := createTestVirtualKey("pushed-vk", "default")
+				vk.Spec.SecretStoreRef = &authv1alpha1.SecretStoreRef{
+					PushSecret: &authv1alpha1.PushSecretStore{StoreName: "vault-backend", StoreKind: "ClusterSecretStore"},
+				}
+				reconciler = setupTestVirtualKeyReconciler(vk)
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = getPushSecret()
+				Expect(err).NotTo(HaveOccurred())
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				updatedVK.Spec.SecretStoreRef = nil
+				Expect(reconciler.Update(ctx, updatedVK)).To(Succeed())
+
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = getPushSecret()
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(updatedVK.Status.SecretStoreRef).To(BeNil())
+				Expect(updatedVK.Status.SecretStorePath).To(BeEmpty())
+				Expect(updatedVK.Status.SecretStoreChecksum).To(BeEmpty())
+			})
+		})
+
+		Context("when the key is delivered to other namespaces", func() {
+			request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "delivered-vk", Namespace: "default"}}
+			var secretName string
+
+			grant := func(namespace string, from ...authv1alpha1.KeyDeliveryGrantFrom) *authv1alpha1.KeyDeliveryGrant {
+				return &authv1alpha1.KeyDeliveryGrant{
+					ObjectMeta: metav1.ObjectMeta{Name: "litellm-keys", Namespace: namespace},
+					Spec:       authv1alpha1.KeyDeliveryGrantSpec{From: from},
+				}
+			}
+
+			setup := func(targetNamespaces []string, objects ...client.Object) {
+				vk := createTestVirtualKey("delivered-vk", "default")
+				vk.Spec.TargetNamespaces = targetNamespaces
+				reconciler = setupTestVirtualKeyReconciler(append(objects, vk)...)
+				secretName = reconciler.litellmResourceNaming.GenerateSecretName("delivered-vk-alias")
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			getCopy := func(namespace string) (*corev1.Secret, error) {
+				secret := &corev1.Secret{}
+				err := reconciler.Get(ctx, types.NamespacedName{Name: secretName, Namespace: namespace}, secret)
+				return secret, err
+			}
+
+			It("copies the key Secret only into namespaces that grant it", func() {
+				setup([]string{"consumer", "ungranted", "named"},
+					grant("consumer", authv1alpha1.KeyDeliveryGrantFrom{Namespace: "default"}),
+					grant("named", authv1alpha1.KeyDeliveryGrantFrom{Namespace: "default", Name: "other-vk"}))
+
+				copied, err := getCopy("consumer")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copied.Data).To(HaveKeyWithValue("key", []byte("sk-test-delivered-vk-alias")))
+				Expect(copied.Labels).To(HaveKeyWithValue(common.DeliveredFromNameLabel, "delivered-vk"))
+				Expect(copied.OwnerReferences).To(BeEmpty())
+				_, err = getCopy("ungranted")
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				_, err = getCopy("named")
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(updatedVK.Status.DeliveredNamespaces).To(Equal([]string{"consumer"}))
+				condition := meta.FindStatusCondition(updatedVK.Status.Conditions, CondKeyDelivered)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Reason).To(Equal(ReasonKeyNotGranted))
+				Expect(condition.Message).To(ContainSubstring("ungranted, named"))
+			})
+
+			It("keeps copies in sync and removes them when no longer targeted or granted", func() {
+				setup([]string{"consumer", "second"},
+					grant("consumer", authv1alpha1.KeyDeliveryGrantFrom{Namespace: "default"}),
+					grant("second", authv1alpha1.KeyDeliveryGrantFrom{Namespace: "default", Name: "delivered-vk"}))
+
+				copied, err := getCopy("consumer")
+				Expect(err).NotTo(HaveOccurred())
+				copied.Data["key"] = []byte("sk-tampered")
+				Expect(reconciler.Update(ctx, copied)).To(Succeed())
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				copied, err = getCopy("consumer")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copied.Data).To(HaveKeyWithValue("key", []byte("sk-test-delivered-vk-alias")))
+
+				// Withdrawing the grant removes the copy
+				Expect(reconciler.Delete(ctx, grant("second"))).To(Succeed())
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = getCopy("second")
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+				// Removing the target namespace removes the copy
+				vk := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, vk)).To(Succeed())
+				vk.Spec.TargetNamespaces = nil
+				Expect(reconciler.Update(ctx, vk)).To(Succeed())
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = getCopy("consumer")
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				Expect(reconciler.Get(ctx, request.NamespacedName, vk)).To(Succeed())
+				Expect(vk.Status.DeliveredNamespaces).To(BeEmpty())
+				Expect(meta.FindStatusCondition(vk.Status.Conditions, CondKeyDelivered)).To(BeNil())
+			})
+
+			It("does not overwrite a Secret it did not deliver", func() {
+				existing := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "litellm-key-delivered-vk-alias", Namespace: "consumer"},
+					Data:       map[string][]byte{"key": []byte("sk-someone-else")},
+				}
+				setup([]string{"consumer"}, existing, grant("consumer", authv1alpha1.KeyDeliveryGrantFrom{Namespace: "default"}))
+				Expect(existing.Name).To(Equal(secretName))
+
+				copied, err := getCopy("consumer")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copied.Data).To(HaveKeyWithValue("key", []byte("sk-someone-else")))
+
+				updatedVK := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, updatedVK)).To(Succeed())
+				Expect(meta.FindStatusCondition(updatedVK.Status.Conditions, CondKeyDelivered).Reason).To(Equal(ReasonKeyConflict))
+			})
+
+			It("removes the copies when the VirtualKey is deleted", func() {
+				setup([]string{"consumer"}, grant("consumer", authv1alpha1.KeyDeliveryGrantFrom{Namespace: "default"}))
+				_, err := getCopy("consumer")
+				Expect(err).NotTo(HaveOccurred())
+
+				vk := &authv1alpha1.VirtualKey{}
+				Expect(reconciler.Get(ctx, request.NamespacedName, vk)).To(Succeed())
+				Expect(reconciler.Delete(ctx, vk)).To(Succeed())
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = getCopy("consumer")
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
 		Context("when handling deletion", func() {
 			var deletingVK *authv1alpha1.VirtualKey
 
@@ -0,0 +1,172 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualkey
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+)
+
+const (
+	// CondKeyDelivered reports whether the key Secret has been copied into every target namespace
+	CondKeyDelivered = "KeyDelivered"
+
+	ReasonKeyDelivered   = "Delivered"
+	ReasonKeyNotGranted  = "NotGranted"
+	ReasonKeyConflict    = "Conflict"
+	ReasonKeyNotYetKnown = "KeyNotYetKnown"
+
+	virtualKeyKind = "VirtualKey"
+)
+
+// ensureDelivery copies the key Secret into the target namespaces that allow it with a KeyDeliveryGrant, keeps the
+// copies in sync with the key Secret, and removes the copies from namespaces no longer targeted or granted.
+func (r *VirtualKeyReconciler) ensureDelivery(ctx context.Context, virtualKey *authv1alpha1.VirtualKey) error {
+	log := log.FromContext(ctx)
+
+	if len(virtualKey.Spec.TargetNamespaces) == 0 {
+		meta.RemoveStatusCondition(&virtualKey.Status.Conditions, CondKeyDelivered)
+		virtualKey.Status.DeliveredNamespaces = nil
+		return r.removeDeliveredSecrets(ctx, virtualKey, nil)
+	}
+
+	source := &corev1.Secret{}
+	if virtualKey.Status.KeySecretRef != "" {
+		if err := r.Get(ctx, types.NamespacedName{Name: virtualKey.Status.KeySecretRef, Namespace: virtualKey.Namespace}, source); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to get secret %s: %w", virtualKey.Status.KeySecretRef, err)
+			}
+			source = nil
+		}
+	}
+	if source == nil || len(source.Data[common.KeySecretKey]) == 0 {
+		// Copies of a lost key are kept until the key is recovered
+		r.SetCondition(virtualKey, CondKeyDelivered, metav1.ConditionFalse, ReasonKeyNotYetKnown, "Key Secret does not hold a key to deliver")
+		return nil
+	}
+
+	delivered := map[string]bool{}
+	var notGranted, conflicts []string
+	for _, namespace := range virtualKey.Spec.TargetNamespaces {
+		if namespace == virtualKey.Namespace {
+			continue
+		}
+		granted, err := common.KeyDeliveryGranted(ctx, r.Client, namespace, virtualKey)
+		if err != nil {
+			return err
+		}
+		if !granted {
+			notGranted = append(notGranted, namespace)
+			continue
+		}
+		copied, err := r.deliverSecret(ctx, virtualKey, source, namespace)
+		if err != nil {
+			return err
+		}
+		if !copied {
+			conflicts = append(conflicts, namespace)
+			continue
+		}
+		delivered[namespace] = true
+	}
+
+	if err := r.removeDeliveredSecrets(ctx, virtualKey, func(secret *corev1.Secret) bool {
+		return delivered[secret.Namespace] && secret.Name == source.Name
+	}); err != nil {
+		return err
+	}
+	virtualKey.Status.DeliveredNamespaces = slices.Sorted(maps.Keys(delivered))
+
+	switch {
+	case len(notGranted) > 0:
+		message := fmt.Sprintf("No KeyDeliveryGrant allows the key in namespaces %s", strings.Join(notGranted, ", "))
+		log.Info("Key delivery not granted", "keyAlias", virtualKey.Spec.KeyAlias, "namespaces", notGranted)
+		r.SetCondition(virtualKey, CondKeyDelivered, metav1.ConditionFalse, ReasonKeyNotGranted, message)
+	case len(conflicts) > 0:
+		message := fmt.Sprintf("Secret %s already exists and was not delivered by the VirtualKey in namespaces %s", source.Name, strings.Join(conflicts, ", "))
+		r.SetCondition(virtualKey, CondKeyDelivered, metav1.ConditionFalse, ReasonKeyConflict, message)
+	default:
+		r.SetCondition(virtualKey, CondKeyDelivered, metav1.ConditionTrue, ReasonKeyDelivered, "Key Secret is delivered to every target namespace")
+	}
+	return nil
+}
+
+// deliverSecret creates or updates the copy of the key Secret in the namespace. A Secret of the same name not
+// delivered from the VirtualKey is left untouched, and false is returned.
+func (r *VirtualKeyReconciler) deliverSecret(ctx context.Context, virtualKey *authv1alpha1.VirtualKey, source *corev1.Secret, namespace string) (bool, error) {
+	labels := common.DeliveredFromLabels(virtualKeyKind, virtualKey)
+
+	existing := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: source.Name, Namespace: namespace}, existing); err == nil {
+		for label, value := range labels {
+			if existing.Labels[label] != value {
+				return false, nil
+			}
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("failed to get secret %s/%s: %w", namespace, source.Name, err)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: source.Name, Namespace: namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Labels = maps.Clone(source.Labels)
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		maps.Copy(secret.Labels, labels)
+		secret.Annotations = maps.Clone(source.Annotations)
+		secret.Type = source.Type
+		secret.Data = maps.Clone(source.Data)
+		return nil
+	}); err != nil {
+		return false, fmt.Errorf("failed to deliver secret %s to namespace %s: %w", source.Name, namespace, err)
+	}
+	return true, nil
+}
+
+// removeDeliveredSecrets deletes the copies of the key Secret that are not kept
+func (r *VirtualKeyReconciler) removeDeliveredSecrets(ctx context.Context, virtualKey *authv1alpha1.VirtualKey, keep func(*corev1.Secret) bool) error {
+	secrets := &corev1.SecretList{}
+	if err := r.List(ctx, secrets, client.MatchingLabels(common.DeliveredFromLabels(virtualKeyKind, virtualKey))); err != nil {
+		return fmt.Errorf("failed to list delivered secrets: %w", err)
+	}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if keep != nil && keep(secret) {
+			continue
+		}
+		if err := r.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete delivered secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+	}
+	return nil
+}
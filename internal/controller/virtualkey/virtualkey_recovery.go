@@ -0,0 +1,157 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualkey
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+)
+
+const (
+	// CondSecretOutOfSync reports that the key Secret no longer holds the key in LiteLLM
+	CondSecretOutOfSync = "SecretOutOfSync"
+
+	// Recovery policies of a key lost from the key Secret
+	RecoveryPolicyNone       = "None"
+	RecoveryPolicyRegenerate = "Regenerate"
+
+	ReasonKeyInSync      = "InSync"
+	ReasonKeyMissing     = "KeyMissing"
+	ReasonKeyMismatch    = "KeyMismatch"
+	ReasonKeyRegenerated = "KeyRegenerated"
+)
+
+// ensureKeyInSync checks that the key Secret still holds the key in LiteLLM. LiteLLM shows a key only once, so a
+// key lost from the Secret, e.g. when the Secret is deleted or the VirtualKey is restored from a backup, is either
+// regenerated under the Regenerate recovery policy or reported with the SecretOutOfSync condition.
+func (r *VirtualKeyReconciler) ensureKeyInSync(ctx context.Context, virtualKey *authv1alpha1.VirtualKey, externalData *ExternalData) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	if virtualKey.Status.KeySecretRef == "" {
+		if externalData.KeyID == "" || externalData.Key != "" {
+			return ctrl.Result{}, nil
+		}
+		// A VirtualKey restored without its status finds its key in LiteLLM by alias, so the key is expected in
+		// the Secret it is written to
+		virtualKey.Status.KeySecretRef = r.keySecretName(virtualKey)
+	}
+	if externalData.Key != "" {
+		// The key is known and is written to the Secret with the other children
+		virtualKey.Status.KeyHash = common.KeyHash(externalData.Key)
+		r.SetCondition(virtualKey, CondSecretOutOfSync, metav1.ConditionFalse, ReasonKeyInSync, "Key Secret holds the key")
+		return ctrl.Result{}, nil
+	}
+
+	secretHash, err := r.keySecretHash(ctx, virtualKey)
+	if err != nil {
+		log.Error(err, "Failed to read key Secret")
+		return r.handleKeySecretError(ctx, virtualKey, err)
+	}
+
+	// LiteLLM identifies the key by its hash, which is compared when LiteLLM reports it
+	expectedHash := externalData.KeyHash
+	if expectedHash == "" {
+		expectedHash = virtualKey.Status.KeyHash
+	}
+	var reason, message string
+	switch {
+	case secretHash == "":
+		reason = ReasonKeyMissing
+		message = fmt.Sprintf("Secret %s no longer holds the key", virtualKey.Status.KeySecretRef)
+	case expectedHash != "" && secretHash != expectedHash:
+		reason = ReasonKeyMismatch
+		message = fmt.Sprintf("Secret %s does not hold the key in LiteLLM", virtualKey.Status.KeySecretRef)
+	default:
+		virtualKey.Status.KeyHash = secretHash
+		r.SetCondition(virtualKey, CondSecretOutOfSync, metav1.ConditionFalse, ReasonKeyInSync, "Key Secret holds the key")
+		return ctrl.Result{}, nil
+	}
+
+	if virtualKey.Spec.RecoveryPolicy != RecoveryPolicyRegenerate || externalData.KeyID == "" {
+		log.Info("Key Secret is out of sync with LiteLLM", "keyAlias", virtualKey.Spec.KeyAlias, "reason", reason)
+		r.SetErrorConditions(virtualKey, reason, message)
+		r.SetCondition(virtualKey, CondSecretOutOfSync, metav1.ConditionTrue, reason, message)
+		if err := r.PatchStatus(ctx, virtualKey); err != nil {
+			r.InstrumentReconcileError()
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: defaultRequeueAfter}, nil
+	}
+
+	// The lost key can no longer be used, so it is replaced without a grace period
+	log.Info("Regenerating virtual key lost from the key Secret", "keyAlias", virtualKey.Spec.KeyAlias, "reason", reason)
+	regenerated, err := r.LitellmClient.RegenerateVirtualKey(ctx, externalData.KeyID, "")
+	if err != nil {
+		log.Error(err, "Failed to regenerate virtual key in LiteLLM")
+		return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonLitellmError)
+	}
+	if regenerated.Key == "" {
+		err := fmt.Errorf("LiteLLM did not return the regenerated key for %s", virtualKey.Spec.KeyAlias)
+		return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonLitellmError)
+	}
+
+	externalData.Key = regenerated.Key
+	if regenerated.Token != "" {
+		externalData.KeyID = regenerated.Token
+		virtualKey.Status.KeyID = regenerated.Token
+		virtualKey.Status.Token = regenerated.Token
+	}
+	if regenerated.KeyName != "" {
+		virtualKey.Status.KeyName = regenerated.KeyName
+	}
+	virtualKey.Status.KeyHash = common.KeyHash(regenerated.Key)
+	r.SetCondition(virtualKey, CondSecretOutOfSync, metav1.ConditionFalse, ReasonKeyRegenerated,
+		fmt.Sprintf("Key was regenerated as %s", message))
+
+	// The new key is written to the Secret with the other children even when the status cannot be patched
+	if err := r.PatchStatus(ctx, virtualKey); err != nil {
+		log.Error(err, "Failed to update status after regenerating the key")
+	}
+	log.Info("Successfully regenerated virtual key", "keyAlias", virtualKey.Spec.KeyAlias)
+	return ctrl.Result{}, nil
+}
+
+// keySecretHash returns the hash of the key in the key Secret, or an empty string when the Secret holds no key. A
+// Secret not controlled by the VirtualKey is reported as a conflict.
+func (r *VirtualKeyReconciler) keySecretHash(ctx context.Context, virtualKey *authv1alpha1.VirtualKey) (string, error) {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: virtualKey.Status.KeySecretRef, Namespace: virtualKey.Namespace}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get secret %s: %w", virtualKey.Status.KeySecretRef, err)
+	}
+	if !metav1.IsControlledBy(secret, virtualKey) {
+		return "", fmt.Errorf("%w: %s", common.ErrKeySecretConflict, secret.Name)
+	}
+	key := secret.Data[common.KeySecretKey]
+	if len(key) == 0 {
+		return "", nil
+	}
+	return common.KeyHash(string(key)), nil
+}
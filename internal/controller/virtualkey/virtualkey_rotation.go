@@ -0,0 +1,204 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualkey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/base"
+	"github.com/bbdsoftware/litellm-operator/internal/controller/common"
+	"github.com/bbdsoftware/litellm-operator/internal/litellm"
+)
+
+const (
+	// RotateAnnotation rotates the key when set to a value not yet recorded in status.rotationTrigger
+	RotateAnnotation = "litellm.ai/rotate"
+
+	defaultRotationGracePeriod = 24 * time.Hour
+	defaultRequeueAfter        = 60 * time.Second
+)
+
+// ensureRotation revokes the previous key once its grace period has passed and regenerates the key when
+// the rotation interval has elapsed or the rotate annotation has changed. A changed rotate annotation revokes
+// the previous key immediately.
+func (r *VirtualKeyReconciler) ensureRotation(ctx context.Context, virtualKey *authv1alpha1.VirtualKey, externalData *ExternalData) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	now := time.Now()
+
+	trigger := virtualKey.Annotations[RotateAnnotation]
+	triggered := trigger != "" && trigger != virtualKey.Status.RotationTrigger
+
+	if virtualKey.Status.PreviousKeyID != "" {
+		// Scheduled rotations wait for the previous key to be revoked, while the rotate annotation revokes it
+		// before the end of its grace period, e.g. when a key has leaked
+		if revokeAt := virtualKey.Status.PreviousKeyRevokeAt; revokeAt != nil && now.Before(revokeAt.Time) && !triggered {
+			setNextRotation(virtualKey)
+			return ctrl.Result{}, nil
+		}
+		if err := r.revokePreviousKey(ctx, virtualKey); err != nil {
+			log.Error(err, "Failed to revoke previous virtual key", "keyAlias", virtualKey.Spec.KeyAlias)
+			return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonLitellmError)
+		}
+		log.Info("Revoked previous virtual key", "keyAlias", virtualKey.Spec.KeyAlias, "triggered", triggered)
+	}
+
+	setNextRotation(virtualKey)
+
+	due := virtualKey.Status.NextRotationAt != nil && !now.Before(virtualKey.Status.NextRotationAt.Time)
+	if !triggered && !due {
+		return ctrl.Result{}, nil
+	}
+	if externalData.KeyID == "" || virtualKey.Status.KeySecretRef == "" {
+		log.Info("Skipping rotation of a virtual key without a key Secret", "keyAlias", virtualKey.Spec.KeyAlias)
+		return ctrl.Result{}, nil
+	}
+
+	gracePeriod := rotationGracePeriod(virtualKey.Spec.Rotation)
+	previousKey := ""
+	if gracePeriod > 0 {
+		var err error
+		previousKey, err = r.getSecretKeyValue(ctx, virtualKey)
+		if err != nil {
+			log.Error(err, "Previous key is not available for the grace period", "keyAlias", virtualKey.Spec.KeyAlias)
+		}
+	}
+
+	log.Info("Rotating virtual key in LiteLLM", "keyAlias", virtualKey.Spec.KeyAlias, "triggered", triggered)
+	regenerated, err := r.LitellmClient.RegenerateVirtualKey(ctx, externalData.KeyID, litellmDuration(gracePeriod))
+	if err != nil {
+		log.Error(err, "Failed to regenerate virtual key in LiteLLM")
+		return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonLitellmError)
+	}
+	if regenerated.Key == "" {
+		err := fmt.Errorf("LiteLLM did not return the regenerated key for %s", virtualKey.Spec.KeyAlias)
+		return r.HandleErrorRetryable(ctx, virtualKey, err, base.ReasonLitellmError)
+	}
+
+	if gracePeriod > 0 {
+		virtualKey.Status.PreviousKeyID = externalData.KeyID
+		virtualKey.Status.PreviousKeyRevokeAt = &metav1.Time{Time: now.Add(gracePeriod)}
+		externalData.PreviousKey = previousKey
+	}
+
+	externalData.Key = regenerated.Key
+	if regenerated.Token != "" {
+		externalData.KeyID = regenerated.Token
+		virtualKey.Status.KeyID = regenerated.Token
+		virtualKey.Status.Token = regenerated.Token
+	}
+	if regenerated.KeyName != "" {
+		virtualKey.Status.KeyName = regenerated.KeyName
+	}
+	virtualKey.Status.LastRotatedAt = &metav1.Time{Time: now}
+	virtualKey.Status.RotationTrigger = trigger
+	setNextRotation(virtualKey)
+
+	// The new key is written to the Secret even when the status cannot be patched, as it is not shown again
+	if err := r.PatchStatus(ctx, virtualKey); err != nil {
+		log.Error(err, "Failed to update status after rotation")
+	}
+	log.Info("Successfully rotated virtual key in LiteLLM", "keyAlias", virtualKey.Spec.KeyAlias)
+	return ctrl.Result{}, nil
+}
+
+// revokePreviousKey deletes the key replaced by the last rotation from LiteLLM and the key Secret.
+func (r *VirtualKeyReconciler) revokePreviousKey(ctx context.Context, virtualKey *authv1alpha1.VirtualKey) error {
+	// LiteLLM versions without a grace period have already replaced the previous key
+	if _, err := r.LitellmClient.GetVirtualKeyInfo(ctx, virtualKey.Status.PreviousKeyID); err == nil {
+		if err := r.LitellmClient.DeleteVirtualKeyByID(ctx, virtualKey.Status.PreviousKeyID); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, litellm.ErrNotFound) {
+		return err
+	}
+
+	if virtualKey.Status.KeySecretRef != "" {
+		secret := &corev1.Secret{}
+		err := r.Get(ctx, types.NamespacedName{Name: virtualKey.Status.KeySecretRef, Namespace: virtualKey.Namespace}, secret)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get secret %s: %w", virtualKey.Status.KeySecretRef, err)
+		}
+		if _, exists := secret.Data[common.PreviousKeySecretKey]; err == nil && exists {
+			delete(secret.Data, common.PreviousKeySecretKey)
+			if err := r.Update(ctx, secret); err != nil {
+				return fmt.Errorf("failed to remove the previous key from secret %s: %w", secret.Name, err)
+			}
+		}
+	}
+
+	virtualKey.Status.PreviousKeyID = ""
+	virtualKey.Status.PreviousKeyRevokeAt = nil
+	return r.PatchStatus(ctx, virtualKey)
+}
+
+// setNextRotation sets the time the rotation interval next regenerates the key, counting from the last
+// rotation or the creation of the VirtualKey.
+func setNextRotation(virtualKey *authv1alpha1.VirtualKey) {
+	rotation := virtualKey.Spec.Rotation
+	if rotation == nil || rotation.Interval == nil || rotation.Interval.Duration <= 0 {
+		virtualKey.Status.NextRotationAt = nil
+		return
+	}
+
+	from := virtualKey.CreationTimestamp.Time
+	if virtualKey.Status.LastRotatedAt != nil {
+		from = virtualKey.Status.LastRotatedAt.Time
+	}
+	virtualKey.Status.NextRotationAt = &metav1.Time{Time: from.Add(rotation.Interval.Duration)}
+}
+
+// rotationGracePeriod returns how long the previous key remains valid after a rotation.
+func rotationGracePeriod(rotation *authv1alpha1.KeyRotation) time.Duration {
+	if rotation == nil || rotation.GracePeriod == nil {
+		return defaultRotationGracePeriod
+	}
+	return rotation.GracePeriod.Duration
+}
+
+// litellmDuration formats a duration as LiteLLM expects it, e.g. 86400s.
+func litellmDuration(duration time.Duration) string {
+	if duration <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%ds", int64(duration.Seconds()))
+}
+
+// requeueAfter returns when the VirtualKey is next reconciled: the periodic drift sync, or earlier when the
+// previous key is due to be revoked or the key is due to be rotated.
+func requeueAfter(virtualKey *authv1alpha1.VirtualKey) time.Duration {
+	after := defaultRequeueAfter
+	for _, at := range []*metav1.Time{virtualKey.Status.PreviousKeyRevokeAt, virtualKey.Status.NextRotationAt} {
+		if at == nil {
+			continue
+		}
+		if until := time.Until(at.Time); until < after {
+			after = max(until, time.Second)
+		}
+	}
+	return after
+}
@@ -0,0 +1,177 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type LitellmBudget interface {
+	CreateBudget(ctx context.Context, req *BudgetRequest) (BudgetTable, error)
+	DeleteBudget(ctx context.Context, budgetID string) error
+	GetBudget(ctx context.Context, budgetID string) (BudgetTable, error)
+	IsBudgetUpdateNeeded(ctx context.Context, budget *BudgetTable, req *BudgetRequest) bool
+	UpdateBudget(ctx context.Context, req *BudgetRequest) (BudgetTable, error)
+}
+
+// ModelBudget is the budget config LiteLLM applies to a single model
+type ModelBudget struct {
+	BudgetDuration string  `json:"budget_duration,omitempty"`
+	MaxBudget      float64 `json:"max_budget,omitempty"`
+	RPMLimit       int     `json:"rpm_limit,omitempty"`
+	TPMLimit       int     `json:"tpm_limit,omitempty"`
+}
+
+type BudgetRequest struct {
+	BudgetDuration      string                 `json:"budget_duration,omitempty"`
+	BudgetID            string                 `json:"budget_id,omitempty"`
+	MaxBudget           float64                `json:"max_budget,omitempty"`
+	MaxParallelRequests int                    `json:"max_parallel_requests,omitempty"`
+	ModelMaxBudget      map[string]ModelBudget `json:"model_max_budget,omitempty"`
+	RPMLimit            int                    `json:"rpm_limit,omitempty"`
+	SoftBudget          float64                `json:"soft_budget,omitempty"`
+	TPMLimit            int                    `json:"tpm_limit,omitempty"`
+}
+
+// BudgetTable is the budget LiteLLM links to keys, organizations and other entities
+type BudgetTable struct {
+	BudgetDuration      string                 `json:"budget_duration,omitempty"`
+	BudgetID            string                 `json:"budget_id,omitempty"`
+	BudgetResetAt       string                 `json:"budget_reset_at,omitempty"`
+	CreatedAt           string                 `json:"created_at,omitempty"`
+	MaxBudget           float64                `json:"max_budget,omitempty"`
+	MaxParallelRequests int                    `json:"max_parallel_requests,omitempty"`
+	ModelMaxBudget      map[string]ModelBudget `json:"model_max_budget,omitempty"`
+	RPMLimit            int                    `json:"rpm_limit,omitempty"`
+	SoftBudget          float64                `json:"soft_budget,omitempty"`
+	TPMLimit            int                    `json:"tpm_limit,omitempty"`
+	UpdatedAt           string                 `json:"updated_at,omitempty"`
+}
+
+// CreateBudget creates a new budget in the Litellm service
+func (l *LitellmClient) CreateBudget(ctx context.Context, req *BudgetRequest) (BudgetTable, error) {
+	return l.upsertBudget(ctx, "/budget/new", req)
+}
+
+// UpdateBudget updates an existing budget in the Litellm service
+func (l *LitellmClient) UpdateBudget(ctx context.Context, req *BudgetRequest) (BudgetTable, error) {
+	return l.upsertBudget(ctx, "/budget/update", req)
+}
+
+func (l *LitellmClient) upsertBudget(ctx context.Context, path string, req *BudgetRequest) (BudgetTable, error) {
+	log := log.FromContext(ctx)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		log.Error(err, "Failed to marshal budget request payload")
+		return BudgetTable{}, err
+	}
+
+	response, err := l.makeRequest(ctx, "POST", path, body)
+	if err != nil {
+		log.Error(err, "Failed to send budget to Litellm", "path", path)
+		return BudgetTable{}, err
+	}
+
+	var budget BudgetTable
+	if err := json.Unmarshal(response, &budget); err != nil {
+		log.Error(err, "Failed to unmarshal budget response from Litellm")
+		return BudgetTable{}, err
+	}
+
+	return budget, nil
+}
+
+// DeleteBudget deletes a budget from the Litellm service
+func (l *LitellmClient) DeleteBudget(ctx context.Context, budgetID string) error {
+	log := log.FromContext(ctx)
+
+	body, err := json.Marshal(map[string]string{"id": budgetID})
+	if err != nil {
+		log.Error(err, "Failed to marshal budget delete payload")
+		return err
+	}
+
+	if _, err := l.makeRequest(ctx, "POST", "/budget/delete", body); err != nil {
+		log.Error(err, "Failed to delete budget in Litellm")
+		return err
+	}
+
+	return nil
+}
+
+// GetBudget gets a budget from the Litellm service, returns ErrNotFound if the budget does not exist
+func (l *LitellmClient) GetBudget(ctx context.Context, budgetID string) (BudgetTable, error) {
+	log := log.FromContext(ctx)
+
+	body, err := json.Marshal(map[string][]string{"budgets": {budgetID}})
+	if err != nil {
+		log.Error(err, "Failed to marshal budget info payload")
+		return BudgetTable{}, err
+	}
+
+	response, err := l.makeRequest(ctx, "POST", "/budget/info", body)
+	if err != nil {
+		log.Error(err, "Failed to get budget with ID: "+budgetID)
+		return BudgetTable{}, err
+	}
+
+	var budgets []BudgetTable
+	if err := json.Unmarshal(response, &budgets); err != nil {
+		log.Error(err, "Failed to unmarshal budget response from Litellm")
+		return BudgetTable{}, err
+	}
+
+	for _, budget := range budgets {
+		if budget.BudgetID == budgetID {
+			return budget, nil
+		}
+	}
+	return BudgetTable{}, fmt.Errorf("%w: budget %s", ErrNotFound, budgetID)
+}
+
+// IsBudgetUpdateNeeded checks if the budget needs to be updated
+func (l *LitellmClient) IsBudgetUpdateNeeded(ctx context.Context, budget *BudgetTable, req *BudgetRequest) bool {
+	log := log.FromContext(ctx)
+
+	if budget.BudgetDuration != req.BudgetDuration {
+		log.Info("BudgetDuration changed")
+		return true
+	}
+
+	if budget.MaxBudget != req.MaxBudget {
+		log.Info("MaxBudget changed")
+		return true
+	}
+
+	if budget.MaxParallelRequests != req.MaxParallelRequests {
+		log.Info("MaxParallelRequests changed")
+		return true
+	}
+
+	if !cmp.Equal(budget.ModelMaxBudget, req.ModelMaxBudget, cmpopts.EquateEmpty()) {
+		log.Info("ModelMaxBudget changed")
+		return true
+	}
+
+	if budget.RPMLimit != req.RPMLimit {
+		log.Info("RPMLimit changed")
+		return true
+	}
+
+	if budget.SoftBudget != req.SoftBudget {
+		log.Info("SoftBudget changed")
+		return true
+	}
+
+	if budget.TPMLimit != req.TPMLimit {
+		log.Info("TPMLimit changed")
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Litellm Budget", func() {
+	var (
+		client *LitellmClient
+		server *httptest.Server
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+		}
+	})
+
+	Describe("GetBudget", func() {
+		It("requests the budget by ID from /budget/info", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				Expect(r.URL.Path).To(Equal("/budget/info"))
+
+				var body map[string][]string
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				Expect(body["budgets"]).To(Equal([]string{"finance"}))
+
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`[{"budget_id": "finance", "max_budget": 500, "soft_budget": 400,
+					"model_max_budget": {"gpt-4o": {"max_budget": 100, "budget_duration": "1d"}}}]`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			budget, err := client.GetBudget(ctx, "finance")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(budget.MaxBudget).To(Equal(500.0))
+			Expect(budget.SoftBudget).To(Equal(400.0))
+			Expect(budget.ModelMaxBudget).To(HaveKeyWithValue("gpt-4o", ModelBudget{MaxBudget: 100, BudgetDuration: "1d"}))
+		})
+
+		It("returns ErrNotFound when no budget matches", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`[]`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			_, err := client.GetBudget(ctx, "missing")
+			Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+		})
+	})
+
+	Describe("DeleteBudget", func() {
+		It("posts the budget ID to /budget/delete", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				Expect(r.URL.Path).To(Equal("/budget/delete"))
+
+				var body map[string]string
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				Expect(body).To(Equal(map[string]string{"id": "finance"}))
+
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			Expect(client.DeleteBudget(ctx, "finance")).To(Succeed())
+		})
+	})
+
+	Describe("IsBudgetUpdateNeeded", func() {
+		BeforeEach(func() {
+			client = NewLitellmClient("http://localhost", "test-master-key")
+		})
+
+		It("returns false when the budget matches", func() {
+			budget := &BudgetTable{BudgetID: "finance", MaxBudget: 500, ModelMaxBudget: map[string]ModelBudget{}}
+			req := &BudgetRequest{BudgetID: "finance", MaxBudget: 500}
+			Expect(client.IsBudgetUpdateNeeded(ctx, budget, req)).To(BeFalse())
+		})
+
+		It("returns true when a model budget changed", func() {
+			budget := &BudgetTable{BudgetID: "finance", ModelMaxBudget: map[string]ModelBudget{"gpt-4o": {MaxBudget: 100}}}
+			req := &BudgetRequest{BudgetID: "finance", ModelMaxBudget: map[string]ModelBudget{"gpt-4o": {MaxBudget: 200}}}
+			Expect(client.IsBudgetUpdateNeeded(ctx, budget, req)).To(BeTrue())
+		})
+	})
+})
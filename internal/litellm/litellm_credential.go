@@ -0,0 +1,119 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type LitellmCredential interface {
+	CreateCredential(ctx context.Context, req *CredentialRequest) error
+	DeleteCredential(ctx context.Context, credentialName string) error
+	GetCredential(ctx context.Context, credentialName string) (CredentialResponse, error)
+	IsCredentialUpdateNeeded(ctx context.Context, credential *CredentialResponse, req *CredentialRequest) bool
+	UpdateCredential(ctx context.Context, req *CredentialRequest) error
+}
+
+// CredentialRequest represents the request structure for creating/updating credentials
+type CredentialRequest struct {
+	CredentialName   string            `json:"credential_name"`
+	CredentialValues map[string]string `json:"credential_values,omitempty"`
+	CredentialInfo   map[string]string `json:"credential_info,omitempty"`
+}
+
+// CredentialResponse represents the response structure for credential operations.
+// LiteLLM masks the credential values, so only the name and info can be compared
+type CredentialResponse struct {
+	CredentialName   string                 `json:"credential_name"`
+	CredentialValues map[string]interface{} `json:"credential_values,omitempty"`
+	CredentialInfo   map[string]interface{} `json:"credential_info,omitempty"`
+}
+
+// CreateCredential creates a new credential in the LiteLLM service
+func (l *LitellmClient) CreateCredential(ctx context.Context, req *CredentialRequest) error {
+	log := log.FromContext(ctx)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		log.Error(err, "Failed to marshal credential request payload")
+		return err
+	}
+
+	if _, err := l.makeRequest(ctx, "POST", "/credentials", body); err != nil {
+		log.Error(err, "Failed to create credential in LiteLLM")
+		return err
+	}
+
+	return nil
+}
+
+// UpdateCredential updates an existing credential in the LiteLLM service
+func (l *LitellmClient) UpdateCredential(ctx context.Context, req *CredentialRequest) error {
+	log := log.FromContext(ctx)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		log.Error(err, "Failed to marshal credential update request payload")
+		return err
+	}
+
+	if _, err := l.makeRequest(ctx, "PATCH", "/credentials/"+url.PathEscape(req.CredentialName), body); err != nil {
+		log.Error(err, "Failed to update credential in LiteLLM")
+		return err
+	}
+
+	return nil
+}
+
+// GetCredential retrieves a credential by name from the LiteLLM service
+func (l *LitellmClient) GetCredential(ctx context.Context, credentialName string) (CredentialResponse, error) {
+	log := log.FromContext(ctx)
+
+	response, err := l.makeRequest(ctx, "GET", "/credentials/by_name/"+url.PathEscape(credentialName), nil)
+	if err != nil {
+		log.Error(err, "Failed to get credential from LiteLLM")
+		return CredentialResponse{}, err
+	}
+
+	var credentialResponse CredentialResponse
+	if err := json.Unmarshal(response, &credentialResponse); err != nil {
+		log.Error(err, "Failed to unmarshal credential response from LiteLLM")
+		return CredentialResponse{}, err
+	}
+
+	return credentialResponse, nil
+}
+
+// DeleteCredential deletes a credential from the LiteLLM service
+func (l *LitellmClient) DeleteCredential(ctx context.Context, credentialName string) error {
+	log := log.FromContext(ctx)
+
+	if _, err := l.makeRequest(ctx, "DELETE", "/credentials/"+url.PathEscape(credentialName), nil); err != nil {
+		log.Error(err, "Failed to delete credential from LiteLLM")
+		return err
+	}
+
+	return nil
+}
+
+// IsCredentialUpdateNeeded checks if the credential needs to be updated.
+// Credential values are masked by LiteLLM, so changes to them must be detected by the caller
+func (l *LitellmClient) IsCredentialUpdateNeeded(ctx context.Context, credential *CredentialResponse, req *CredentialRequest) bool {
+	log := log.FromContext(ctx)
+
+	expectedInfo := make(map[string]interface{}, len(req.CredentialInfo))
+	for key, value := range req.CredentialInfo {
+		expectedInfo[key] = value
+	}
+
+	if !cmp.Equal(credential.CredentialInfo, expectedInfo, cmpopts.EquateEmpty()) {
+		log.Info("CredentialInfo changed")
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Litellm Credential", func() {
+	var (
+		client *LitellmClient
+		server *httptest.Server
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+		}
+	})
+
+	Describe("CreateCredential", func() {
+		It("posts the credential to /credentials", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				Expect(r.URL.Path).To(Equal("/credentials"))
+
+				var req CredentialRequest
+				Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+				Expect(req.CredentialName).To(Equal("shared-openai"))
+				Expect(req.CredentialValues).To(HaveKeyWithValue("api_key", "sk-test"))
+
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"success": true}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			err := client.CreateCredential(ctx, &CredentialRequest{
+				CredentialName:   "shared-openai",
+				CredentialValues: map[string]string{"api_key": "sk-test"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("UpdateCredential", func() {
+		It("patches the named credential", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PATCH"))
+				Expect(r.URL.Path).To(Equal("/credentials/shared-openai"))
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"success": true}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			Expect(client.UpdateCredential(ctx, &CredentialRequest{CredentialName: "shared-openai"})).To(Succeed())
+		})
+	})
+
+	Describe("GetCredential", func() {
+		It("returns the credential by name", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				Expect(r.URL.Path).To(Equal("/credentials/by_name/shared-openai"))
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"credential_name": "shared-openai", "credential_info": {"custom_llm_provider": "openai"}}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			credential, err := client.GetCredential(ctx, "shared-openai")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credential.CredentialName).To(Equal("shared-openai"))
+			Expect(credential.CredentialInfo).To(HaveKeyWithValue("custom_llm_provider", "openai"))
+		})
+
+		It("returns ErrNotFound when the credential does not exist", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			_, err := client.GetCredential(ctx, "missing")
+			Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+		})
+	})
+
+	Describe("DeleteCredential", func() {
+		It("deletes the named credential", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("DELETE"))
+				Expect(r.URL.Path).To(Equal("/credentials/shared-openai"))
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"success": true}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			Expect(client.DeleteCredential(ctx, "shared-openai")).To(Succeed())
+		})
+	})
+
+	Describe("IsCredentialUpdateNeeded", func() {
+		BeforeEach(func() {
+			client = NewLitellmClient("http://localhost", "test-master-key")
+		})
+
+		It("returns false when the credential info matches", func() {
+			credential := &CredentialResponse{CredentialName: "c", CredentialInfo: map[string]interface{}{"custom_llm_provider": "openai"}}
+			req := &CredentialRequest{CredentialName: "c", CredentialInfo: map[string]string{"custom_llm_provider": "openai"}}
+			Expect(client.IsCredentialUpdateNeeded(ctx, credential, req)).To(BeFalse())
+		})
+
+		It("treats empty and missing credential info as equal", func() {
+			credential := &CredentialResponse{CredentialName: "c", CredentialInfo: map[string]interface{}{}}
+			req := &CredentialRequest{CredentialName: "c"}
+			Expect(client.IsCredentialUpdateNeeded(ctx, credential, req)).To(BeFalse())
+		})
+
+		It("returns true when the credential info changed", func() {
+			credential := &CredentialResponse{CredentialName: "c", CredentialInfo: map[string]interface{}{"custom_llm_provider": "azure"}}
+			req := &CredentialRequest{CredentialName: "c", CredentialInfo: map[string]string{"custom_llm_provider": "openai"}}
+			Expect(client.IsCredentialUpdateNeeded(ctx, credential, req)).To(BeTrue())
+		})
+	})
+})
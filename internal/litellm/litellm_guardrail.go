@@ -0,0 +1,244 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type LitellmGuardrail interface {
+	CreateGuardrail(ctx context.Context, req *GuardrailRequest) (GuardrailResponse, error)
+	DeleteGuardrail(ctx context.Context, guardrailID string) error
+	GetGuardrail(ctx context.Context, guardrailID string) (GuardrailResponse, error)
+	GetGuardrailID(ctx context.Context, guardrailName string) (string, error)
+	IsGuardrailUpdateNeeded(ctx context.Context, guardrail *GuardrailResponse, req *GuardrailRequest) bool
+	UpdateGuardrail(ctx context.Context, guardrailID string, req *GuardrailRequest) (GuardrailResponse, error)
+	GuardrailLister
+}
+
+// GuardrailLister lists the guardrails known to LiteLLM, including those defined in the proxy config
+type GuardrailLister interface {
+	ListGuardrails(ctx context.Context) ([]GuardrailResponse, error)
+}
+
+// GuardrailRequest describes a guardrail to create or update.
+// Params and SecretParams are flattened into litellm_params next to the provider and mode
+type GuardrailRequest struct {
+	GuardrailName string
+	Provider      string
+	Mode          string
+	DefaultOn     bool
+	Params        map[string]string
+	SecretParams  map[string]string
+}
+
+// GuardrailResponse represents a guardrail returned by LiteLLM. Secret params may be masked
+type GuardrailResponse struct {
+	GuardrailID   string                 `json:"guardrail_id,omitempty"`
+	GuardrailName string                 `json:"guardrail_name,omitempty"`
+	LitellmParams map[string]interface{} `json:"litellm_params,omitempty"`
+	GuardrailInfo map[string]interface{} `json:"guardrail_info,omitempty"`
+	CreatedAt     string                 `json:"created_at,omitempty"`
+	UpdatedAt     string                 `json:"updated_at,omitempty"`
+}
+
+type guardrailPayload struct {
+	Guardrail guardrailBody `json:"guardrail"`
+}
+
+type guardrailBody struct {
+	GuardrailName string                 `json:"guardrail_name"`
+	LitellmParams map[string]interface{} `json:"litellm_params"`
+}
+
+type guardrailListResponse struct {
+	Guardrails []GuardrailResponse `json:"guardrails"`
+}
+
+// litellmParams returns the litellm_params sent to LiteLLM for the request
+func (req *GuardrailRequest) litellmParams() map[string]interface{} {
+	params := make(map[string]interface{}, len(req.Params)+len(req.SecretParams)+3)
+	for key, value := range req.Params {
+		params[key] = value
+	}
+	for key, value := range req.SecretParams {
+		params[key] = value
+	}
+	params["guardrail"] = req.Provider
+	params["mode"] = req.Mode
+	params["default_on"] = req.DefaultOn
+	return params
+}
+
+func (req *GuardrailRequest) marshal() ([]byte, error) {
+	return json.Marshal(guardrailPayload{Guardrail: guardrailBody{
+		GuardrailName: req.GuardrailName,
+		LitellmParams: req.litellmParams(),
+	}})
+}
+
+// CreateGuardrail creates a new guardrail in the Litellm service
+func (l *LitellmClient) CreateGuardrail(ctx context.Context, req *GuardrailRequest) (GuardrailResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := req.marshal()
+	if err != nil {
+		log.Error(err, "Failed to marshal guardrail request payload")
+		return GuardrailResponse{}, err
+	}
+
+	response, err := l.makeRequest(ctx, "POST", "/guardrails", body)
+	if err != nil {
+		log.Error(err, "Failed to create guardrail in Litellm")
+		return GuardrailResponse{}, err
+	}
+
+	var guardrailResponse GuardrailResponse
+	if err := json.Unmarshal(response, &guardrailResponse); err != nil {
+		log.Error(err, "Failed to unmarshal guardrail response from Litellm")
+		return GuardrailResponse{}, err
+	}
+
+	return guardrailResponse, nil
+}
+
+// UpdateGuardrail replaces an existing guardrail in the Litellm service
+func (l *LitellmClient) UpdateGuardrail(ctx context.Context, guardrailID string, req *GuardrailRequest) (GuardrailResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := req.marshal()
+	if err != nil {
+		log.Error(err, "Failed to marshal guardrail update request payload")
+		return GuardrailResponse{}, err
+	}
+
+	response, err := l.makeRequest(ctx, "PUT", "/guardrails/"+url.PathEscape(guardrailID), body)
+	if err != nil {
+		log.Error(err, "Failed to update guardrail in Litellm")
+		return GuardrailResponse{}, err
+	}
+
+	var guardrailResponse GuardrailResponse
+	if err := json.Unmarshal(response, &guardrailResponse); err != nil {
+		log.Error(err, "Failed to unmarshal guardrail response from Litellm")
+		return GuardrailResponse{}, err
+	}
+
+	return guardrailResponse, nil
+}
+
+// DeleteGuardrail deletes a guardrail from the Litellm service
+func (l *LitellmClient) DeleteGuardrail(ctx context.Context, guardrailID string) error {
+	log := log.FromContext(ctx)
+
+	if _, err := l.makeRequest(ctx, "DELETE", "/guardrails/"+url.PathEscape(guardrailID), nil); err != nil {
+		log.Error(err, "Failed to delete guardrail in Litellm")
+		return err
+	}
+
+	return nil
+}
+
+// GetGuardrail gets a guardrail from the Litellm service
+func (l *LitellmClient) GetGuardrail(ctx context.Context, guardrailID string) (GuardrailResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := l.makeRequest(ctx, "GET", "/guardrails/"+url.PathEscape(guardrailID)+"/info", nil)
+	if err != nil {
+		log.Error(err, "Failed to get guardrail with ID: "+guardrailID)
+		return GuardrailResponse{}, err
+	}
+
+	var response GuardrailResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		log.Error(err, "Failed to unmarshal guardrail response from Litellm")
+		return GuardrailResponse{}, err
+	}
+
+	return response, nil
+}
+
+// ListGuardrails lists the guardrails in the Litellm service, whether stored in the database or the proxy config
+func (l *LitellmClient) ListGuardrails(ctx context.Context) ([]GuardrailResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := l.makeRequest(ctx, "GET", "/guardrails/list", nil)
+	if err != nil {
+		log.Error(err, "Failed to list guardrails")
+		return nil, err
+	}
+
+	var response guardrailListResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		log.Error(err, "Failed to unmarshal response from Litellm")
+		return nil, err
+	}
+
+	return response.Guardrails, nil
+}
+
+// GetGuardrailID gets the ID of a guardrail from the Litellm service, returns empty string if the guardrail name is not found.
+// Guardrails defined in the proxy config have no ID and are never returned
+func (l *LitellmClient) GetGuardrailID(ctx context.Context, guardrailName string) (string, error) {
+	guardrails, err := l.ListGuardrails(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, guardrail := range guardrails {
+		if guardrail.GuardrailName == guardrailName && guardrail.GuardrailID != "" {
+			return guardrail.GuardrailID, nil
+		}
+	}
+	return "", nil
+}
+
+// IsGuardrailUpdateNeeded checks if the guardrail needs to be updated.
+// Secret params may be masked by LiteLLM, so changes to them must be detected by the caller
+func (l *LitellmClient) IsGuardrailUpdateNeeded(ctx context.Context, guardrail *GuardrailResponse, req *GuardrailRequest) bool {
+	log := log.FromContext(ctx)
+
+	if guardrail.GuardrailName != req.GuardrailName {
+		log.Info("GuardrailName changed")
+		return true
+	}
+
+	if guardrailParam(guardrail, "guardrail") != req.Provider {
+		log.Info("Provider changed")
+		return true
+	}
+
+	if guardrailParam(guardrail, "mode") != req.Mode {
+		log.Info("Mode changed")
+		return true
+	}
+
+	if defaultOn, _ := guardrail.LitellmParams["default_on"].(bool); defaultOn != req.DefaultOn {
+		log.Info("DefaultOn changed")
+		return true
+	}
+
+	for key, value := range req.Params {
+		if guardrailParam(guardrail, key) != value {
+			log.Info("Params changed", "param", key)
+			return true
+		}
+	}
+
+	return false
+}
+
+// guardrailParam returns a litellm_params value of the guardrail as a string, or an empty string if it is unset
+func guardrailParam(guardrail *GuardrailResponse, key string) string {
+	value, ok := guardrail.LitellmParams[key]
+	if !ok || value == nil {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(value)
+}
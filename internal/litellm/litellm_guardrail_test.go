@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Litellm Guardrail", func() {
+	var (
+		client *LitellmClient
+		server *httptest.Server
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+		}
+	})
+
+	Describe("CreateGuardrail", func() {
+		It("flattens the provider, mode and params into litellm_params", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				Expect(r.URL.Path).To(Equal("/guardrails"))
+
+				var body guardrailPayload
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				Expect(body.Guardrail.GuardrailName).To(Equal("aporia-pre"))
+				Expect(body.Guardrail.LitellmParams).To(Equal(map[string]interface{}{
+					"guardrail":  "aporia",
+					"mode":       "pre_call",
+					"default_on": true,
+					"api_base":   "https://aporia.example.com",
+					"api_key":    "sk-aporia",
+				}))
+
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"guardrail_id": "g-1", "guardrail_name": "aporia-pre"}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			guardrail, err := client.CreateGuardrail(ctx, &GuardrailRequest{
+				GuardrailName: "aporia-pre",
+				Provider:      "aporia",
+				Mode:          "pre_call",
+				DefaultOn:     true,
+				Params:        map[string]string{"api_base": "https://aporia.example.com"},
+				SecretParams:  map[string]string{"api_key": "sk-aporia"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(guardrail.GuardrailID).To(Equal("g-1"))
+		})
+	})
+
+	Describe("GetGuardrail", func() {
+		It("returns ErrNotFound for a missing guardrail", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/guardrails/g-1/info"))
+				w.WriteHeader(http.StatusNotFound)
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			_, err := client.GetGuardrail(ctx, "g-1")
+			Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+		})
+	})
+
+	Describe("GetGuardrailID", func() {
+		It("ignores guardrails defined in the proxy config", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/guardrails/list"))
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"guardrails": [{"guardrail_name": "from-config"}, {"guardrail_id": "g-2", "guardrail_name": "from-db"}]}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			Expect(client.GetGuardrailID(ctx, "from-db")).To(Equal("g-2"))
+			Expect(client.GetGuardrailID(ctx, "from-config")).To(BeEmpty())
+		})
+	})
+
+	Describe("IsGuardrailUpdateNeeded", func() {
+		var guardrail *GuardrailResponse
+
+		BeforeEach(func() {
+			client = NewLitellmClient("http://localhost", "test-master-key")
+			guardrail = &GuardrailResponse{
+				GuardrailName: "aporia-pre",
+				LitellmParams: map[string]interface{}{
+					"guardrail":  "aporia",
+					"mode":       "pre_call",
+					"default_on": true,
+					"api_base":   "https://aporia.example.com",
+					"api_key":    "sk-ap****",
+				},
+			}
+		})
+
+		It("returns false when only masked secret params differ", func() {
+			req := &GuardrailRequest{
+				GuardrailName: "aporia-pre",
+				Provider:      "aporia",
+				Mode:          "pre_call",
+				DefaultOn:     true,
+				Params:        map[string]string{"api_base": "https://aporia.example.com"},
+				SecretParams:  map[string]string{"api_key": "sk-aporia"},
+			}
+			Expect(client.IsGuardrailUpdateNeeded(ctx, guardrail, req)).To(BeFalse())
+		})
+
+		It("returns true when the mode changed", func() {
+			req := &GuardrailRequest{GuardrailName: "aporia-pre", Provider: "aporia", Mode: "post_call", DefaultOn: true}
+			Expect(client.IsGuardrailUpdateNeeded(ctx, guardrail, req)).To(BeTrue())
+		})
+
+		It("returns true when a param changed", func() {
+			req := &GuardrailRequest{
+				GuardrailName: "aporia-pre",
+				Provider:      "aporia",
+				Mode:          "pre_call",
+				DefaultOn:     true,
+				Params:        map[string]string{"api_base": "https://other.example.com"},
+			}
+			Expect(client.IsGuardrailUpdateNeeded(ctx, guardrail, req)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,265 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type LitellmMCPServer interface {
+	CreateMCPServer(ctx context.Context, req *MCPServerRequest) (MCPServerResponse, error)
+	DeleteMCPServer(ctx context.Context, serverID string) error
+	GetMCPServer(ctx context.Context, serverID string) (MCPServerResponse, error)
+	GetMCPServerID(ctx context.Context, serverName string) (string, error)
+	IsMCPServerUpdateNeeded(ctx context.Context, server *MCPServerResponse, req *MCPServerRequest) bool
+	ListMCPServerTools(ctx context.Context, serverID string) ([]MCPToolResponse, error)
+	UpdateMCPServer(ctx context.Context, req *MCPServerRequest) (MCPServerResponse, error)
+}
+
+// ObjectPermission lists the objects a key or team may use beyond models.
+// MCPServers is sent even when empty so that revoked servers are removed
+type ObjectPermission struct {
+	MCPServers []string `json:"mcp_servers"`
+}
+
+// MCPCredentials holds the auth value LiteLLM sends to the MCP server
+type MCPCredentials struct {
+	AuthValue string `json:"auth_value,omitempty"`
+}
+
+type MCPServerRequest struct {
+	Alias        string            `json:"alias,omitempty"`
+	AllowedTools []string          `json:"allowed_tools,omitempty"`
+	Args         []string          `json:"args,omitempty"`
+	AuthType     string            `json:"auth_type,omitempty"`
+	Command      string            `json:"command,omitempty"`
+	Credentials  *MCPCredentials   `json:"credentials,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	Env          map[string]string `json:"env,omitempty"`
+	ServerID     string            `json:"server_id,omitempty"`
+	ServerName   string            `json:"server_name,omitempty"`
+	Transport    string            `json:"transport,omitempty"`
+	URL          string            `json:"url,omitempty"`
+}
+
+type MCPServerResponse struct {
+	Alias        string            `json:"alias,omitempty"`
+	AllowedTools []string          `json:"allowed_tools,omitempty"`
+	Args         []string          `json:"args,omitempty"`
+	AuthType     string            `json:"auth_type,omitempty"`
+	Command      string            `json:"command,omitempty"`
+	CreatedAt    string            `json:"created_at,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	Env          map[string]string `json:"env,omitempty"`
+	ServerID     string            `json:"server_id,omitempty"`
+	ServerName   string            `json:"server_name,omitempty"`
+	Transport    string            `json:"transport,omitempty"`
+	UpdatedAt    string            `json:"updated_at,omitempty"`
+	URL          string            `json:"url,omitempty"`
+}
+
+type MCPToolResponse struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateMCPServer registers a new MCP server with the Litellm service
+func (l *LitellmClient) CreateMCPServer(ctx context.Context, req *MCPServerRequest) (MCPServerResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		log.Error(err, "Failed to marshal MCP server request payload")
+		return MCPServerResponse{}, err
+	}
+
+	response, err := l.makeRequest(ctx, "POST", "/v1/mcp/server", body)
+	if err != nil {
+		log.Error(err, "Failed to create MCP server in Litellm")
+		return MCPServerResponse{}, err
+	}
+
+	var serverResponse MCPServerResponse
+	if err := json.Unmarshal(response, &serverResponse); err != nil {
+		log.Error(err, "Failed to unmarshal MCP server response from Litellm")
+		return MCPServerResponse{}, err
+	}
+
+	return serverResponse, nil
+}
+
+// UpdateMCPServer updates an existing MCP server in the Litellm service
+func (l *LitellmClient) UpdateMCPServer(ctx context.Context, req *MCPServerRequest) (MCPServerResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		log.Error(err, "Failed to marshal MCP server update request payload")
+		return MCPServerResponse{}, err
+	}
+
+	response, err := l.makeRequest(ctx, "PUT", "/v1/mcp/server", body)
+	if err != nil {
+		log.Error(err, "Failed to update MCP server in Litellm")
+		return MCPServerResponse{}, err
+	}
+
+	var serverResponse MCPServerResponse
+	if err := json.Unmarshal(response, &serverResponse); err != nil {
+		log.Error(err, "Failed to unmarshal MCP server response from Litellm")
+		return MCPServerResponse{}, err
+	}
+
+	return serverResponse, nil
+}
+
+// DeleteMCPServer deletes an MCP server from the Litellm service
+func (l *LitellmClient) DeleteMCPServer(ctx context.Context, serverID string) error {
+	log := log.FromContext(ctx)
+
+	if _, err := l.makeRequest(ctx, "DELETE", "/v1/mcp/server/"+url.PathEscape(serverID), nil); err != nil {
+		log.Error(err, "Failed to delete MCP server in Litellm")
+		return err
+	}
+
+	return nil
+}
+
+// GetMCPServer gets an MCP server from the Litellm service
+func (l *LitellmClient) GetMCPServer(ctx context.Context, serverID string) (MCPServerResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := l.makeRequest(ctx, "GET", "/v1/mcp/server/"+url.PathEscape(serverID), nil)
+	if err != nil {
+		log.Error(err, "Failed to get MCP server with ID: "+serverID)
+		return MCPServerResponse{}, err
+	}
+
+	var response MCPServerResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		log.Error(err, "Failed to unmarshal MCP server response from Litellm")
+		return MCPServerResponse{}, err
+	}
+
+	return response, nil
+}
+
+// GetMCPServerID gets the ID of an MCP server from the Litellm service, returns empty string if the server name is not found
+func (l *LitellmClient) GetMCPServerID(ctx context.Context, serverName string) (string, error) {
+	log := log.FromContext(ctx)
+
+	body, err := l.makeRequest(ctx, "GET", "/v1/mcp/server", nil)
+	if err != nil {
+		log.Error(err, "Failed to list MCP servers")
+		return "", err
+	}
+
+	var servers []MCPServerResponse
+	if err := json.Unmarshal(body, &servers); err != nil {
+		log.Error(err, "Failed to unmarshal response from Litellm")
+		return "", err
+	}
+
+	for _, server := range servers {
+		if server.ServerName == serverName {
+			return server.ServerID, nil
+		}
+	}
+	return "", nil
+}
+
+// ListMCPServerTools lists the tools LiteLLM discovered on an MCP server
+func (l *LitellmClient) ListMCPServerTools(ctx context.Context, serverID string) ([]MCPToolResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := l.makeRequest(ctx, "GET", "/mcp-rest/tools/list?server_id="+url.QueryEscape(serverID), nil)
+	if err != nil {
+		log.Error(err, "Failed to list tools of MCP server with ID: "+serverID)
+		return nil, err
+	}
+
+	var response struct {
+		Tools []MCPToolResponse `json:"tools"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		log.Error(err, "Failed to unmarshal MCP tools response from Litellm")
+		return nil, err
+	}
+
+	return response.Tools, nil
+}
+
+// IsMCPServerUpdateNeeded checks if the MCP server needs to be updated.
+// LiteLLM does not return credentials, so changes to them must be detected by the caller
+func (l *LitellmClient) IsMCPServerUpdateNeeded(ctx context.Context, server *MCPServerResponse, req *MCPServerRequest) bool {
+	log := log.FromContext(ctx)
+
+	if server.Alias != req.Alias {
+		log.Info("Alias changed")
+		return true
+	}
+
+	if !cmp.Equal(server.AllowedTools, req.AllowedTools, cmpopts.EquateEmpty()) {
+		log.Info("AllowedTools changed")
+		return true
+	}
+
+	if !cmp.Equal(server.Args, req.Args, cmpopts.EquateEmpty()) {
+		log.Info("Args changed")
+		return true
+	}
+
+	if server.AuthType != req.AuthType {
+		log.Info("AuthType changed")
+		return true
+	}
+
+	if server.Command != req.Command {
+		log.Info("Command changed")
+		return true
+	}
+
+	if server.Description != req.Description {
+		log.Info("Description changed")
+		return true
+	}
+
+	if !cmp.Equal(server.Env, req.Env, cmpopts.EquateEmpty()) {
+		log.Info("Env changed")
+		return true
+	}
+
+	if server.ServerName != req.ServerName {
+		log.Info("ServerName changed")
+		return true
+	}
+
+	if server.Transport != req.Transport {
+		log.Info("Transport changed")
+		return true
+	}
+
+	if server.URL != req.URL {
+		log.Info("URL changed")
+		return true
+	}
+
+	return false
+}
+
+// isObjectPermissionUpdateNeeded reports whether the MCP servers in the observed permission differ from
+// the requested ones. A nil request leaves the permission unmanaged
+func isObjectPermissionUpdateNeeded(observed, req *ObjectPermission) bool {
+	if req == nil {
+		return false
+	}
+	var observedServers []string
+	if observed != nil {
+		observedServers = observed.MCPServers
+	}
+	return !cmp.Equal(observedServers, req.MCPServers, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Litellm MCP Server", func() {
+	var (
+		client *LitellmClient
+		server *httptest.Server
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+		}
+	})
+
+	Describe("UpdateMCPServer", func() {
+		It("puts the server with its ID and credentials", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				Expect(r.URL.Path).To(Equal("/v1/mcp/server"))
+
+				var req MCPServerRequest
+				Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+				Expect(req.ServerID).To(Equal("mcp-1"))
+				Expect(req.Credentials).To(Equal(&MCPCredentials{AuthValue: "ghp-test"}))
+
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"server_id": "mcp-1", "server_name": "github"}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			updated, err := client.UpdateMCPServer(ctx, &MCPServerRequest{
+				ServerID:    "mcp-1",
+				ServerName:  "github",
+				Credentials: &MCPCredentials{AuthValue: "ghp-test"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.ServerName).To(Equal("github"))
+		})
+	})
+
+	Describe("GetMCPServerID", func() {
+		It("finds the server by name", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/v1/mcp/server"))
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`[{"server_id": "mcp-1", "server_name": "github"}, {"server_id": "mcp-2", "server_name": "jira"}]`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			Expect(client.GetMCPServerID(ctx, "jira")).To(Equal("mcp-2"))
+			Expect(client.GetMCPServerID(ctx, "missing")).To(BeEmpty())
+		})
+	})
+
+	Describe("ListMCPServerTools", func() {
+		It("lists the tools of the server", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/mcp-rest/tools/list"))
+				Expect(r.URL.Query().Get("server_id")).To(Equal("mcp-1"))
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"tools": [{"name": "create_issue", "description": "Create a GitHub issue", "inputSchema": {}}]}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			Expect(client.ListMCPServerTools(ctx, "mcp-1")).To(Equal([]MCPToolResponse{{Name: "create_issue", Description: "Create a GitHub issue"}}))
+		})
+	})
+
+	Describe("IsMCPServerUpdateNeeded", func() {
+		BeforeEach(func() {
+			client = NewLitellmClient("http://localhost", "test-master-key")
+		})
+
+		It("returns false when only the credentials differ", func() {
+			observed := &MCPServerResponse{ServerName: "github", Alias: "github", Transport: "http", URL: "http://github-mcp"}
+			req := &MCPServerRequest{ServerName: "github", Alias: "github", Transport: "http", URL: "http://github-mcp", Credentials: &MCPCredentials{AuthValue: "ghp-test"}}
+			Expect(client.IsMCPServerUpdateNeeded(ctx, observed, req)).To(BeFalse())
+		})
+
+		It("returns true when the allowed tools changed", func() {
+			observed := &MCPServerResponse{ServerName: "github", AllowedTools: []string{"create_issue"}}
+			req := &MCPServerRequest{ServerName: "github", AllowedTools: []string{"create_issue", "list_issues"}}
+			Expect(client.IsMCPServerUpdateNeeded(ctx, observed, req)).To(BeTrue())
+		})
+	})
+
+	Describe("isObjectPermissionUpdateNeeded", func() {
+		It("ignores an unmanaged permission", func() {
+			Expect(isObjectPermissionUpdateNeeded(&ObjectPermission{MCPServers: []string{"mcp-1"}}, nil)).To(BeFalse())
+		})
+
+		It("compares the servers regardless of order", func() {
+			observed := &ObjectPermission{MCPServers: []string{"mcp-2", "mcp-1"}}
+			Expect(isObjectPermissionUpdateNeeded(observed, &ObjectPermission{MCPServers: []string{"mcp-1", "mcp-2"}})).To(BeFalse())
+			Expect(isObjectPermissionUpdateNeeded(observed, &ObjectPermission{MCPServers: []string{}})).To(BeTrue())
+			Expect(isObjectPermissionUpdateNeeded(nil, &ObjectPermission{MCPServers: []string{}})).To(BeFalse())
+		})
+	})
+})
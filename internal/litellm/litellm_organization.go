@@ -0,0 +1,195 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type LitellmOrganization interface {
+	CreateOrganization(ctx context.Context, req *OrganizationRequest) (OrganizationResponse, error)
+	DeleteOrganization(ctx context.Context, organizationID string) error
+	GetOrganization(ctx context.Context, organizationID string) (OrganizationResponse, error)
+	GetOrganizationID(ctx context.Context, organizationAlias string) (string, error)
+	IsOrganizationUpdateNeeded(ctx context.Context, organization *OrganizationResponse, req *OrganizationRequest) bool
+	UpdateOrganization(ctx context.Context, req *OrganizationRequest) (OrganizationResponse, error)
+}
+
+type OrganizationRequest struct {
+	BudgetDuration    string            `json:"budget_duration,omitempty"`
+	MaxBudget         float64           `json:"max_budget,omitempty"`
+	Metadata          map[string]string `json:"metadata,omitempty"`
+	Models            []string          `json:"models,omitempty"`
+	OrganizationAlias string            `json:"organization_alias,omitempty"`
+	OrganizationID    string            `json:"organization_id,omitempty"`
+	RPMLimit          int               `json:"rpm_limit,omitempty"`
+	Tags              []string          `json:"tags,omitempty"`
+	TPMLimit          int               `json:"tpm_limit,omitempty"`
+}
+
+type OrganizationResponse struct {
+	BudgetID           string       `json:"budget_id,omitempty"`
+	CreatedAt          string       `json:"created_at,omitempty"`
+	LiteLLMBudgetTable *BudgetTable `json:"litellm_budget_table,omitempty"`
+	Models             []string     `json:"models,omitempty"`
+	OrganizationAlias  string       `json:"organization_alias,omitempty"`
+	OrganizationID     string       `json:"organization_id,omitempty"`
+	Spend              float64      `json:"spend,omitempty"`
+	UpdatedAt          string       `json:"updated_at,omitempty"`
+}
+
+// GetBudget returns the budget linked to the organization, or an empty budget if there is none
+func (o OrganizationResponse) GetBudget() BudgetTable {
+	if o.LiteLLMBudgetTable == nil {
+		return BudgetTable{}
+	}
+	return *o.LiteLLMBudgetTable
+}
+
+// CreateOrganization creates a new organization in the Litellm service
+func (l *LitellmClient) CreateOrganization(ctx context.Context, req *OrganizationRequest) (OrganizationResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		log.Error(err, "Failed to marshal organization request payload")
+		return OrganizationResponse{}, err
+	}
+
+	response, err := l.makeRequest(ctx, "POST", "/organization/new", body)
+	if err != nil {
+		log.Error(err, "Failed to create organization in Litellm")
+		return OrganizationResponse{}, err
+	}
+
+	var organizationResponse OrganizationResponse
+	if err := json.Unmarshal(response, &organizationResponse); err != nil {
+		log.Error(err, "Failed to unmarshal organization response from Litellm")
+		return OrganizationResponse{}, err
+	}
+
+	return organizationResponse, nil
+}
+
+// UpdateOrganization updates an existing organization in the Litellm service
+func (l *LitellmClient) UpdateOrganization(ctx context.Context, req *OrganizationRequest) (OrganizationResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		log.Error(err, "Failed to marshal organization update request payload")
+		return OrganizationResponse{}, err
+	}
+
+	response, err := l.makeRequest(ctx, "PATCH", "/organization/update", body)
+	if err != nil {
+		log.Error(err, "Failed to update organization in Litellm")
+		return OrganizationResponse{}, err
+	}
+
+	var organizationResponse OrganizationResponse
+	if err := json.Unmarshal(response, &organizationResponse); err != nil {
+		log.Error(err, "Failed to unmarshal organization response from Litellm")
+		return OrganizationResponse{}, err
+	}
+
+	return organizationResponse, nil
+}
+
+// DeleteOrganization deletes an organization from the Litellm service
+func (l *LitellmClient) DeleteOrganization(ctx context.Context, organizationID string) error {
+	log := log.FromContext(ctx)
+
+	body := []byte(`{"organization_ids": ["` + organizationID + `"]}`)
+
+	if _, err := l.makeRequest(ctx, "DELETE", "/organization/delete", body); err != nil {
+		log.Error(err, "Failed to delete organization in Litellm")
+		return err
+	}
+
+	return nil
+}
+
+// GetOrganizationID gets the ID of an organization from the Litellm service, returns empty string if organization alias not found
+func (l *LitellmClient) GetOrganizationID(ctx context.Context, organizationAlias string) (string, error) {
+	log := log.FromContext(ctx)
+
+	body, err := l.makeRequest(ctx, "GET", "/organization/list", nil)
+	if err != nil {
+		log.Error(err, "Failed to list organizations")
+		return "", err
+	}
+
+	var organizations []OrganizationResponse
+	if err := json.Unmarshal(body, &organizations); err != nil {
+		log.Error(err, "Failed to unmarshal response from Litellm")
+		return "", err
+	}
+
+	for _, organization := range organizations {
+		if organization.OrganizationAlias == organizationAlias {
+			return organization.OrganizationID, nil
+		}
+	}
+	return "", nil
+}
+
+// GetOrganization gets an organization from the Litellm service
+func (l *LitellmClient) GetOrganization(ctx context.Context, organizationID string) (OrganizationResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := l.makeRequest(ctx, "GET", "/organization/info?organization_id="+organizationID, nil)
+	if err != nil {
+		log.Error(err, "Failed to get organization with ID: "+organizationID)
+		return OrganizationResponse{}, err
+	}
+
+	var response OrganizationResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		log.Error(err, "Failed to unmarshal organization response from Litellm")
+		return OrganizationResponse{}, err
+	}
+
+	return response, nil
+}
+
+// IsOrganizationUpdateNeeded checks if the organization needs to be updated
+func (l *LitellmClient) IsOrganizationUpdateNeeded(ctx context.Context, organization *OrganizationResponse, req *OrganizationRequest) bool {
+	log := log.FromContext(ctx)
+	budget := organization.GetBudget()
+
+	if organization.OrganizationAlias != req.OrganizationAlias {
+		log.Info("OrganizationAlias changed")
+		return true
+	}
+
+	if !cmp.Equal(organization.Models, req.Models, cmpopts.EquateEmpty()) {
+		log.Info("Models changed")
+		return true
+	}
+
+	if budget.BudgetDuration != req.BudgetDuration {
+		log.Info("BudgetDuration changed")
+		return true
+	}
+
+	if budget.MaxBudget != req.MaxBudget {
+		log.Info("MaxBudget changed")
+		return true
+	}
+
+	if budget.RPMLimit != req.RPMLimit {
+		log.Info("RPMLimit changed")
+		return true
+	}
+
+	if budget.TPMLimit != req.TPMLimit {
+		log.Info("TPMLimit changed")
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Litellm Organization", func() {
+	var (
+		client *LitellmClient
+		server *httptest.Server
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+		}
+	})
+
+	Describe("GetOrganization", func() {
+		It("reads the budget from the linked budget table", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				Expect(r.URL.Path).To(Equal("/organization/info"))
+				Expect(r.URL.Query().Get("organization_id")).To(Equal("org-1"))
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"organization_id": "org-1", "organization_alias": "acme", "budget_id": "b-1",
+					"litellm_budget_table": {"max_budget": 100, "budget_duration": "30d", "rpm_limit": 10}}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			organization, err := client.GetOrganization(ctx, "org-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(organization.OrganizationAlias).To(Equal("acme"))
+			Expect(organization.GetBudget()).To(Equal(BudgetTable{MaxBudget: 100, BudgetDuration: "30d", RPMLimit: 10}))
+		})
+	})
+
+	Describe("GetOrganizationID", func() {
+		It("finds the organization by alias", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/organization/list"))
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`[{"organization_id": "org-1", "organization_alias": "acme"}, {"organization_id": "org-2", "organization_alias": "other"}]`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			Expect(client.GetOrganizationID(ctx, "other")).To(Equal("org-2"))
+			Expect(client.GetOrganizationID(ctx, "missing")).To(BeEmpty())
+		})
+	})
+
+	Describe("DeleteOrganization", func() {
+		It("sends the organization ID in the request body", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("DELETE"))
+				Expect(r.URL.Path).To(Equal("/organization/delete"))
+
+				var body map[string][]string
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				Expect(body["organization_ids"]).To(Equal([]string{"org-1"}))
+
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`[]`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			Expect(client.DeleteOrganization(ctx, "org-1")).To(Succeed())
+		})
+	})
+
+	Describe("IsOrganizationUpdateNeeded", func() {
+		BeforeEach(func() {
+			client = NewLitellmClient("http://localhost", "test-master-key")
+		})
+
+		It("returns false when the organization and its budget match", func() {
+			organization := &OrganizationResponse{
+				OrganizationAlias:  "acme",
+				LiteLLMBudgetTable: &BudgetTable{MaxBudget: 100, TPMLimit: 1000},
+			}
+			req := &OrganizationRequest{OrganizationAlias: "acme", MaxBudget: 100, TPMLimit: 1000}
+			Expect(client.IsOrganizationUpdateNeeded(ctx, organization, req)).To(BeFalse())
+		})
+
+		It("returns true when the budget changed", func() {
+			organization := &OrganizationResponse{
+				OrganizationAlias:  "acme",
+				LiteLLMBudgetTable: &BudgetTable{MaxBudget: 100},
+			}
+			req := &OrganizationRequest{OrganizationAlias: "acme", MaxBudget: 200}
+			Expect(client.IsOrganizationUpdateNeeded(ctx, organization, req)).To(BeTrue())
+		})
+
+		It("returns true when a budget is set on an organization without one", func() {
+			organization := &OrganizationResponse{OrganizationAlias: "acme"}
+			req := &OrganizationRequest{OrganizationAlias: "acme", RPMLimit: 10}
+			Expect(client.IsOrganizationUpdateNeeded(ctx, organization, req)).To(BeTrue())
+		})
+	})
+})
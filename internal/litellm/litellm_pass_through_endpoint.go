@@ -0,0 +1,226 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type LitellmPassThroughEndpoint interface {
+	CreatePassThroughEndpoint(ctx context.Context, req *PassThroughEndpointRequest) (PassThroughEndpointResponse, error)
+	DeletePassThroughEndpoint(ctx context.Context, endpointID string) error
+	GetPassThroughEndpoint(ctx context.Context, endpointID string) (PassThroughEndpointResponse, error)
+	GetPassThroughEndpointID(ctx context.Context, path string) (string, error)
+	IsPassThroughEndpointUpdateNeeded(ctx context.Context, endpoint *PassThroughEndpointResponse, req *PassThroughEndpointRequest) (PassThroughEndpointUpdateNeeded, error)
+	UpdatePassThroughEndpoint(ctx context.Context, endpointID string, req *PassThroughEndpointRequest) (PassThroughEndpointResponse, error)
+}
+
+// PassThroughEndpointRequest describes a pass-through endpoint to create or update.
+// SecretHeaders are sent along with Headers but never compared, as LiteLLM may mask them
+type PassThroughEndpointRequest struct {
+	Path           string            `json:"path"`
+	Target         string            `json:"target"`
+	Headers        map[string]string `json:"headers"`
+	IncludeSubpath bool              `json:"include_subpath"`
+	Auth           bool              `json:"auth"`
+	ForwardHeaders bool              `json:"forward_headers"`
+	SecretHeaders  map[string]string `json:"-"`
+}
+
+// PassThroughEndpointResponse represents a pass-through endpoint returned by LiteLLM
+type PassThroughEndpointResponse struct {
+	ID             string            `json:"id,omitempty"`
+	Path           string            `json:"path"`
+	Target         string            `json:"target"`
+	Headers        map[string]string `json:"headers,omitempty"`
+	IncludeSubpath bool              `json:"include_subpath"`
+	Auth           bool              `json:"auth"`
+	ForwardHeaders bool              `json:"forward_headers"`
+}
+
+type PassThroughEndpointUpdateNeeded struct {
+	NeedsUpdate   bool
+	ChangedFields []FieldChange
+}
+
+type passThroughEndpointListResponse struct {
+	Endpoints []PassThroughEndpointResponse `json:"endpoints"`
+}
+
+func (req *PassThroughEndpointRequest) marshal() ([]byte, error) {
+	payload := *req
+	payload.Headers = make(map[string]string, len(req.Headers)+len(req.SecretHeaders))
+	for name, value := range req.Headers {
+		payload.Headers[name] = value
+	}
+	for name, value := range req.SecretHeaders {
+		payload.Headers[name] = value
+	}
+	return json.Marshal(payload)
+}
+
+// CreatePassThroughEndpoint creates a new pass-through endpoint in the Litellm service
+func (l *LitellmClient) CreatePassThroughEndpoint(ctx context.Context, req *PassThroughEndpointRequest) (PassThroughEndpointResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := req.marshal()
+	if err != nil {
+		log.Error(err, "Failed to marshal pass-through endpoint request payload")
+		return PassThroughEndpointResponse{}, err
+	}
+
+	if _, err := l.makeRequest(ctx, "POST", "/config/pass_through_endpoint", body); err != nil {
+		log.Error(err, "Failed to create pass-through endpoint in Litellm")
+		return PassThroughEndpointResponse{}, err
+	}
+
+	// LiteLLM assigns the ID, so read the endpoint back by its path
+	return l.getPassThroughEndpoint(ctx, func(endpoint *PassThroughEndpointResponse) bool {
+		return endpoint.Path == req.Path
+	}, req.Path)
+}
+
+// UpdatePassThroughEndpoint replaces an existing pass-through endpoint in the Litellm service
+func (l *LitellmClient) UpdatePassThroughEndpoint(ctx context.Context, endpointID string, req *PassThroughEndpointRequest) (PassThroughEndpointResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := req.marshal()
+	if err != nil {
+		log.Error(err, "Failed to marshal pass-through endpoint update request payload")
+		return PassThroughEndpointResponse{}, err
+	}
+
+	if _, err := l.makeRequest(ctx, "POST", "/config/pass_through_endpoint/"+url.PathEscape(endpointID), body); err != nil {
+		log.Error(err, "Failed to update pass-through endpoint in Litellm")
+		return PassThroughEndpointResponse{}, err
+	}
+
+	return l.GetPassThroughEndpoint(ctx, endpointID)
+}
+
+// DeletePassThroughEndpoint deletes a pass-through endpoint from the Litellm service
+func (l *LitellmClient) DeletePassThroughEndpoint(ctx context.Context, endpointID string) error {
+	log := log.FromContext(ctx)
+
+	if _, err := l.makeRequest(ctx, "DELETE", "/config/pass_through_endpoint?endpoint_id="+url.QueryEscape(endpointID), nil); err != nil {
+		log.Error(err, "Failed to delete pass-through endpoint in Litellm")
+		return err
+	}
+
+	return nil
+}
+
+// GetPassThroughEndpoint gets a pass-through endpoint from the Litellm service
+func (l *LitellmClient) GetPassThroughEndpoint(ctx context.Context, endpointID string) (PassThroughEndpointResponse, error) {
+	return l.getPassThroughEndpoint(ctx, func(endpoint *PassThroughEndpointResponse) bool {
+		return endpoint.ID == endpointID
+	}, endpointID)
+}
+
+// GetPassThroughEndpointID gets the ID of the pass-through endpoint serving a path, returns empty string if the path is not found.
+// Endpoints defined in the proxy config have no ID and are never returned
+func (l *LitellmClient) GetPassThroughEndpointID(ctx context.Context, path string) (string, error) {
+	endpoints, err := l.listPassThroughEndpoints(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint.Path == path && endpoint.ID != "" {
+			return endpoint.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// getPassThroughEndpoint returns the first pass-through endpoint matching the filter, or ErrNotFound
+func (l *LitellmClient) getPassThroughEndpoint(ctx context.Context, match func(*PassThroughEndpointResponse) bool, description string) (PassThroughEndpointResponse, error) {
+	endpoints, err := l.listPassThroughEndpoints(ctx)
+	if err != nil {
+		return PassThroughEndpointResponse{}, err
+	}
+
+	for i := range endpoints {
+		if match(&endpoints[i]) {
+			return endpoints[i], nil
+		}
+	}
+	return PassThroughEndpointResponse{}, fmt.Errorf("%w: pass-through endpoint %s", ErrNotFound, description)
+}
+
+// listPassThroughEndpoints lists the pass-through endpoints in the Litellm service
+func (l *LitellmClient) listPassThroughEndpoints(ctx context.Context) ([]PassThroughEndpointResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := l.makeRequest(ctx, "GET", "/config/pass_through_endpoint", nil)
+	if err != nil {
+		log.Error(err, "Failed to list pass-through endpoints")
+		return nil, err
+	}
+
+	var response passThroughEndpointListResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		log.Error(err, "Failed to unmarshal response from Litellm")
+		return nil, err
+	}
+
+	return response.Endpoints, nil
+}
+
+// IsPassThroughEndpointUpdateNeeded checks if the pass-through endpoint needs to be updated.
+// Secret headers may be masked by LiteLLM, so changes to them must be detected by the caller
+func (l *LitellmClient) IsPassThroughEndpointUpdateNeeded(ctx context.Context, endpoint *PassThroughEndpointResponse, req *PassThroughEndpointRequest) (PassThroughEndpointUpdateNeeded, error) {
+	log := log.FromContext(ctx)
+	var changedFields PassThroughEndpointUpdateNeeded
+	// Helper function to check field changes
+	checkField := func(fieldName, logName string, current, expected interface{}, equateEmpty bool, needsUpdate bool) {
+		var changed bool
+		if equateEmpty {
+			changed = !cmp.Equal(current, expected, cmpopts.EquateEmpty())
+		} else {
+			changed = !reflect.DeepEqual(current, expected)
+		}
+
+		if changed {
+			log.Info(fmt.Sprintf("%s changed", logName))
+			if needsUpdate {
+				changedFields.NeedsUpdate = true
+			}
+			changedFields.ChangedFields = append(changedFields.ChangedFields, FieldChange{
+				FieldName:     fieldName,
+				CurrentValue:  current,
+				ExpectedValue: expected,
+			})
+		}
+	}
+
+	checkField("path", "Path", endpoint.Path, req.Path, false, true)
+	checkField("target", "Target", endpoint.Target, req.Target, false, true)
+	checkField("include_subpath", "Include subpath", endpoint.IncludeSubpath, req.IncludeSubpath, false, true)
+	checkField("auth", "Auth", endpoint.Auth, req.Auth, false, true)
+	checkField("forward_headers", "Forward headers", endpoint.ForwardHeaders, req.ForwardHeaders, false, true)
+
+	// Only the non-secret headers are compared
+	observedHeaders := make(map[string]string, len(req.Headers))
+	for name := range req.Headers {
+		if value, ok := endpoint.Headers[name]; ok {
+			observedHeaders[name] = value
+		}
+	}
+	checkField("headers", "Headers", observedHeaders, req.Headers, true, true)
+
+	if changedFields.NeedsUpdate {
+		log.Info("Pass-through endpoint update needed")
+		for _, field := range changedFields.ChangedFields {
+			log.Info(fmt.Sprintf("Field changed: %s", field.FieldName), "current", field.CurrentValue, "expected", field.ExpectedValue)
+		}
+	}
+
+	return changedFields, nil
+}
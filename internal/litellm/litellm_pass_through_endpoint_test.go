@@ -0,0 +1,119 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Litellm Pass-Through Endpoint", func() {
+	var (
+		client *LitellmClient
+		server *httptest.Server
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+		}
+	})
+
+	Describe("CreatePassThroughEndpoint", func() {
+		It("sends the secret headers and reads back the assigned ID", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/config/pass_through_endpoint"))
+				switch r.Method {
+				case "POST":
+					var body map[string]interface{}
+					Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+					Expect(body["headers"]).To(Equal(map[string]interface{}{"content-type": "application/json", "Authorization": "bearer sk-test"}))
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write([]byte(`{}`))
+				case "GET":
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write([]byte(`{"endpoints": [{"id": "pte-1", "path": "/v1/rerank", "target": "https://api.cohere.com/v1/rerank"}]}`))
+				}
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			created, err := client.CreatePassThroughEndpoint(ctx, &PassThroughEndpointRequest{
+				Path:          "/v1/rerank",
+				Target:        "https://api.cohere.com/v1/rerank",
+				Headers:       map[string]string{"content-type": "application/json"},
+				SecretHeaders: map[string]string{"Authorization": "bearer sk-test"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.ID).To(Equal("pte-1"))
+		})
+	})
+
+	Describe("GetPassThroughEndpoint", func() {
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"endpoints": [{"path": "/config-route", "target": "https://example.com"}, {"id": "pte-1", "path": "/v1/rerank", "target": "https://api.cohere.com/v1/rerank"}]}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+		})
+
+		It("returns ErrNotFound for an unknown ID", func() {
+			_, err := client.GetPassThroughEndpoint(ctx, "missing")
+			Expect(err).To(MatchError(ErrNotFound))
+		})
+
+		It("finds the ID by path, ignoring endpoints defined in the proxy config", func() {
+			Expect(client.GetPassThroughEndpointID(ctx, "/v1/rerank")).To(Equal("pte-1"))
+			Expect(client.GetPassThroughEndpointID(ctx, "/config-route")).To(BeEmpty())
+		})
+	})
+
+	Describe("IsPassThroughEndpointUpdateNeeded", func() {
+		BeforeEach(func() {
+			client = NewLitellmClient("http://localhost", "test-master-key")
+		})
+
+		It("ignores the secret headers", func() {
+			observed := &PassThroughEndpointResponse{Path: "/v1/rerank", Target: "https://api.cohere.com/v1/rerank", Headers: map[string]string{"Authorization": "****"}}
+			req := &PassThroughEndpointRequest{Path: "/v1/rerank", Target: "https://api.cohere.com/v1/rerank", SecretHeaders: map[string]string{"Authorization": "bearer sk-test"}}
+			updateNeeded, err := client.IsPassThroughEndpointUpdateNeeded(ctx, observed, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updateNeeded.NeedsUpdate).To(BeFalse())
+		})
+
+		It("reports the changed fields", func() {
+			observed := &PassThroughEndpointResponse{Path: "/v1/rerank", Target: "https://api.cohere.com/v1/rerank"}
+			req := &PassThroughEndpointRequest{Path: "/v1/rerank", Target: "https://api.cohere.com/v2/rerank", Auth: true}
+			updateNeeded, err := client.IsPassThroughEndpointUpdateNeeded(ctx, observed, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updateNeeded.NeedsUpdate).To(BeTrue())
+			Expect(updateNeeded.ChangedFields).To(HaveLen(2))
+			Expect(updateNeeded.ChangedFields[0].FieldName).To(Equal("target"))
+			Expect(updateNeeded.ChangedFields[1].FieldName).To(Equal("auth"))
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -18,6 +19,10 @@ type LitellmTeam interface {
 	IsTeamUpdateNeeded(ctx context.Context, team *TeamResponse, req *TeamRequest) bool
 	UpdateTeam(ctx context.Context, req *TeamRequest) (TeamResponse, error)
 	SetTeamBlockedState(ctx context.Context, teamID string, blocked bool) error
+	GetTeamCallbacks(ctx context.Context, teamID string) (TeamCallbacksResponse, error)
+	AddTeamCallback(ctx context.Context, teamID string, req *TeamCallbackRequest) error
+	DisableTeamLogging(ctx context.Context, teamID string) error
+	GuardrailLister
 }
 
 type TeamMemberWithRole struct {
@@ -26,6 +31,20 @@ type TeamMemberWithRole struct {
 	Role      string `json:"role,omitempty"`
 }
 
+// TeamMembership is the membership of a user in a team, with the user's budget in the team
+type TeamMembership struct {
+	UserID             string            `json:"user_id,omitempty"`
+	TeamID             string            `json:"team_id,omitempty"`
+	BudgetID           string            `json:"budget_id,omitempty"`
+	Spend              float64           `json:"spend,omitempty"`
+	LiteLLMBudgetTable *TeamMemberBudget `json:"litellm_budget_table,omitempty"`
+}
+
+// TeamMemberBudget is the budget of a user in a team
+type TeamMemberBudget struct {
+	MaxBudget float64 `json:"max_budget,omitempty"`
+}
+
 type TeamRequest struct {
 	Admins                []string          `json:"admins,omitempty"`
 	Blocked               bool              `json:"blocked,omitempty"`
@@ -36,6 +55,7 @@ type TeamRequest struct {
 	Metadata              map[string]string `json:"metadata,omitempty"`
 	ModelAliases          map[string]string `json:"model_aliases,omitempty"`
 	Models                []string          `json:"models,omitempty"`
+	ObjectPermission      *ObjectPermission `json:"object_permission,omitempty"`
 	OrganizationID        string            `json:"organization_id,omitempty"`
 	RPMLimit              int               `json:"rpm_limit,omitempty"`
 	Tags                  []string          `json:"tags,omitempty"`
@@ -58,6 +78,7 @@ type TeamResponse struct {
 	MembersWithRole       []TeamMemberWithRole `json:"members_with_roles,omitempty"`
 	ModelID               string               `json:"model_id,omitempty"`
 	Models                []string             `json:"models,omitempty"`
+	ObjectPermission      *ObjectPermission    `json:"object_permission,omitempty"`
 	OrganizationID        string               `json:"organization_id,omitempty"`
 	RPMLimit              int                  `json:"rpm_limit,omitempty"`
 	Spend                 float64              `json:"spend,omitempty"`
@@ -65,10 +86,26 @@ type TeamResponse struct {
 	TeamAlias             string               `json:"team_alias,omitempty"`
 	TeamID                string               `json:"team_id,omitempty"`
 	TeamMemberPermissions []string             `json:"team_member_permissions,omitempty"`
+	TeamMemberships       []TeamMembership     `json:"team_memberships,omitempty"`
 	TPMLimit              int                  `json:"tpm_limit,omitempty"`
 	UpdatedAt             string               `json:"updated_at,omitempty"`
 }
 
+// TeamCallbackRequest adds a logging callback to a team. CallbackType is one of success, failure or success_and_failure
+type TeamCallbackRequest struct {
+	CallbackName string            `json:"callback_name"`
+	CallbackType string            `json:"callback_type"`
+	CallbackVars map[string]string `json:"callback_vars"`
+}
+
+// TeamCallbacksResponse represents the logging callbacks of a team
+type TeamCallbacksResponse struct {
+	TeamID           string            `json:"team_id,omitempty"`
+	SuccessCallbacks []string          `json:"success_callbacks,omitempty"`
+	FailureCallbacks []string          `json:"failure_callbacks,omitempty"`
+	CallbackVars     map[string]string `json:"callback_vars,omitempty"`
+}
+
 // CreateTeam creates a new team in the Litellm service
 func (l *LitellmClient) CreateTeam(ctx context.Context, req *TeamRequest) (TeamResponse, error) {
 	log := log.FromContext(ctx)
@@ -177,7 +214,8 @@ func (l *LitellmClient) GetTeam(ctx context.Context, teamID string) (TeamRespons
 	}
 
 	var response struct {
-		Team TeamResponse `json:"team_info"`
+		Team            TeamResponse     `json:"team_info"`
+		TeamMemberships []TeamMembership `json:"team_memberships"`
 	}
 
 	if err := json.Unmarshal(body, &response); err != nil {
@@ -185,6 +223,8 @@ func (l *LitellmClient) GetTeam(ctx context.Context, teamID string) (TeamRespons
 		return TeamResponse{}, err
 	}
 
+	// The memberships are returned next to the team info
+	response.Team.TeamMemberships = response.TeamMemberships
 	return response.Team, nil
 }
 
@@ -222,6 +262,57 @@ func (l *LitellmClient) SetTeamBlockedState(ctx context.Context, teamID string,
 	return nil
 }
 
+// GetTeamCallbacks gets the logging callbacks of a team from the Litellm service
+func (l *LitellmClient) GetTeamCallbacks(ctx context.Context, teamID string) (TeamCallbacksResponse, error) {
+	log := log.FromContext(ctx)
+
+	body, err := l.makeRequest(ctx, "GET", "/team/"+url.PathEscape(teamID)+"/callback", nil)
+	if err != nil {
+		log.Error(err, "Failed to get callbacks of team with ID: "+teamID)
+		return TeamCallbacksResponse{}, err
+	}
+
+	var response struct {
+		Data TeamCallbacksResponse `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		log.Error(err, "Failed to unmarshal team callbacks response from Litellm")
+		return TeamCallbacksResponse{}, err
+	}
+
+	return response.Data, nil
+}
+
+// AddTeamCallback adds a logging callback to a team in the Litellm service
+func (l *LitellmClient) AddTeamCallback(ctx context.Context, teamID string, req *TeamCallbackRequest) error {
+	log := log.FromContext(ctx)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		log.Error(err, "Failed to marshal team callback request payload")
+		return err
+	}
+
+	if _, err := l.makeRequest(ctx, "POST", "/team/"+url.PathEscape(teamID)+"/callback", body); err != nil {
+		log.Error(err, "Failed to add callback to team in Litellm", "callback", req.CallbackName)
+		return err
+	}
+
+	return nil
+}
+
+// DisableTeamLogging removes all logging callbacks of a team in the Litellm service
+func (l *LitellmClient) DisableTeamLogging(ctx context.Context, teamID string) error {
+	log := log.FromContext(ctx)
+
+	if _, err := l.makeRequest(ctx, "POST", "/team/"+url.PathEscape(teamID)+"/disable_logging", nil); err != nil {
+		log.Error(err, "Failed to disable logging of team in Litellm")
+		return err
+	}
+
+	return nil
+}
+
 // IsTeamUpdateNeeded checks if a team needs to be updated in the Litellm service
 func (l *LitellmClient) IsTeamUpdateNeeded(ctx context.Context, team *TeamResponse, req *TeamRequest) bool {
 	log := log.FromContext(ctx)
@@ -251,6 +342,11 @@ func (l *LitellmClient) IsTeamUpdateNeeded(ctx context.Context, team *TeamRespon
 		return true
 	}
 
+	if isObjectPermissionUpdateNeeded(team.ObjectPermission, req.ObjectPermission) {
+		log.Info("ObjectPermission changed")
+		return true
+	}
+
 	if !cmp.Equal(team.OrganizationID, req.OrganizationID, cmpopts.EquateEmpty()) {
 		log.Info("OrganizationID changed")
 		return true
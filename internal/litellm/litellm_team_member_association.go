@@ -9,6 +9,7 @@ import (
 
 type LitellmTeamMemberAssociation interface {
 	CreateTeamMemberAssociation(ctx context.Context, req *TeamMemberAssociationRequest) (TeamMemberAssociationResponse, error)
+	UpdateTeamMemberAssociation(ctx context.Context, req *TeamMemberAssociationRequest) error
 	DeleteTeamMemberAssociation(ctx context.Context, teamAlias string, userEmail string) error
 	GetTeam(ctx context.Context, teamID string) (TeamResponse, error)
 	GetTeamID(ctx context.Context, teamAlias string) (string, error)
@@ -86,6 +87,50 @@ func (l *LitellmClient) CreateTeamMemberAssociation(ctx context.Context, req *Te
 	return createTeamMemberAssociationResponse, nil
 }
 
+// UpdateTeamMemberAssociation updates the role and budget of a User in a Team in the Litellm service
+func (l *LitellmClient) UpdateTeamMemberAssociation(ctx context.Context, req *TeamMemberAssociationRequest) error {
+	log := log.FromContext(ctx)
+
+	teamID, err := l.GetTeamID(ctx, req.TeamAlias)
+	if err != nil {
+		log.Error(err, "Failed to get team ID")
+		return err
+	}
+
+	userID, err := l.GetUserID(ctx, req.UserEmail)
+	if err != nil {
+		log.Error(err, "Failed to get user ID")
+		return err
+	}
+
+	type updateRequest struct {
+		MaxBudgetInTeam float64 `json:"max_budget_in_team,omitempty"`
+		Role            string  `json:"role,omitempty"`
+		TeamID          string  `json:"team_id"`
+		UserEmail       string  `json:"user_email,omitempty"`
+		UserID          string  `json:"user_id"`
+	}
+
+	body, err := json.Marshal(updateRequest{
+		MaxBudgetInTeam: req.MaxBudgetInTeam,
+		Role:            req.Role,
+		TeamID:          teamID,
+		UserEmail:       req.UserEmail,
+		UserID:          userID,
+	})
+	if err != nil {
+		log.Error(err, "Failed to marshal team member association update request payload")
+		return err
+	}
+
+	if _, err := l.makeRequest(ctx, "POST", "/team/member_update", body); err != nil {
+		log.Error(err, "Failed to update team member association in Litellm")
+		return err
+	}
+
+	return nil
+}
+
 // DeleteTeamMemberAssociation removes a User from a Team in the Litellm service
 func (l *LitellmClient) DeleteTeamMemberAssociation(ctx context.Context, teamAlias string, userEmail string) error {
 	log := log.FromContext(ctx)
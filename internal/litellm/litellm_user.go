@@ -19,6 +19,7 @@ type LitellmUser interface {
 	GetTeam(ctx context.Context, teamID string) (TeamResponse, error)
 	IsUserUpdateNeeded(ctx context.Context, user *UserResponse, req *UserRequest) (UserUpdateNeeded, error)
 	UpdateUser(ctx context.Context, req *UserRequest) (UserResponse, error)
+	GuardrailLister
 }
 
 type UserRequest struct {
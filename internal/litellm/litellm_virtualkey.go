@@ -3,7 +3,9 @@ package litellm
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -12,12 +14,15 @@ import (
 
 type LitellmVirtualKey interface {
 	DeleteVirtualKey(ctx context.Context, keyAlias string) error
+	DeleteVirtualKeyByID(ctx context.Context, keyID string) error
 	GenerateVirtualKey(ctx context.Context, req *VirtualKeyRequest) (VirtualKeyResponse, error)
 	GetVirtualKeyFromAlias(ctx context.Context, keyAlias string) ([]string, error)
 	GetVirtualKeyInfo(ctx context.Context, keyID string) (VirtualKeyResponse, error)
 	IsVirtualKeyUpdateNeeded(ctx context.Context, virtualKey *VirtualKeyResponse, req *VirtualKeyRequest) bool
+	RegenerateVirtualKey(ctx context.Context, keyID string, gracePeriod string) (VirtualKeyResponse, error)
 	UpdateVirtualKey(ctx context.Context, req *VirtualKeyRequest) (VirtualKeyResponse, error)
 	SetVirtualKeyBlockedState(ctx context.Context, key string, blocked bool) error
+	GuardrailLister
 }
 
 type VirtualKeyRequest struct {
@@ -40,6 +45,7 @@ type VirtualKeyRequest struct {
 	ModelRPMLimit        map[string]int    `json:"model_rpm_limit,omitempty"`
 	ModelTPMLimit        map[string]int    `json:"model_tpm_limit,omitempty"`
 	Models               []string          `json:"models,omitempty"`
+	ObjectPermission     *ObjectPermission `json:"object_permission,omitempty"`
 	Permissions          map[string]string `json:"permissions,omitempty"`
 	RPMLimit             int               `json:"rpm_limit,omitempty"`
 	SendInviteEmail      bool              `json:"send_invite_email,omitempty"`
@@ -76,18 +82,19 @@ type VirtualKeyResponse struct {
 	// ModelMaxBudget       map[string]string `json:"model_max_budget,omitempty"`
 	// ModelRPMLimit        map[string]int    `json:"model_rpm_limit,omitempty"`
 	// ModelTPMLimit        map[string]int    `json:"model_tpm_limit,omitempty"`
-	Models      []string          `json:"models,omitempty"`
-	Permissions map[string]string `json:"permissions,omitempty"`
-	RPMLimit    int               `json:"rpm_limit,omitempty"`
-	Spend       float64           `json:"spend,omitempty"`
-	Tags        []string          `json:"tags,omitempty"`
-	TeamID      string            `json:"team_id,omitempty"`
-	Token       string            `json:"token,omitempty"`
-	TokenID     string            `json:"token_id,omitempty"`
-	TPMLimit    int               `json:"tpm_limit,omitempty"`
-	UpdatedAt   string            `json:"updated_at,omitempty"`
-	UpdatedBy   string            `json:"updated_by,omitempty"`
-	UserID      string            `json:"user_id,omitempty"`
+	Models           []string          `json:"models,omitempty"`
+	ObjectPermission *ObjectPermission `json:"object_permission,omitempty"`
+	Permissions      map[string]string `json:"permissions,omitempty"`
+	RPMLimit         int               `json:"rpm_limit,omitempty"`
+	Spend            float64           `json:"spend,omitempty"`
+	Tags             []string          `json:"tags,omitempty"`
+	TeamID           string            `json:"team_id,omitempty"`
+	Token            string            `json:"token,omitempty"`
+	TokenID          string            `json:"token_id,omitempty"`
+	TPMLimit         int               `json:"tpm_limit,omitempty"`
+	UpdatedAt        string            `json:"updated_at,omitempty"`
+	UpdatedBy        string            `json:"updated_by,omitempty"`
+	UserID           string            `json:"user_id,omitempty"`
 }
 
 // GenerateVirtualKey generates a new virtual key for the Litellm service
@@ -153,6 +160,63 @@ func (l *LitellmClient) DeleteVirtualKey(ctx context.Context, keyAlias string) e
 	return nil
 }
 
+// DeleteVirtualKeyByID deletes a single virtual key, by key/token ID, from the Litellm service.
+// Keys that no longer exist are treated as deleted.
+func (l *LitellmClient) DeleteVirtualKeyByID(ctx context.Context, keyID string) error {
+	log := log.FromContext(ctx)
+
+	body, err := json.Marshal(map[string][]string{"keys": {keyID}})
+	if err != nil {
+		log.Error(err, "Failed to marshal delete virtual key request payload")
+		return err
+	}
+
+	if _, err := l.makeRequest(ctx, "POST", "/key/delete", body); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		log.Error(err, "Failed to delete virtual key in Litellm")
+		return err
+	}
+
+	return nil
+}
+
+// RegenerateVirtualKey replaces the key material of a virtual key, by key/token ID, keeping its settings.
+// The previous key remains valid for the grace period, e.g. 24h, when one is given.
+func (l *LitellmClient) RegenerateVirtualKey(ctx context.Context, keyID string, gracePeriod string) (VirtualKeyResponse, error) {
+	log := log.FromContext(ctx)
+
+	req := map[string]string{"key": keyID}
+	if gracePeriod != "" {
+		req["grace_period"] = gracePeriod
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		log.Error(err, "Failed to marshal regenerate virtual key request payload")
+		return VirtualKeyResponse{}, err
+	}
+
+	response, err := l.makeRequest(ctx, "POST", "/key/"+url.PathEscape(keyID)+"/regenerate", body)
+	if err != nil {
+		log.Error(err, "Failed to regenerate virtual key in Litellm")
+		return VirtualKeyResponse{}, err
+	}
+
+	var virtualKeyResponse VirtualKeyResponse
+	if err := json.Unmarshal(response, &virtualKeyResponse); err != nil {
+		log.Error(err, "Failed to unmarshal virtual key response from Litellm")
+		return VirtualKeyResponse{}, err
+	}
+
+	// The regenerated key reports its new key/token ID in token_id
+	if virtualKeyResponse.TokenID != "" && (virtualKeyResponse.Token == "" || virtualKeyResponse.Token == virtualKeyResponse.Key) {
+		virtualKeyResponse.Token = virtualKeyResponse.TokenID
+	}
+
+	return virtualKeyResponse, nil
+}
+
 // GetVirtualKeyFromAlias
 func (l *LitellmClient) GetVirtualKeyFromAlias(ctx context.Context, keyAlias string) ([]string, error) {
 	log := log.FromContext(ctx)
@@ -301,6 +365,10 @@ func (l *LitellmClient) IsVirtualKeyUpdateNeeded(ctx context.Context, virtualKey
 		log.Info("Models changed")
 		return true
 	}
+	if isObjectPermissionUpdateNeeded(virtualKey.ObjectPermission, req.ObjectPermission) {
+		log.Info("ObjectPermission changed")
+		return true
+	}
 	if !cmp.Equal(virtualKey.Permissions, req.Permissions, cmpopts.EquateEmpty()) {
 		log.Info("Permissions changed")
 		return true
@@ -0,0 +1,92 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Litellm Virtual Key", func() {
+	var (
+		client *LitellmClient
+		server *httptest.Server
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+		}
+	})
+
+	Describe("RegenerateVirtualKey", func() {
+		It("regenerates the key with the grace period and reads back the new key ID", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				Expect(r.URL.Path).To(Equal("/key/token-1/regenerate"))
+				var body map[string]string
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				Expect(body).To(Equal(map[string]string{"key": "token-1", "grace_period": "86400s"}))
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"key": "sk-new", "token": "sk-new", "token_id": "token-2", "key_alias": "my-key"}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			regenerated, err := client.RegenerateVirtualKey(ctx, "token-1", "86400s")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(regenerated.Key).To(Equal("sk-new"))
+			Expect(regenerated.Token).To(Equal("token-2"))
+			Expect(regenerated.KeyAlias).To(Equal("my-key"))
+		})
+	})
+
+	Describe("DeleteVirtualKeyByID", func() {
+		It("deletes the key by its ID", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/key/delete"))
+				var body map[string][]string
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				Expect(body).To(Equal(map[string][]string{"keys": {"token-1"}}))
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"deleted_keys": ["token-1"]}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			Expect(client.DeleteVirtualKeyByID(ctx, "token-1")).To(Succeed())
+		})
+
+		It("treats a key that no longer exists as deleted", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"detail": "key not found"}`))
+			}))
+			client = NewLitellmClient(server.URL, "test-master-key")
+
+			Expect(client.DeleteVirtualKeyByID(ctx, "token-1")).To(Succeed())
+		})
+	})
+})
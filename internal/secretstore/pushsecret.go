@@ -0,0 +1,156 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+)
+
+// PushSecretGVK is the External Secrets Operator PushSecret
+var PushSecretGVK = schema.GroupVersionKind{Group: "external-secrets.io", Version: "v1alpha1", Kind: "PushSecret"}
+
+// PushSecretSink emits External Secrets Operator PushSecrets that push key Secrets to a SecretStore. The
+// PushSecret is named after, and owned by, the resource the key was generated for, e.g. example-virtualkey.
+type PushSecretSink struct {
+	client client.Client
+	scheme *runtime.Scheme
+	store  *authv1alpha1.PushSecretStore
+}
+
+// NewPushSecretSink returns a sink emitting PushSecrets to the store
+func NewPushSecretSink(k8sClient client.Client, scheme *runtime.Scheme, store *authv1alpha1.PushSecretStore) *PushSecretSink {
+	return &PushSecretSink{client: k8sClient, scheme: scheme, store: store}
+}
+
+// Write creates or updates the PushSecret pushing every key of the key Secret to the path of the entry
+func (s *PushSecretSink) Write(ctx context.Context, entry KeySecret) error {
+	pushSecret, err := s.newPushSecret(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, s.client, pushSecret, func() error {
+		if err := controllerutil.SetControllerReference(entry.Owner, pushSecret, s.scheme); err != nil {
+			return err
+		}
+		pushSecret.Object["spec"] = s.pushSecretSpec(entry)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to write PushSecret %s: %w", pushSecret.GetName(), err)
+	}
+	return nil
+}
+
+// Delete removes the PushSecret, which removes the pushed secret from the store. A PushSecret that has since been
+// written to another path is kept, as the External Secrets Operator removes the secret at the previous path itself.
+func (s *PushSecretSink) Delete(ctx context.Context, entry KeySecret) error {
+	pushSecret, err := s.newPushSecret(entry)
+	if err != nil {
+		return err
+	}
+	if err := s.client.Get(ctx, client.ObjectKeyFromObject(pushSecret), pushSecret); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get PushSecret %s: %w", pushSecret.GetName(), err)
+	}
+	if remoteKey := pushSecretRemoteKey(pushSecret); remoteKey != "" && remoteKey != entry.Path {
+		return nil
+	}
+	if err := s.client.Delete(ctx, pushSecret); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete PushSecret %s: %w", pushSecret.GetName(), err)
+	}
+	return nil
+}
+
+// pushSecretSpec builds the spec of the PushSecret of the entry
+func (s *PushSecretSink) pushSecretSpec(entry KeySecret) map[string]interface{} {
+	storeKind := s.store.StoreKind
+	if storeKind == "" {
+		storeKind = "SecretStore"
+	}
+	refreshInterval := "1h"
+	if s.store.RefreshInterval != nil {
+		refreshInterval = s.store.RefreshInterval.Duration.String()
+	}
+
+	var data []interface{}
+	for _, key := range slices.Sorted(maps.Keys(entry.Data)) {
+		data = append(data, map[string]interface{}{
+			"match": map[string]interface{}{
+				"secretKey": key,
+				"remoteRef": map[string]interface{}{
+					"remoteKey": entry.Path,
+					"property":  key,
+				},
+			},
+		})
+	}
+
+	return map[string]interface{}{
+		"refreshInterval": refreshInterval,
+		"deletionPolicy":  "Delete",
+		"secretStoreRefs": []interface{}{
+			map[string]interface{}{"name": s.store.StoreName, "kind": storeKind},
+		},
+		"selector": map[string]interface{}{
+			"secret": map[string]interface{}{"name": entry.SecretName},
+		},
+		"data": data,
+	}
+}
+
+// pushSecretRemoteKey returns the path the PushSecret pushes to
+func pushSecretRemoteKey(pushSecret *unstructured.Unstructured) string {
+	data, _, _ := unstructured.NestedSlice(pushSecret.Object, "spec", "data")
+	for _, item := range data {
+		if match, ok := item.(map[string]interface{}); ok {
+			if remoteKey, _, _ := unstructured.NestedString(match, "match", "remoteRef", "remoteKey"); remoteKey != "" {
+				return remoteKey
+			}
+		}
+	}
+	return ""
+}
+
+// newPushSecret returns the PushSecret of the entry, identified by its name and namespace
+func (s *PushSecretSink) newPushSecret(entry KeySecret) (*unstructured.Unstructured, error) {
+	ownerGVK, err := apiutil.GVKForObject(entry.Owner, s.scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	pushSecret := &unstructured.Unstructured{}
+	pushSecret.SetGroupVersionKind(PushSecretGVK)
+	pushSecret.SetName(entry.Owner.GetName() + "-" + strings.ToLower(ownerGVK.Kind))
+	pushSecret.SetNamespace(entry.Owner.GetNamespace())
+	return pushSecret, nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+)
+
+var _ = Describe("PushSecretSink", func() {
+	var (
+		ctx       context.Context
+		k8sClient client.Client
+		sink      *PushSecretSink
+		entry     KeySecret
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(authv1alpha1.AddToScheme(scheme)).To(Succeed())
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).Build()
+		sink = NewPushSecretSink(k8sClient, scheme, &authv1alpha1.PushSecretStore{StoreName: "vault-backend"})
+
+		entry = KeySecret{
+			Owner: &authv1alpha1.VirtualKey{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-key", Namespace: "default", UID: "uid-1"},
+			},
+			SecretName: "my-key-secret",
+			Path:       "default/my-key",
+			Data:       map[string][]byte{"key": []byte("sk-test"), "previousKey": []byte("sk-old")},
+		}
+	})
+
+	getPushSecret := func() (*unstructured.Unstructured, error) {
+		pushSecret := &unstructured.Unstructured{}
+		pushSecret.SetGroupVersionKind(PushSecretGVK)
+		err := k8sClient.Get(ctx, client.ObjectKey{Name: "my-key-virtualkey", Namespace: "default"}, pushSecret)
+		return pushSecret, err
+	}
+
+	It("emits a PushSecret owned by the resource pushing every key of the Secret", func() {
+		Expect(sink.Write(ctx, entry)).To(Succeed())
+
+		pushSecret, err := getPushSecret()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pushSecret.GetOwnerReferences()).To(HaveLen(1))
+		Expect(pushSecret.GetOwnerReferences()[0].Name).To(Equal("my-key"))
+
+		spec := pushSecret.Object["spec"].(map[string]interface{})
+		Expect(spec["refreshInterval"]).To(Equal("1h"))
+		Expect(spec["deletionPolicy"]).To(Equal("Delete"))
+		Expect(spec["secretStoreRefs"]).To(ConsistOf(map[string]interface{}{"name": "vault-backend", "kind": "SecretStore"}))
+		secretName, _, _ := unstructured.NestedString(pushSecret.Object, "spec", "selector", "secret", "name")
+		Expect(secretName).To(Equal("my-key-secret"))
+		Expect(spec["data"]).To(HaveLen(2))
+		Expect(pushSecretRemoteKey(pushSecret)).To(Equal("default/my-key"))
+	})
+
+	It("keeps a PushSecret moved to another path and deletes it from its own path", func() {
+		Expect(sink.Write(ctx, entry)).To(Succeed())
+		moved := entry
+		moved.Path = "default/renamed"
+		Expect(sink.Write(ctx, moved)).To(Succeed())
+
+		Expect(sink.Delete(ctx, entry)).To(Succeed())
+		_, err := getPushSecret()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(sink.Delete(ctx, moved)).To(Succeed())
+		_, err = getPushSecret()
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		Expect(sink.Delete(ctx, moved)).To(Succeed())
+	})
+})
@@ -0,0 +1,84 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secretstore writes generated keys to secret stores outside the cluster.
+package secretstore
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+)
+
+// KeySecret is the key Secret of a resource written to a secret store
+type KeySecret struct {
+	// Owner is the resource the key was generated for
+	Owner client.Object
+	// SecretName is the name of the key Secret, in the namespace of the owner
+	SecretName string
+	// Path is the location of the entry in the secret store
+	Path string
+	// Data is the data of the key Secret
+	Data map[string][]byte
+}
+
+// Sink writes key Secrets to a secret store
+type Sink interface {
+	// Write creates or replaces the entry in the secret store
+	Write(ctx context.Context, entry KeySecret) error
+	// Delete removes the entry from the secret store. Entries that do not exist are treated as deleted
+	Delete(ctx context.Context, entry KeySecret) error
+}
+
+// NewSink returns the sink of the secret store reference
+func NewSink(ctx context.Context, k8sClient client.Client, scheme *runtime.Scheme, ref *authv1alpha1.SecretStoreRef) (Sink, error) {
+	switch {
+	case ref.Vault != nil:
+		store := &authv1alpha1.ClusterVaultStore{}
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: ref.Vault.Name}, store); err != nil {
+			return nil, fmt.Errorf("failed to get ClusterVaultStore %s: %w", ref.Vault.Name, err)
+		}
+		return NewVaultSink(ctx, k8sClient, scheme, store)
+	case ref.PushSecret != nil:
+		return NewPushSecretSink(k8sClient, scheme, ref.PushSecret), nil
+	}
+	return nil, fmt.Errorf("secretStoreRef sets no secret store")
+}
+
+// Path returns the location of the key Secret of a resource in the secret store: <namespace>/<path>, where path
+// is set by the reference or defaults to the key alias. Paths that would leave the namespace are rejected.
+func Path(ref *authv1alpha1.SecretStoreRef, namespace, keyAlias string) (string, error) {
+	relative := keyAlias
+	switch {
+	case ref.Vault != nil && ref.Vault.Path != "":
+		relative = ref.Vault.Path
+	case ref.PushSecret != nil && ref.PushSecret.RemoteKey != "":
+		relative = ref.PushSecret.RemoteKey
+	}
+	for _, segment := range strings.Split(relative, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", fmt.Errorf("secret store path %q must be a relative path without empty, . or .. segments", relative)
+		}
+	}
+	return path.Join(namespace, relative), nil
+}
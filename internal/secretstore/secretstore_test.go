@@ -0,0 +1,44 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+)
+
+var _ = Describe("Path", func() {
+	It("places the key Secret below the namespace of the resource", func() {
+		Expect(Path(&authv1alpha1.SecretStoreRef{Vault: &authv1alpha1.VaultStoreRef{Name: "vault"}}, "team-a", "my-key")).
+			To(Equal("team-a/my-key"))
+		Expect(Path(&authv1alpha1.SecretStoreRef{Vault: &authv1alpha1.VaultStoreRef{Name: "vault", Path: "apps/my-key"}}, "team-a", "my-key")).
+			To(Equal("team-a/apps/my-key"))
+		Expect(Path(&authv1alpha1.SecretStoreRef{PushSecret: &authv1alpha1.PushSecretStore{StoreName: "store", RemoteKey: "my-remote-key"}}, "team-a", "my-key")).
+			To(Equal("team-a/my-remote-key"))
+	})
+
+	It("rejects paths that would leave the namespace", func() {
+		for _, path := range []string{"../team-b/my-key", "apps/../../team-b", "/team-b/my-key", "apps//my-key"} {
+			_, err := Path(&authv1alpha1.SecretStoreRef{Vault: &authv1alpha1.VaultStoreRef{Name: "vault", Path: path}}, "team-a", "my-key")
+			Expect(err).To(HaveOccurred(), path)
+		}
+		_, err := Path(&authv1alpha1.SecretStoreRef{PushSecret: &authv1alpha1.PushSecretStore{StoreName: "store"}}, "team-a", "../my-key")
+		Expect(err).To(HaveOccurred())
+	})
+})
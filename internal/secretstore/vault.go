@@ -0,0 +1,210 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	vault "github.com/hashicorp/vault/api"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+)
+
+// ServiceAccountTokenPath is the token of the operator's service account, used to log in with the Vault Kubernetes auth method
+var ServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+const (
+	// Custom metadata tagging the secrets written by the operator with the resource they were written for. Secrets
+	// without the tags of the resource are never overwritten or deleted.
+	VaultManagedByMetadata = "managed-by"
+	VaultOwnerMetadata     = "owner"
+
+	vaultManagedBy = "litellm-operator"
+)
+
+// VaultSink writes key Secrets to the HashiCorp Vault KV version 2 secrets engine of a ClusterVaultStore, below
+// the path prefix of the store
+type VaultSink struct {
+	client     *vault.Client
+	scheme     *runtime.Scheme
+	mount      string
+	pathPrefix string
+}
+
+// NewVaultSink returns a sink writing to the Vault KV secrets engine of the store, logged in with the token of the
+// referenced Secret or through the Kubernetes auth method
+func NewVaultSink(ctx context.Context, k8sClient client.Client, scheme *runtime.Scheme, store *authv1alpha1.ClusterVaultStore) (*VaultSink, error) {
+	spec := store.Spec
+	config := vault.DefaultConfig()
+	config.Address = spec.Address
+	vaultClient, err := vault.NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Vault client: %w", err)
+	}
+	if spec.Namespace != "" {
+		vaultClient.SetNamespace(spec.Namespace)
+	}
+
+	switch {
+	case spec.TokenSecretRef != nil:
+		ref := spec.TokenSecretRef
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get Vault token secret %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		token, exists := secret.Data[ref.Key]
+		if !exists {
+			return nil, fmt.Errorf("secret %s/%s does not contain key %s", ref.Namespace, ref.Name, ref.Key)
+		}
+		vaultClient.SetToken(strings.TrimSpace(string(token)))
+	case spec.KubernetesAuth != nil:
+		if err := loginWithKubernetesAuth(ctx, vaultClient, spec.KubernetesAuth); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("ClusterVaultStore %s sets neither tokenSecretRef nor kubernetesAuth", store.Name)
+	}
+
+	mount := spec.Mount
+	if mount == "" {
+		mount = "secret"
+	}
+	return &VaultSink{client: vaultClient, scheme: scheme, mount: mount, pathPrefix: strings.Trim(spec.PathPrefix, "/")}, nil
+}
+
+// loginWithKubernetesAuth logs in to Vault as the role with the token of the operator's service account
+func loginWithKubernetesAuth(ctx context.Context, vaultClient *vault.Client, auth *authv1alpha1.VaultKubernetesAuth) error {
+	jwt, err := os.ReadFile(ServiceAccountTokenPath)
+	if err != nil {
+		return fmt.Errorf("failed to read service account token: %w", err)
+	}
+
+	mount := auth.Mount
+	if mount == "" {
+		mount = "kubernetes"
+	}
+	login, err := vaultClient.Logical().WriteWithContext(ctx, "auth/"+mount+"/login", map[string]interface{}{
+		"role": auth.Role,
+		"jwt":  strings.TrimSpace(string(jwt)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to log in to Vault as role %s: %w", auth.Role, err)
+	}
+	if login == nil || login.Auth == nil || login.Auth.ClientToken == "" {
+		return fmt.Errorf("vault login as role %s returned no token", auth.Role)
+	}
+	vaultClient.SetToken(login.Auth.ClientToken)
+	return nil
+}
+
+// Write replaces the secret at the path of the entry with the data of the key Secret. A new secret is tagged with
+// the owner of the entry before its data is written, and a secret tagged with another owner is never replaced.
+func (s *VaultSink) Write(ctx context.Context, entry KeySecret) error {
+	secretPath := path.Join(s.pathPrefix, entry.Path)
+	owner, err := s.owner(entry)
+	if err != nil {
+		return err
+	}
+
+	kv := s.client.KVv2(s.mount)
+	written, err := s.ownedBy(ctx, secretPath, owner)
+	if err != nil {
+		return err
+	}
+	if !written {
+		if err := kv.PutMetadata(ctx, secretPath, vault.KVMetadataPutInput{
+			CustomMetadata: map[string]interface{}{VaultManagedByMetadata: vaultManagedBy, VaultOwnerMetadata: owner},
+		}); err != nil {
+			return fmt.Errorf("failed to tag %s in Vault: %w", secretPath, err)
+		}
+	}
+
+	data := make(map[string]interface{}, len(entry.Data))
+	for key, value := range entry.Data {
+		data[key] = string(value)
+	}
+	if _, err := kv.Put(ctx, secretPath, data); err != nil {
+		return fmt.Errorf("failed to write %s to Vault: %w", secretPath, err)
+	}
+	return nil
+}
+
+// Delete removes the secret at the path of the entry, with all its versions, when it is tagged with the owner of
+// the entry. Secrets of other owners are left untouched.
+func (s *VaultSink) Delete(ctx context.Context, entry KeySecret) error {
+	secretPath := path.Join(s.pathPrefix, entry.Path)
+	owner, err := s.owner(entry)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := s.client.KVv2(s.mount).GetMetadata(ctx, secretPath)
+	if err != nil {
+		if errors.Is(err, vault.ErrSecretNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to read metadata of %s from Vault: %w", secretPath, err)
+	}
+	if !vaultOwnedBy(metadata, owner) {
+		return nil
+	}
+	if err := s.client.KVv2(s.mount).DeleteMetadata(ctx, secretPath); err != nil {
+		return fmt.Errorf("failed to delete %s from Vault: %w", secretPath, err)
+	}
+	return nil
+}
+
+// ownedBy reports whether the secret at the path exists and is tagged with the owner. It fails when the secret
+// exists and is not tagged with the owner.
+func (s *VaultSink) ownedBy(ctx context.Context, secretPath, owner string) (bool, error) {
+	metadata, err := s.client.KVv2(s.mount).GetMetadata(ctx, secretPath)
+	if err != nil {
+		if errors.Is(err, vault.ErrSecretNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read metadata of %s from Vault: %w", secretPath, err)
+	}
+	if !vaultOwnedBy(metadata, owner) {
+		return false, fmt.Errorf("secret %s in Vault was not written by %s", secretPath, owner)
+	}
+	return true, nil
+}
+
+// owner returns the owner tag of the entry: <kind>/<namespace>/<name> of the resource the key was generated for
+func (s *VaultSink) owner(entry KeySecret) (string, error) {
+	gvk, err := apiutil.GVKForObject(entry.Owner, s.scheme)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(gvk.Kind, entry.Owner.GetNamespace(), entry.Owner.GetName()), nil
+}
+
+// vaultOwnedBy reports whether the secret metadata is tagged with the owner
+func vaultOwnedBy(metadata *vault.KVMetadata, owner string) bool {
+	return metadata.CustomMetadata[VaultManagedByMetadata] == vaultManagedBy &&
+		metadata.CustomMetadata[VaultOwnerMetadata] == owner
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	vault "github.com/hashicorp/vault/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
+)
+
+func TestSecretStore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Secret Store Suite")
+}
+
+// fakeVault serves the KV version 2 and Kubernetes auth endpoints of Vault used by the sink
+type fakeVault struct {
+	mu       sync.Mutex
+	token    string
+	secrets  map[string]map[string]interface{}
+	metadata map[string]map[string]interface{}
+	logins   []map[string]interface{}
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	if path == "auth/kubernetes/login" {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		v.logins = append(v.logins, body)
+		_, _ = w.Write([]byte(`{"auth": {"client_token": "login-token"}}`))
+		return
+	}
+	if r.Header.Get("X-Vault-Token") != v.token {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+		return
+	}
+
+	secretPath, isMetadata := strings.CutPrefix(path, "secret/metadata/")
+	if !isMetadata {
+		secretPath = strings.TrimPrefix(path, "secret/data/")
+	}
+	switch {
+	case r.Method == http.MethodGet && isMetadata:
+		customMetadata, exists := v.metadata[secretPath]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": []}`))
+			return
+		}
+		Expect(json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"custom_metadata": customMetadata, "versions": map[string]interface{}{}},
+		})).To(Succeed())
+	case r.Method == http.MethodPut || r.Method == http.MethodPost:
+		var body map[string]interface{}
+		Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+		if isMetadata {
+			customMetadata, _ := body["custom_metadata"].(map[string]interface{})
+			v.metadata[secretPath] = customMetadata
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		v.secrets[secretPath] = body["data"].(map[string]interface{})
+		if _, exists := v.metadata[secretPath]; !exists {
+			v.metadata[secretPath] = nil
+		}
+		_, _ = w.Write([]byte(`{"data": {"created_time": "2025-01-01T00:00:00Z", "deletion_time": "", "destroyed": false, "version": 1}}`))
+	case r.Method == http.MethodDelete && isMetadata:
+		delete(v.secrets, secretPath)
+		delete(v.metadata, secretPath)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+var _ = Describe("VaultSink", func() {
+	var (
+		ctx       context.Context
+		server    *httptest.Server
+		vaultAPI  *fakeVault
+		scheme    *runtime.Scheme
+		k8sClient client.Client
+		entry     KeySecret
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		vaultAPI = &fakeVault{
+			token:    "root-token",
+			secrets:  map[string]map[string]interface{}{},
+			metadata: map[string]map[string]interface{}{},
+		}
+		server = httptest.NewServer(vaultAPI)
+
+		scheme = runtime.NewScheme()
+		Expect(authv1alpha1.AddToScheme(scheme)).To(Succeed())
+		k8sClient = fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: "vault"},
+			Data:       map[string][]byte{"token": []byte("root-token\n")},
+		}).Build()
+
+		entry = KeySecret{
+			Owner: &authv1alpha1.VirtualKey{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-key", Namespace: "default"},
+			},
+			SecretName: "my-key-secret",
+			Path:       "default/my-key",
+			Data:       map[string][]byte{"key": []byte("sk-test"), "previousKey": []byte("sk-old")},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	tokenStore := func(address string) *authv1alpha1.ClusterVaultStore {
+		return &authv1alpha1.ClusterVaultStore{
+			ObjectMeta: metav1.ObjectMeta{Name: "vault"},
+			Spec: authv1alpha1.ClusterVaultStoreSpec{
+				Address:    address,
+				PathPrefix: "litellm",
+				TokenSecretRef: &authv1alpha1.VaultTokenSecretRef{
+					Name:      "vault-token",
+					Namespace: "vault",
+					Key:       "token",
+				},
+			},
+		}
+	}
+
+	It("writes, tags and deletes the key Secret below the path prefix of the store", func() {
+		sink, err := NewVaultSink(ctx, k8sClient, scheme, tokenStore(server.URL))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(sink.Write(ctx, entry)).To(Succeed())
+		Expect(vaultAPI.secrets).To(HaveKeyWithValue("litellm/default/my-key", map[string]interface{}{
+			"key":         "sk-test",
+			"previousKey": "sk-old",
+		}))
+		Expect(vaultAPI.metadata).To(HaveKeyWithValue("litellm/default/my-key", map[string]interface{}{
+			VaultManagedByMetadata: "litellm-operator",
+			VaultOwnerMetadata:     "VirtualKey/default/my-key",
+		}))
+
+		entry.Data["key"] = []byte("sk-rotated")
+		Expect(sink.Write(ctx, entry)).To(Succeed())
+		Expect(vaultAPI.secrets).To(HaveKeyWithValue("litellm/default/my-key", HaveKeyWithValue("key", "sk-rotated")))
+
+		Expect(sink.Delete(ctx, entry)).To(Succeed())
+		Expect(vaultAPI.secrets).To(BeEmpty())
+		Expect(vaultAPI.metadata).To(BeEmpty())
+	})
+
+	It("neither overwrites nor deletes a secret it did not write", func() {
+		vaultAPI.secrets["litellm/default/my-key"] = map[string]interface{}{"password": "hunter2"}
+		vaultAPI.metadata["litellm/default/my-key"] = map[string]interface{}{
+			VaultManagedByMetadata: "litellm-operator",
+			VaultOwnerMetadata:     "User/default/my-key",
+		}
+		sink, err := NewVaultSink(ctx, k8sClient, scheme, tokenStore(server.URL))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(sink.Write(ctx, entry)).To(MatchError(ContainSubstring("was not written by VirtualKey/default/my-key")))
+		Expect(sink.Delete(ctx, entry)).To(Succeed())
+		Expect(vaultAPI.secrets).To(HaveKeyWithValue("litellm/default/my-key", map[string]interface{}{"password": "hunter2"}))
+	})
+
+	It("logs in with the Kubernetes auth method", func() {
+		tokenPath := filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenPath, []byte("service-account-jwt"), 0o600)).To(Succeed())
+		DeferCleanup(func(previous string) { ServiceAccountTokenPath = previous }, ServiceAccountTokenPath)
+		ServiceAccountTokenPath = tokenPath
+		vaultAPI.token = "login-token"
+
+		store := tokenStore(server.URL)
+		store.Spec.TokenSecretRef = nil
+		store.Spec.KubernetesAuth = &authv1alpha1.VaultKubernetesAuth{Role: "litellm-operator"}
+		sink, err := NewVaultSink(ctx, k8sClient, scheme, store)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vaultAPI.logins).To(ConsistOf(map[string]interface{}{"role": "litellm-operator", "jwt": "service-account-jwt"}))
+
+		Expect(sink.Write(ctx, entry)).To(Succeed())
+		Expect(vaultAPI.secrets).To(HaveKey("litellm/default/my-key"))
+	})
+
+	It("fails when the token is rejected", func() {
+		vaultAPI.token = "other-token"
+		sink, err := NewVaultSink(ctx, k8sClient, scheme, tokenStore(server.URL))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(sink.Write(ctx, entry)).To(MatchError(ContainSubstring("permission denied")))
+	})
+
+	It("fails when the token secret does not exist", func() {
+		store := tokenStore(server.URL)
+		store.Spec.TokenSecretRef.Name = "missing"
+		_, err := NewVaultSink(ctx, k8sClient, scheme, store)
+		Expect(err).To(MatchError(ContainSubstring("failed to get Vault token secret vault/missing")))
+	})
+
+	It("writes and deletes the key Secret in a Vault dev server", func() {
+		address, token := startVaultDevServer()
+
+		k8sClient = fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: "vault"},
+			Data:       map[string][]byte{"token": []byte(token)},
+		}).Build()
+		store := tokenStore(address)
+		store.Spec.PathPrefix = "litellm-operator-test"
+		sink, err := NewVaultSink(ctx, k8sClient, scheme, store)
+		Expect(err).NotTo(HaveOccurred())
+		kv := sink.client.KVv2("secret")
+
+		Expect(sink.Write(ctx, entry)).To(Succeed())
+		written, err := kv.Get(ctx, "litellm-operator-test/default/my-key")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(written.Data).To(HaveKeyWithValue("key", "sk-test"))
+		Expect(written.CustomMetadata).To(HaveKeyWithValue(VaultOwnerMetadata, "VirtualKey/default/my-key"))
+
+		_, err = kv.Put(ctx, "litellm-operator-test/default/foreign", map[string]interface{}{"password": "hunter2"})
+		Expect(err).NotTo(HaveOccurred())
+		foreign := entry
+		foreign.Path = "default/foreign"
+		Expect(sink.Write(ctx, foreign)).To(MatchError(ContainSubstring("was not written by")))
+		Expect(sink.Delete(ctx, foreign)).To(Succeed())
+		_, err = kv.Get(ctx, "litellm-operator-test/default/foreign")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(sink.Delete(ctx, entry)).To(Succeed())
+		_, err = kv.Get(ctx, "litellm-operator-test/default/my-key")
+		Expect(errors.Is(err, vault.ErrSecretNotFound)).To(BeTrue())
+	})
+})
+
+// startVaultDevServer returns the address and root token of a Vault dev server: the server of VAULT_ADDR and
+// VAULT_TOKEN when they are set, or a server started with the vault binary. The spec is skipped when neither is
+// available.
+func startVaultDevServer() (string, string) {
+	if address, token := os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN"); address != "" && token != "" {
+		return address, token
+	}
+	binary, err := exec.LookPath("vault")
+	if err != nil {
+		Skip("the vault binary is not installed and VAULT_ADDR and VAULT_TOKEN are not set")
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+	listenAddress := listener.Addr().String()
+	Expect(listener.Close()).To(Succeed())
+
+	server := exec.Command(binary, "server", "-dev", "-dev-root-token-id=root", "-dev-listen-address="+listenAddress)
+	server.Stdout = GinkgoWriter
+	server.Stderr = GinkgoWriter
+	Expect(server.Start()).To(Succeed())
+	DeferCleanup(func() {
+		_ = server.Process.Kill()
+		_ = server.Wait()
+	})
+
+	address := "http://" + listenAddress
+	Eventually(func() error {
+		response, err := http.Get(address + "/v1/sys/health")
+		if err != nil {
+			return err
+		}
+		return response.Body.Close()
+	}).WithTimeout(30 * time.Second).Should(Succeed())
+	return address, "root"
+}
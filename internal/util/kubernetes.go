@@ -20,10 +20,12 @@ package util
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -47,10 +49,9 @@ func GetMapFromSecret(ctx context.Context, c client.Client, secretRef client.Obj
 
 // CreateOrUpdateWithRetry creates or updates a Kubernetes resource with retry logic.
 // It implements optimistic concurrency control with exponential backoff to handle
-// resource conflicts in high-concurrency environments.
+// resource conflicts in high-concurrency environments. It reports whether an existing object was updated.
 func CreateOrUpdateWithRetry(ctx context.Context, c client.Client, scheme *runtime.Scheme, obj client.Object, owner client.Object) (bool, error) {
 	const maxRetries = 5
-	restart := false
 	log := logf.FromContext(ctx)
 
 	for attempt := 0; attempt < maxRetries; attempt++ {
@@ -77,26 +78,26 @@ func CreateOrUpdateWithRetry(ctx context.Context, c client.Client, scheme *runti
 		}
 
 		// Object exists, check if update is needed
-		needsUpdate, restart := needsUpdate(existing, obj)
-		if !needsUpdate {
-			return restart, nil // No update needed
+		if !needsUpdate(existing, obj) {
+			return false, nil // No update needed
 		}
 
 		// Object exists and needs update
 		// Preserve the existing resource version and other metadata
 		obj.SetResourceVersion(existing.GetResourceVersion())
 		obj.SetUID(existing.GetUID())
+		retainUnmanagedFields(existing, obj)
 
 		// Set controller reference for the update
 		if err := ctrl.SetControllerReference(owner, obj, scheme); err != nil {
 			log.Error(err, "Error setting controller reference")
-			return restart, err
+			return false, err
 		}
 
 		// Try to update
 		err = c.Update(ctx, obj)
 		if err == nil {
-			return restart, nil // Success
+			return true, nil // Success
 		}
 
 		// Check if it's a conflict error
@@ -109,61 +110,63 @@ func CreateOrUpdateWithRetry(ctx context.Context, c client.Client, scheme *runti
 			}
 		}
 
-		return restart, err
+		return false, err
 	}
 
-	return restart, fmt.Errorf("failed to update after %d attempts", maxRetries)
+	return false, fmt.Errorf("failed to update after %d attempts", maxRetries)
 }
 
-// cause a restart of the deployment
-func RestartDeployment(ctx context.Context, c client.Client, name, namespace string) error {
-	deployment := &appsv1.Deployment{}
-	err := c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, deployment)
-	if err != nil {
-		return err
+// retainUnmanagedFields copies fields that the desired object leaves to other controllers from the existing object,
+// so that an update does not reset them. A Deployment without desired replicas keeps the count set by its autoscaler.
+func retainUnmanagedFields(existing, desired client.Object) {
+	if existingDeployment, ok := existing.(*appsv1.Deployment); ok {
+		if desiredDeployment, ok := desired.(*appsv1.Deployment); ok {
+			if desiredDeployment.Spec.Replicas == nil {
+				desiredDeployment.Spec.Replicas = existingDeployment.Spec.Replicas
+			}
+		}
 	}
 
-	// Add a restart annotation to force pod recreation
-	if deployment.Spec.Template.Annotations == nil {
-		deployment.Spec.Template.Annotations = make(map[string]string)
+	// StatefulSet volume claim templates are immutable, keep the stored ones to avoid a rejected update
+	if existingStatefulSet, ok := existing.(*appsv1.StatefulSet); ok {
+		if desiredStatefulSet, ok := desired.(*appsv1.StatefulSet); ok {
+			desiredStatefulSet.Spec.VolumeClaimTemplates = existingStatefulSet.Spec.VolumeClaimTemplates
+		}
 	}
-	deployment.Spec.Template.Annotations["kubectl.kubernetes.io/restartedAt"] = time.Now().Format(time.RFC3339)
-
-	return c.Update(ctx, deployment)
 }
 
 // needsUpdate checks if the resource needs to be updated by comparing existing and desired states.
 // It implements resource-specific comparison logic to determine whether an update is necessary.
-func needsUpdate(existing, desired client.Object) (bool, restart bool) {
+func needsUpdate(existing, desired client.Object) bool {
 	// For ConfigMaps, compare the data
 	if existingConfigMap, ok := existing.(*corev1.ConfigMap); ok {
 		if desiredConfigMap, ok := desired.(*corev1.ConfigMap); ok {
 			if len(existingConfigMap.Data) != len(desiredConfigMap.Data) {
-				return true, true
+				return true
 			}
 			for key, value := range desiredConfigMap.Data {
 				if existingConfigMap.Data[key] != value {
-					return true, true
+					return true
 				}
 			}
-			return false, false
+			return false
 		}
 	}
 
 	// For Deployments, compare specific fields that matter for our use case
 	if existingDeployment, ok := existing.(*appsv1.Deployment); ok {
 		if desiredDeployment, ok := desired.(*appsv1.Deployment); ok {
-			// Compare replicas
+			// Compare replicas; a nil desired count means the replicas are owned by an autoscaler
 			if existingDeployment.Spec.Replicas != nil && desiredDeployment.Spec.Replicas != nil {
 				if *existingDeployment.Spec.Replicas != *desiredDeployment.Spec.Replicas {
-					return true, false
+					return true
 				}
 			}
 
 			// Compare container image
 			if len(existingDeployment.Spec.Template.Spec.Containers) > 0 && len(desiredDeployment.Spec.Template.Spec.Containers) > 0 {
 				if existingDeployment.Spec.Template.Spec.Containers[0].Image != desiredDeployment.Spec.Template.Spec.Containers[0].Image {
-					return true, false
+					return true
 				}
 			}
 
@@ -172,23 +175,194 @@ func needsUpdate(existing, desired client.Object) (bool, restart bool) {
 				existingArgs := existingDeployment.Spec.Template.Spec.Containers[0].Args
 				desiredArgs := desiredDeployment.Spec.Template.Spec.Containers[0].Args
 				if len(existingArgs) != len(desiredArgs) {
-					return true, false
+					return true
 				}
 				for i, arg := range existingArgs {
 					if i >= len(desiredArgs) || arg != desiredArgs[i] {
-						return true, false
+						return true
 					}
 				}
 			}
 
+			// Compare the pod template fields that can be overridden from the instance spec
+			if podTemplateNeedsUpdate(&existingDeployment.Spec.Template, &desiredDeployment.Spec.Template) {
+				return true
+			}
+
 			// For now, we'll be conservative and update if we're not sure
 			// In a production environment, you might want more sophisticated comparison
-			return false, false
+			return false
+		}
+	}
+
+	// For StatefulSets, compare the replicas and the pod template
+	if existingStatefulSet, ok := existing.(*appsv1.StatefulSet); ok {
+		if desiredStatefulSet, ok := desired.(*appsv1.StatefulSet); ok {
+			if existingStatefulSet.Spec.Replicas != nil && desiredStatefulSet.Spec.Replicas != nil {
+				if *existingStatefulSet.Spec.Replicas != *desiredStatefulSet.Spec.Replicas {
+					return true
+				}
+			}
+			return podTemplateNeedsUpdate(&existingStatefulSet.Spec.Template, &desiredStatefulSet.Spec.Template)
 		}
 	}
 
 	// Default to updating if we can't determine the type
-	return true, false
+	return true
+}
+
+// podTemplateNeedsUpdate reports drift on the pod template fields that are configurable through
+// the LiteLLMInstance pod template overrides. Containers and volumes are compared as a whole, and
+// fields defaulted by the API server are ignored when the desired template leaves them unset.
+func podTemplateNeedsUpdate(existing, desired *corev1.PodTemplateSpec) bool {
+	// Only desired metadata keys are compared so that annotations added by other
+	// controllers (e.g. kubectl.kubernetes.io/restartedAt) do not cause churn
+	for k, v := range desired.Annotations {
+		if existing.Annotations[k] != v {
+			return true
+		}
+	}
+	for k, v := range desired.Labels {
+		if existing.Labels[k] != v {
+			return true
+		}
+	}
+
+	existingSpec, desiredSpec := existing.Spec, desired.Spec
+	if fieldsDiffer(existingSpec.NodeSelector, desiredSpec.NodeSelector) ||
+		fieldsDiffer(existingSpec.Tolerations, desiredSpec.Tolerations) ||
+		fieldsDiffer(existingSpec.Affinity, desiredSpec.Affinity) ||
+		fieldsDiffer(existingSpec.TopologySpreadConstraints, desiredSpec.TopologySpreadConstraints) ||
+		fieldsDiffer(existingSpec.PriorityClassName, desiredSpec.PriorityClassName) ||
+		fieldsDiffer(existingSpec.SecurityContext, desiredSpec.SecurityContext) ||
+		fieldsDiffer(existingSpec.ImagePullSecrets, desiredSpec.ImagePullSecrets) {
+		return true
+	}
+
+	if containersDiffer(existingSpec.InitContainers, desiredSpec.InitContainers) ||
+		containersDiffer(existingSpec.Containers, desiredSpec.Containers) {
+		return true
+	}
+
+	if len(existingSpec.Volumes) != len(desiredSpec.Volumes) {
+		return true
+	}
+	for i := range desiredSpec.Volumes {
+		if fieldsDiffer(existingSpec.Volumes[i], withVolumeDefaults(desiredSpec.Volumes[i], existingSpec.Volumes[i])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containersDiffer compares whole containers, after filling in the fields the API server defaults on the desired ones.
+func containersDiffer(existing, desired []corev1.Container) bool {
+	if len(existing) != len(desired) {
+		return true
+	}
+	for i := range desired {
+		if fieldsDiffer(existing[i], withContainerDefaults(desired[i], existing[i])) {
+			return true
+		}
+	}
+	return false
+}
+
+// withContainerDefaults returns a copy of the desired container with the fields it leaves unset, and that the
+// API server defaults, taken from the existing container.
+func withContainerDefaults(desired, existing corev1.Container) corev1.Container {
+	c := *desired.DeepCopy()
+	if c.TerminationMessagePath == "" {
+		c.TerminationMessagePath = existing.TerminationMessagePath
+	}
+	if c.TerminationMessagePolicy == "" {
+		c.TerminationMessagePolicy = existing.TerminationMessagePolicy
+	}
+	if c.ImagePullPolicy == "" {
+		c.ImagePullPolicy = existing.ImagePullPolicy
+	}
+	for i := range c.Ports {
+		if c.Ports[i].Protocol == "" && i < len(existing.Ports) {
+			c.Ports[i].Protocol = existing.Ports[i].Protocol
+		}
+	}
+	for i := range c.Env {
+		if i >= len(existing.Env) || c.Env[i].ValueFrom == nil || existing.Env[i].ValueFrom == nil {
+			continue
+		}
+		if ref, existingRef := c.Env[i].ValueFrom.FieldRef, existing.Env[i].ValueFrom.FieldRef; ref != nil && existingRef != nil && ref.APIVersion == "" {
+			ref.APIVersion = existingRef.APIVersion
+		}
+	}
+	withProbeDefaults(c.LivenessProbe, existing.LivenessProbe)
+	withProbeDefaults(c.ReadinessProbe, existing.ReadinessProbe)
+	withProbeDefaults(c.StartupProbe, existing.StartupProbe)
+	return c
+}
+
+// withProbeDefaults fills in the probe fields defaulted by the API server from the existing probe.
+func withProbeDefaults(desired, existing *corev1.Probe) {
+	if desired == nil || existing == nil {
+		return
+	}
+	if desired.TimeoutSeconds == 0 {
+		desired.TimeoutSeconds = existing.TimeoutSeconds
+	}
+	if desired.PeriodSeconds == 0 {
+		desired.PeriodSeconds = existing.PeriodSeconds
+	}
+	if desired.SuccessThreshold == 0 {
+		desired.SuccessThreshold = existing.SuccessThreshold
+	}
+	if desired.FailureThreshold == 0 {
+		desired.FailureThreshold = existing.FailureThreshold
+	}
+	if desired.HTTPGet != nil && existing.HTTPGet != nil && desired.HTTPGet.Scheme == "" {
+		desired.HTTPGet.Scheme = existing.HTTPGet.Scheme
+	}
+}
+
+// withVolumeDefaults returns a copy of the desired volume with the file modes defaulted by the API server
+// taken from the existing volume.
+func withVolumeDefaults(desired, existing corev1.Volume) corev1.Volume {
+	v := *desired.DeepCopy()
+	switch {
+	case v.Secret != nil && existing.Secret != nil && v.Secret.DefaultMode == nil:
+		v.Secret.DefaultMode = existing.Secret.DefaultMode
+	case v.ConfigMap != nil && existing.ConfigMap != nil && v.ConfigMap.DefaultMode == nil:
+		v.ConfigMap.DefaultMode = existing.ConfigMap.DefaultMode
+	case v.Projected != nil && existing.Projected != nil && v.Projected.DefaultMode == nil:
+		v.Projected.DefaultMode = existing.Projected.DefaultMode
+	case v.DownwardAPI != nil && existing.DownwardAPI != nil && v.DownwardAPI.DefaultMode == nil:
+		v.DownwardAPI.DefaultMode = existing.DownwardAPI.DefaultMode
+	case v.HostPath != nil && existing.HostPath != nil && v.HostPath.Type == nil:
+		v.HostPath.Type = existing.HostPath.Type
+	}
+	return v
+}
+
+// fieldsDiffer reports whether two values differ semantically, treating nil and empty values as equal.
+func fieldsDiffer(existing, desired interface{}) bool {
+	if isEmptyValue(existing) && isEmptyValue(desired) {
+		return false
+	}
+	return !equality.Semantic.DeepEqual(existing, desired)
+}
+
+// isEmptyValue reports whether v is nil, a zero value, an empty map or slice, or a pointer to a zero value.
+func isEmptyValue(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Map, reflect.Slice:
+		return rv.Len() == 0
+	case reflect.Ptr:
+		return rv.IsNil() || rv.Elem().IsZero()
+	default:
+		return rv.IsZero()
+	}
 }
 
 // isConflictError checks if the error is a Kubernetes conflict error.
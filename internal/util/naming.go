@@ -18,6 +18,8 @@ limitations under the License.
 package util
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	authv1alpha1 "github.com/bbdsoftware/litellm-operator/api/auth/v1alpha1"
@@ -25,14 +27,22 @@ import (
 
 const (
 	// Resource name suffixes used for creating child resources
-	ConfigMapSuffix              = "-config"      // Suffix for ConfigMap resources
-	SecretSuffix                 = "-secrets"     // Suffix for Secret resources
-	DeploymentSuffix             = "-deployment"  // Suffix for Deployment resources
-	ServiceSuffix                = "-service"     // Suffix for Service resources
-	IngressSuffix                = "-ingress"     // Suffix for Ingress resources
-	ServiceAccountSuffix         = "-sa"          // Suffix for ServiceAccount resources
-	RoleSuffix                   = "-role"        // Suffix for Role resources
-	RoleBindingSuffix            = "-rolebinding" // Suffix for RoleBinding resources
+	ConfigMapSuffix              = "-config"               // Suffix for ConfigMap resources
+	SecretSuffix                 = "-secrets"              // Suffix for Secret resources
+	DeploymentSuffix             = "-deployment"           // Suffix for Deployment resources
+	ServiceSuffix                = "-service"              // Suffix for Service resources
+	IngressSuffix                = "-ingress"              // Suffix for Ingress resources
+	ServiceAccountSuffix         = "-sa"                   // Suffix for ServiceAccount resources
+	RoleSuffix                   = "-role"                 // Suffix for Role resources
+	RoleBindingSuffix            = "-rolebinding"          // Suffix for RoleBinding resources
+	HPASuffix                    = "-hpa"                  // Suffix for HorizontalPodAutoscaler resources
+	PDBSuffix                    = "-pdb"                  // Suffix for PodDisruptionBudget resources
+	HTTPRouteSuffix              = "-httproute"            // Suffix for Gateway API HTTPRoute resources
+	DatabaseSuffix               = "-postgres"             // Suffix for managed PostgreSQL resources
+	DatabaseSecretSuffix         = "-postgres-credentials" // Suffix for managed PostgreSQL credential Secrets
+	MigrationJobSuffix           = "-migrate"              // Suffix for database migration Jobs
+	CanarySuffix                 = "-canary"               // Suffix for canary upgrade resources
+	PreviewSuffix                = "-preview"              // Suffix for blue/green preview upgrade resources
 	DefaultLLMName               = "litellm"
 	DefaultUserSecretAlias       = "user-secrets"
 	DefaultVirtualKeySecretAlias = "key"
@@ -107,6 +117,72 @@ func (n *LitellmResourceNaming) GetRoleBindingName() string {
 	return n.litellmInstanceName + RoleBindingSuffix
 }
 
+// GetHPAName generates the name for a HorizontalPodAutoscaler resource based on the LiteLLM instance name.
+func (n *LitellmResourceNaming) GetHPAName() string {
+	return n.litellmInstanceName + HPASuffix
+}
+
+// GetPDBName generates the name for a PodDisruptionBudget resource based on the LiteLLM instance name.
+func (n *LitellmResourceNaming) GetPDBName() string {
+	return n.litellmInstanceName + PDBSuffix
+}
+
+// GetHTTPRouteName generates the name for an HTTPRoute resource based on the LiteLLM instance name.
+func (n *LitellmResourceNaming) GetHTTPRouteName() string {
+	return n.litellmInstanceName + HTTPRouteSuffix
+}
+
+// GetDatabaseName generates the name for the managed PostgreSQL resources based on the LiteLLM instance name.
+func (n *LitellmResourceNaming) GetDatabaseName() string {
+	return n.litellmInstanceName + DatabaseSuffix
+}
+
+// GetDatabaseSecretName generates the name for the managed PostgreSQL credentials Secret based on the LiteLLM instance name.
+func (n *LitellmResourceNaming) GetDatabaseSecretName() string {
+	return n.litellmInstanceName + DatabaseSecretSuffix
+}
+
+// GetDatabaseLabels generates the labels for the managed PostgreSQL pods.
+func (n *LitellmResourceNaming) GetDatabaseLabels() map[string]string {
+	return map[string]string{
+		"app": fmt.Sprintf("litellm-%s-postgres", n.litellmInstanceName),
+	}
+}
+
+// GetMigrationJobName generates the name for the database migration Job of an image.
+// The name embeds a short hash of the image so that every image gets its own Job.
+func (n *LitellmResourceNaming) GetMigrationJobName(image string) string {
+	sum := sha256.Sum256([]byte(image))
+	return n.litellmInstanceName + MigrationJobSuffix + "-" + hex.EncodeToString(sum[:])[:8]
+}
+
+// GetUpgradeDeploymentName generates the name for the Deployment running a new image during a canary or blue/green upgrade.
+func (n *LitellmResourceNaming) GetUpgradeDeploymentName(suffix string) string {
+	return n.litellmInstanceName + suffix + DeploymentSuffix
+}
+
+// GetUpgradeServiceName generates the name for the Service selecting the pods of a new image during a canary or blue/green upgrade.
+func (n *LitellmResourceNaming) GetUpgradeServiceName(suffix string) string {
+	return n.litellmInstanceName + suffix + ServiceSuffix
+}
+
+// GetUpgradeLabels generates the labels for the pods of a new image during a canary or blue/green upgrade.
+func (n *LitellmResourceNaming) GetUpgradeLabels(suffix string) map[string]string {
+	return map[string]string{
+		"app": fmt.Sprintf("litellm-%s%s", n.litellmInstanceName, suffix),
+	}
+}
+
+// GetMigrationLabels generates the labels for the pods of the database migration Job. They do not overlap the
+// application labels, so that the Service, PodDisruptionBudget and Deployment never select the migration pods.
+func (n *LitellmResourceNaming) GetMigrationLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      "litellm",
+		"app.kubernetes.io/instance":  n.litellmInstanceName,
+		"app.kubernetes.io/component": "migration",
+	}
+}
+
 // GetAppLabels generates the standard application labels for LiteLLM resources.
 // These labels are used for resource selection and organisation.
 func (n *LitellmResourceNaming) GetAppLabels() map[string]string {